@@ -40,6 +40,7 @@ import (
 
 	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
 	knativeservingv1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/knative/serving/v1"
+	scanningv1beta1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/scanning/v1beta1"
 	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/logs"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
@@ -56,6 +57,7 @@ func init() {
 	_ = clientgoscheme.AddToScheme(scheme)
 	_ = cartov1alpha1.AddToScheme(scheme)
 	_ = knativeservingv1.AddToScheme(scheme)
+	_ = scanningv1beta1.AddToScheme(scheme)
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -84,7 +86,10 @@ func main() {
 
 	c := cli.Initialize(fmt.Sprintf("tanzu %s", p.Cmd.Use), scheme)
 	p.AddCommands(
+		commands.NewAppCommand(ctx, c),
+		commands.NewClusterDeliveryCommand(ctx, c),
 		commands.NewClusterSupplyChainCommand(ctx, c),
+		commands.NewDeliverableCommand(ctx, c),
 		commands.NewWorkloadCommand(ctx, c),
 
 		// hidden commands
@@ -140,6 +145,6 @@ func main() {
 				c.Eprintf("%s %s\n", printer.Serrorf("Error:"), err)
 			}
 		}
-		os.Exit(1)
+		os.Exit(cli.ExitCode(err))
 	}
 }
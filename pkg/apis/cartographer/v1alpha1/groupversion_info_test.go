@@ -31,6 +31,22 @@ func TestGetGroupVersionKind(t *testing.T) {
 		}
 		want schema.GroupVersionKind
 	}{{
+		name:     "ClusterDelivery",
+		resource: &ClusterDelivery{},
+		want: schema.GroupVersionKind{
+			Group:   "carto.run",
+			Version: "v1alpha1",
+			Kind:    "ClusterDelivery",
+		},
+	}, {
+		name:     "ClusterRunTemplate",
+		resource: &ClusterRunTemplate{},
+		want: schema.GroupVersionKind{
+			Group:   "carto.run",
+			Version: "v1alpha1",
+			Kind:    "ClusterRunTemplate",
+		},
+	}, {
 		name:     "ClusterSupplyChain",
 		resource: &ClusterSupplyChain{},
 		want: schema.GroupVersionKind{
@@ -38,6 +54,14 @@ func TestGetGroupVersionKind(t *testing.T) {
 			Version: "v1alpha1",
 			Kind:    "ClusterSupplyChain",
 		},
+	}, {
+		name:     "Runnable",
+		resource: &Runnable{},
+		want: schema.GroupVersionKind{
+			Group:   "carto.run",
+			Version: "v1alpha1",
+			Kind:    "Runnable",
+		},
 	}, {
 		name:     "Workload",
 		resource: &Workload{},
@@ -17,3 +17,6 @@ limitations under the License.
 package v1alpha1
 
 const WorkloadLabelName = GroupName + "/workload-name"
+
+// RunnableLabelName labels the objects stamped for a runnable with the name of the runnable
+const RunnableLabelName = GroupName + "/runnable-name"
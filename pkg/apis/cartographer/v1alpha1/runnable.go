@@ -0,0 +1,103 @@
+// Copyright 2021 VMware
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	RunnableKind = "Runnable"
+)
+
+const (
+	RunnableConditionReady            = "Ready"
+	RunnableConditionRunTemplateReady = "RunTemplateReady"
+	RunnableConditionStampedObject    = "StampedObjectCondition"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+type Runnable struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              RunnableSpec   `json:"spec"`
+	Status            RunnableStatus `json:"status,omitempty"`
+}
+
+type RunnableSpec struct {
+	// Inputs are key/values providing inputs to the templated object created for this runnable.
+	Inputs map[string]apiextensionsv1.JSON `json:"inputs,omitempty"`
+
+	// RunTemplateRef identifies the run template used to produce resources for this runnable.
+	RunTemplateRef RunTemplateReference `json:"runTemplateRef"`
+
+	// Selector refers to an additional object that the template can refer to using: $(selected)$.
+	Selector *ResourceSelector `json:"selector,omitempty"`
+
+	// RetentionPolicy specifies how many successful and failed runs should be retained.
+	RetentionPolicy RetentionPolicy `json:"retentionPolicy,omitempty"`
+
+	// ServiceAccountName refers to the Service account with permissions to create resources
+	// submitted by the ClusterRunTemplate.
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+}
+
+type RunTemplateReference struct {
+	Kind string `json:"kind,omitempty"`
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+}
+
+type ResourceSelector struct {
+	Resource       ResourceType      `json:"resource"`
+	MatchingLabels map[string]string `json:"matchingLabels"`
+}
+
+type ResourceType struct {
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind,omitempty"`
+}
+
+type RetentionPolicy struct {
+	// MaxFailedRuns is the number of failed runs to retain.
+	MaxFailedRuns int64 `json:"maxFailedRuns"`
+	// MaxSuccessfulRuns is the number of successful runs to retain.
+	MaxSuccessfulRuns int64 `json:"maxSuccessfulRuns"`
+}
+
+type RunnableStatus struct {
+	OwnerStatus `json:",inline"`
+
+	// Outputs are the values read from the latest successful run, as declared by the run template.
+	Outputs map[string]apiextensionsv1.JSON `json:"outputs,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+type RunnableList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Runnable `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(
+		&Runnable{},
+		&RunnableList{},
+	)
+}
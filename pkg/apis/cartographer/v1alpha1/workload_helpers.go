@@ -41,6 +41,7 @@ const (
 	WorkloadConditionReady  = "Ready"
 	WorkloadAnnotationParam = "annotations"
 	WorkloadMavenParam      = "maven"
+	WorkloadRebuildParam    = "rebuild"
 )
 
 type MavenSource struct {
@@ -67,6 +68,35 @@ func (w *Workload) Load(in io.Reader) error {
 	return nil
 }
 
+// LoadWorkloads reads every workload described in the input, documents can be
+// separated with "---" when the input is YAML
+func LoadWorkloads(in io.Reader) ([]*Workload, error) {
+	d := yaml.NewYAMLOrJSONDecoder(in, 4096)
+	workloads := []*Workload{}
+	for {
+		var workload *Workload
+		if err := d.Decode(&workload); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		if workload == nil {
+			continue
+		}
+		if apiVersion, kind := SchemeGroupVersion.Identifier(), "Workload"; workload.APIVersion != apiVersion || workload.Kind != kind {
+			return nil, fmt.Errorf("file must contain resource with API Version %q and Kind %q", apiVersion, kind)
+		}
+		workload.APIVersion = ""
+		workload.Kind = ""
+		workloads = append(workloads, workload)
+	}
+	if len(workloads) == 0 {
+		return nil, fmt.Errorf("file must contain resource with API Version %q and Kind %q", SchemeGroupVersion.Identifier(), "Workload")
+	}
+	return workloads, nil
+}
+
 func (w *Workload) loadAndValidateDocuments(in io.Reader) error {
 	d := yaml.NewYAMLOrJSONDecoder(in, 4096)
 	documents := 0
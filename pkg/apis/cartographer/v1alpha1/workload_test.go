@@ -131,6 +131,64 @@ func TestWorkload_Load(t *testing.T) {
 	}
 }
 
+func TestLoadWorkloads(t *testing.T) {
+	tests := []struct {
+		name      string
+		file      string
+		want      []string
+		shouldErr bool
+	}{{
+		name: "loads workload",
+		file: "testdata/workload.yaml",
+		want: []string{"spring-petclinic"},
+	}, {
+		name: "multi document",
+		file: "testdata/multidocument.yaml",
+		want: []string{"spring-petclinic0", "spring-petclinic1", "spring-petclinic2"},
+	}, {
+		name: "loads workload with first and last document empty",
+		file: "testdata/multidocument_first_last_empty.yaml",
+		want: []string{"spring-petclinic"},
+	}, {
+		name:      "not a workload",
+		file:      "testdata/supplychain.yaml",
+		shouldErr: true,
+	}, {
+		name:      "malformed",
+		file:      "testdata/malformed.yaml",
+		shouldErr: true,
+	}, {
+		name:      "missing",
+		file:      "testdata/missing.yaml",
+		shouldErr: true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, _ := os.Open(test.file)
+			defer f.Close()
+
+			workloads, err := LoadWorkloads(f)
+
+			if (err == nil) == test.shouldErr {
+				t.Errorf("LoadWorkloads() shouldErr %t %v", test.shouldErr, err)
+			} else if test.shouldErr {
+				return
+			}
+			got := []string{}
+			for _, w := range workloads {
+				if w.APIVersion != "" || w.Kind != "" {
+					t.Errorf("LoadWorkloads() expected TypeMeta to be cleared, got %v", w.TypeMeta)
+				}
+				got = append(got, w.Name)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("LoadWorkloads() (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
+
 func TestWorkload_IsAnnotationExists(t *testing.T) {
 	tests := []struct {
 		name       string
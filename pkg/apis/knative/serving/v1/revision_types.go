@@ -0,0 +1,68 @@
+/*
+Copyright 2019 The Knative Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const RevisionConditionReady = "Ready"
+
+// ServiceLabelKey is the label carried by the revisions of a Service, its value is the name of
+// the Service
+const ServiceLabelKey = GroupName + "/service"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// Revision is an immutable snapshot of code and configuration.
+type Revision struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +optional
+	Status RevisionStatus `json:"status,omitempty"`
+}
+
+// RevisionStatus communicates the observed state of the Revision (from the controller).
+type RevisionStatus struct {
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+	// ActualReplicas reflects the amount of ready pods running this revision.
+	// +optional
+	ActualReplicas *int32 `json:"actualReplicas,omitempty"`
+	// DesiredReplicas reflects the desired amount of pods running this revision.
+	// +optional
+	DesiredReplicas *int32 `json:"desiredReplicas,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RevisionList is a list of Revision resources
+type RevisionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Revision `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(
+		&Revision{},
+		&RevisionList{},
+	)
+}
@@ -45,6 +45,47 @@ type ServiceStatus struct {
 	// It generally has the form http[s]://{route-name}.{route-namespace}.{cluster-level-suffix}
 	// +optional
 	URL string `json:"url,omitempty"`
+	// LatestReadyRevisionName holds the name of the latest Revision stamped out
+	// from this Service's Configuration that has had its "Ready" condition become "True".
+	// +optional
+	LatestReadyRevisionName string `json:"latestReadyRevisionName,omitempty"`
+	// LatestCreatedRevisionName is the last revision that was created from this
+	// Service's Configuration. It might not be ready yet, for that use LatestReadyRevisionName.
+	// +optional
+	LatestCreatedRevisionName string `json:"latestCreatedRevisionName,omitempty"`
+	// Traffic holds the configured traffic distribution.
+	// These entries will always contain RevisionName references.
+	// When ConfigurationName appears in the spec, this will hold the
+	// LatestReadyRevisionName that we last observed.
+	// +optional
+	Traffic []TrafficTarget `json:"traffic,omitempty"`
+}
+
+// TrafficTarget holds a single entry of the routing table for a Service.
+type TrafficTarget struct {
+	// Tag is optionally used to expose a dedicated url for referencing
+	// this target exclusively.
+	// +optional
+	Tag string `json:"tag,omitempty"`
+	// RevisionName of a specific revision to which to send this portion of
+	// traffic.
+	// +optional
+	RevisionName string `json:"revisionName,omitempty"`
+	// LatestRevision may be optionally provided to indicate that the latest
+	// ready Revision of the Configuration should be used for this traffic
+	// target.
+	// +optional
+	LatestRevision *bool `json:"latestRevision,omitempty"`
+	// Percent indicates that percentage based routing should be used and
+	// the value indicates the percent of traffic that is be routed to this
+	// Revision or Configuration.
+	// +optional
+	Percent *int64 `json:"percent,omitempty"`
+	// URL displays the URL for accessing named traffic targets. URL is displayed in
+	// status, and is disallowed on spec. URL must contain a scheme (e.g. http://) and
+	// a hostname, but may not contain anything else (e.g. basic auth, url path, etc.)
+	// +optional
+	URL string `json:"url,omitempty"`
 }
 
 // +kubebuilder:object:root=true
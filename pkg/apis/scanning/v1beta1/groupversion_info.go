@@ -0,0 +1,41 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// +versionName=v1beta1
+// +groupName=scanning.apps.tanzu.vmware.com
+// +kubebuilder:object:generate=true
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+const GroupName = "scanning.apps.tanzu.vmware.com"
+
+var (
+	SchemeGroupVersion = schema.GroupVersion{
+		Group:   GroupName,
+		Version: "v1beta1",
+	}
+
+	SchemeBuilder = &scheme.Builder{
+		GroupVersion: SchemeGroupVersion,
+	}
+
+	AddToScheme = SchemeBuilder.AddToScheme
+)
@@ -0,0 +1,188 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// +versionName=v1beta1
+// +groupName=scanning.apps.tanzu.vmware.com
+// +kubebuilder:object:generate=true
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	SourceScanKind = "SourceScan"
+	ImageScanKind  = "ImageScan"
+)
+
+const (
+	// ConditionScanCompleted reports whether the scan ran to completion
+	ConditionScanCompleted = "ScanCompleted"
+	// ConditionPolicySucceeded reports whether the scanned artifact complies with the scan policy
+	ConditionPolicySucceeded = "PolicySucceeded"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// SourceScan is a scan of the source code of a workload
+type SourceScan struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +optional
+	Spec SourceScanSpec `json:"spec,omitempty"`
+	// +optional
+	Status ScanStatus `json:"status,omitempty"`
+}
+
+// SourceScanSpec represents the Spec stanza of the SourceScan resource.
+type SourceScanSpec struct {
+	// Blob is the location of the source code archive to scan
+	// +optional
+	Blob Blob `json:"blob,omitempty"`
+	// ScanTemplate is the name of the template running the scan
+	// +optional
+	ScanTemplate string `json:"scanTemplate,omitempty"`
+	// ScanPolicy is the name of the policy the scan results are evaluated against
+	// +optional
+	ScanPolicy string `json:"scanPolicy,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ImageScan is a scan of the image built for a workload
+type ImageScan struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// +optional
+	Spec ImageScanSpec `json:"spec,omitempty"`
+	// +optional
+	Status ScanStatus `json:"status,omitempty"`
+}
+
+// ImageScanSpec represents the Spec stanza of the ImageScan resource.
+type ImageScanSpec struct {
+	// Registry is the location of the image to scan
+	// +optional
+	Registry Registry `json:"registry,omitempty"`
+	// ScanTemplate is the name of the template running the scan
+	// +optional
+	ScanTemplate string `json:"scanTemplate,omitempty"`
+	// ScanPolicy is the name of the policy the scan results are evaluated against
+	// +optional
+	ScanPolicy string `json:"scanPolicy,omitempty"`
+}
+
+type Blob struct {
+	URL      string `json:"url,omitempty"`
+	Revision string `json:"revision,omitempty"`
+}
+
+type Registry struct {
+	Image string `json:"image,omitempty"`
+}
+
+// ScanStatus represents the Status stanza of the SourceScan and ImageScan resources.
+type ScanStatus struct {
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+	// Phase is the stage of the scan, one of Pending, Scanning, Completed, Failed or Error
+	// +optional
+	Phase string `json:"phase,omitempty"`
+	// ScannedBy is the scanner that ran the scan
+	// +optional
+	ScannedBy ScannedBy `json:"scannedBy,omitempty"`
+	// ScannedAt is the time the scan completed
+	// +optional
+	ScannedAt metav1.Time `json:"scannedAt,omitempty"`
+	// Artifact is the source code or image that was scanned
+	// +optional
+	Artifact Artifact `json:"artifact,omitempty"`
+	// CompliantArtifact is the scanned artifact when it complies with the scan policy
+	// +optional
+	CompliantArtifact Artifact `json:"compliantArtifact,omitempty"`
+	// MetadataURL is the location of the scan report in the metadata store
+	// +optional
+	MetadataURL string `json:"metadataUrl,omitempty"`
+	// Summary is the number of vulnerabilities found by the scan
+	// +optional
+	Summary Summary `json:"summary,omitempty"`
+}
+
+type ScannedBy struct {
+	Scanner Scanner `json:"scanner,omitempty"`
+}
+
+type Scanner struct {
+	Name    string `json:"name,omitempty"`
+	Vendor  string `json:"vendor,omitempty"`
+	Version string `json:"version,omitempty"`
+}
+
+type Artifact struct {
+	// +optional
+	Blob *Blob `json:"blob,omitempty"`
+	// +optional
+	Registry *Registry `json:"registry,omitempty"`
+}
+
+type Summary struct {
+	CVECount CVECount `json:"cvecount,omitempty"`
+}
+
+// CVECount is the number of vulnerabilities found by severity
+type CVECount struct {
+	Critical int64 `json:"critical"`
+	High     int64 `json:"high"`
+	Medium   int64 `json:"medium"`
+	Low      int64 `json:"low"`
+	Unknown  int64 `json:"unknown"`
+}
+
+// +kubebuilder:object:root=true
+
+// SourceScanList is a list of SourceScan resources
+type SourceScanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []SourceScan `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+
+// ImageScanList is a list of ImageScan resources
+type ImageScanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ImageScan `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(
+		&SourceScan{},
+		&SourceScanList{},
+		&ImageScan{},
+		&ImageScanList{},
+	)
+}
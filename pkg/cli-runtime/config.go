@@ -46,7 +46,13 @@ type Config struct {
 	Stderr          io.Writer
 	Verbose         *int32
 	Builder         *resource.Builder
-	NoColor         bool
+	// NewBuilder returns a new resource builder. A builder can only visit a single request, commands
+	// fetching resources more than once, like for each of several workloads, need a builder each
+	NewBuilder func() *resource.Builder
+	// NewContextClient returns a client for another context of the kubeconfig, to reach a
+	// cluster other than the current one
+	NewContextClient func(context string) Client
+	NoColor          bool
 }
 
 func NewDefaultConfig(name string, scheme *runtime.Scheme) *Config {
@@ -147,4 +153,14 @@ func (c *Config) init() {
 	if c.Builder == nil {
 		c.Builder = resource.NewBuilder(c.Client)
 	}
+	if c.NewBuilder == nil {
+		c.NewBuilder = func() *resource.Builder {
+			return resource.NewBuilder(c.Client)
+		}
+	}
+	if c.NewContextClient == nil {
+		c.NewContextClient = func(context string) Client {
+			return NewClient(c.KubeConfigFile, context, c.Scheme)
+		}
+	}
 }
@@ -34,4 +34,6 @@ const (
 	Question        Icon = '❓'
 	ThumbsUp        Icon = '👍'
 	Exclamation     Icon = '❗'
+	Link            Icon = '🔗'
+	Runner          Icon = '🏃'
 )
@@ -16,6 +16,8 @@ limitations under the License.
 
 package cli
 
+import "errors"
+
 var SilentError = &silentError{}
 
 type silentError struct {
@@ -38,3 +40,35 @@ func (e *silentError) Is(err error) bool {
 func SilenceError(err error) error {
 	return &silentError{err: err}
 }
+
+type exitCodeError struct {
+	err  error
+	code int
+}
+
+func (e *exitCodeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *exitCodeError) Unwrap() error {
+	return e.err
+}
+
+// WithExitCode wraps the error so the process exits with the given code when the error is
+// returned from the command
+func WithExitCode(err error, code int) error {
+	return &exitCodeError{err: err, code: code}
+}
+
+// ExitCode returns the code the process should exit with for the error, 0 when there is no
+// error and 1 when the error was not wrapped with an exit code
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exitErr *exitCodeError
+	if errors.As(err, &exitErr) {
+		return exitErr.code
+	}
+	return 1
+}
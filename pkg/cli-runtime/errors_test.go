@@ -41,3 +41,27 @@ func TestSilenceError(t *testing.T) {
 		t.Errorf("errors expected to match, expected %q, actually %q", expected, actual)
 	}
 }
+
+func TestExitCode(t *testing.T) {
+	err := fmt.Errorf("test error")
+	exitErr := cli.WithExitCode(err, 3)
+
+	if expected, actual := 0, cli.ExitCode(nil); expected != actual {
+		t.Errorf("exit codes expected to match, expected %d, actually %d", expected, actual)
+	}
+	if expected, actual := 1, cli.ExitCode(err); expected != actual {
+		t.Errorf("exit codes expected to match, expected %d, actually %d", expected, actual)
+	}
+	if expected, actual := 3, cli.ExitCode(exitErr); expected != actual {
+		t.Errorf("exit codes expected to match, expected %d, actually %d", expected, actual)
+	}
+	if expected, actual := 3, cli.ExitCode(cli.SilenceError(exitErr)); expected != actual {
+		t.Errorf("exit codes expected to match, expected %d, actually %d", expected, actual)
+	}
+	if expected, actual := err, errors.Unwrap(exitErr); expected != actual {
+		t.Errorf("errors expected to match, expected %v, actually %v", expected, actual)
+	}
+	if expected, actual := err.Error(), exitErr.Error(); expected != actual {
+		t.Errorf("errors expected to match, expected %q, actually %q", expected, actual)
+	}
+}
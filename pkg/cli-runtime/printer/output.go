@@ -0,0 +1,172 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/template"
+
+	"k8s.io/client-go/util/jsonpath"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
+)
+
+const (
+	OutputFormatName           = "name"
+	OutputFormatWide           = "wide"
+	OutputFormatCustomColumns  = "custom-columns"
+	OutputFormatJsonPath       = "jsonpath"
+	OutputFormatGoTemplate     = "go-template"
+	OutputFormatGoTemplateFile = "go-template-file"
+)
+
+var (
+	// ObjectOutputFormats are the format types supported by OutputObject
+	ObjectOutputFormats = []string{OutputFormatJson, OutputFormatYaml, OutputFormatYml, OutputFormatCustomColumns, OutputFormatJsonPath, OutputFormatGoTemplate, OutputFormatGoTemplateFile}
+	// ResourceOutputFormats are the format types supported by OutputResource and OutputResources
+	ResourceOutputFormats = []string{OutputFormatJson, OutputFormatYaml, OutputFormatYml, OutputFormatName, OutputFormatCustomColumns, OutputFormatJsonPath, OutputFormatGoTemplate, OutputFormatGoTemplateFile}
+	// TableOutputFormats are the format types supported by commands that render resources
+	// in a table, the wide format is rendered by the command itself
+	TableOutputFormats = []string{OutputFormatJson, OutputFormatYaml, OutputFormatYml, OutputFormatWide, OutputFormatName, OutputFormatCustomColumns, OutputFormatJsonPath, OutputFormatGoTemplate, OutputFormatGoTemplateFile}
+)
+
+// Type returns the format without its argument, the type of "jsonpath={.metadata.name}"
+// is "jsonpath"
+func (f OutputFormat) Type() string {
+	t, _, _ := strings.Cut(string(f), "=")
+	return t
+}
+
+// Argument returns the template, template file or columns following the format type
+func (f OutputFormat) Argument() string {
+	_, arg, _ := strings.Cut(string(f), "=")
+	return arg
+}
+
+// Validate checks the argument of templated formats can be parsed
+func (f OutputFormat) Validate() error {
+	switch f.Type() {
+	case OutputFormatCustomColumns:
+		_, err := table.ParseCustomColumns(f.Argument())
+		return err
+	case OutputFormatJsonPath:
+		_, err := parseJSONPath(f.Argument())
+		return err
+	case OutputFormatGoTemplate, OutputFormatGoTemplateFile:
+		_, err := parseGoTemplate(f)
+		return err
+	}
+	return nil
+}
+
+// printTemplate renders the json representation of obj with a custom columns,
+// jsonpath or go-template format
+func printTemplate(obj interface{}, format OutputFormat) (string, error) {
+	data, err := jsonValue(obj)
+	if err != nil {
+		return "", err
+	}
+
+	buf := &bytes.Buffer{}
+	switch format.Type() {
+	case OutputFormatCustomColumns:
+		columns, err := table.ParseCustomColumns(format.Argument())
+		if err != nil {
+			return "", err
+		}
+		items, ok := data.([]interface{})
+		if !ok {
+			items = []interface{}{data}
+		}
+		t, err := table.CustomColumnsTable(columns, items)
+		if err != nil {
+			return "", err
+		}
+		if err := table.NewTablePrinter(table.PrintOptions{}).PrintObj(t, buf); err != nil {
+			return "", err
+		}
+	case OutputFormatJsonPath:
+		j, err := parseJSONPath(format.Argument())
+		if err != nil {
+			return "", err
+		}
+		if err := j.Execute(buf, data); err != nil {
+			return "", err
+		}
+	case OutputFormatGoTemplate, OutputFormatGoTemplateFile:
+		t, err := parseGoTemplate(format)
+		if err != nil {
+			return "", err
+		}
+		if err := t.Execute(buf, data); err != nil {
+			return "", err
+		}
+	default:
+		return "", fmt.Errorf("unknown output format %q", format)
+	}
+	return strings.TrimRight(buf.String(), "\n"), nil
+}
+
+func parseJSONPath(expression string) (*jsonpath.JSONPath, error) {
+	if expression == "" {
+		return nil, fmt.Errorf("jsonpath format specified but no expression given")
+	}
+	j := jsonpath.New(OutputFormatJsonPath).AllowMissingKeys(true)
+	if err := j.Parse(expression); err != nil {
+		return nil, fmt.Errorf("error parsing jsonpath %s: %v", expression, err)
+	}
+	return j, nil
+}
+
+func parseGoTemplate(format OutputFormat) (*template.Template, error) {
+	text := format.Argument()
+	if text == "" {
+		return nil, fmt.Errorf("%s format specified but no template given", format.Type())
+	}
+	if format.Type() == OutputFormatGoTemplateFile {
+		b, err := os.ReadFile(text)
+		if err != nil {
+			return nil, fmt.Errorf("error reading template %s: %v", text, err)
+		}
+		text = string(b)
+	}
+	t, err := template.New(OutputFormatGoTemplate).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template %s: %v", text, err)
+	}
+	return t, nil
+}
+
+// jsonValue converts obj into the generic types produced by encoding/json, so
+// templates reference fields by their json names
+func jsonValue(obj interface{}) (interface{}, error) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+)
+
+func TestOutputFormat(t *testing.T) {
+	tests := []struct {
+		name         string
+		format       printer.OutputFormat
+		wantType     string
+		wantArgument string
+	}{{
+		name:     "json",
+		format:   printer.OutputFormatJson,
+		wantType: "json",
+	}, {
+		name:         "jsonpath",
+		format:       "jsonpath={.metadata.name}",
+		wantType:     "jsonpath",
+		wantArgument: "{.metadata.name}",
+	}, {
+		name:         "argument with equals",
+		format:       `go-template={{if eq .kind "Workload"}}{{end}}`,
+		wantType:     "go-template",
+		wantArgument: `{{if eq .kind "Workload"}}{{end}}`,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.wantType, test.format.Type()); diff != "" {
+				t.Errorf("Type() (-want, +got) = %v", diff)
+			}
+			if diff := cmp.Diff(test.wantArgument, test.format.Argument()); diff != "" {
+				t.Errorf("Argument() (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
+
+func TestOutputObject(t *testing.T) {
+	templateFile := filepath.Join(t.TempDir(), "status.tmpl")
+	if err := os.WriteFile(templateFile, []byte("{{.name}} is {{if .healthy}}healthy{{else}}unhealthy{{end}}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	obj := struct {
+		Name    string `json:"name"`
+		Healthy bool   `json:"healthy"`
+		Count   int    `json:"count"`
+	}{
+		Name:    "proxy",
+		Healthy: true,
+		Count:   1000000,
+	}
+
+	tests := []struct {
+		name         string
+		outputFormat printer.OutputFormat
+		want         string
+		shouldError  bool
+	}{{
+		name:         "jsonpath",
+		outputFormat: "jsonpath={.name} {.count}",
+		want:         "proxy 1000000",
+	}, {
+		name:         "go-template",
+		outputFormat: "go-template={{.name}}",
+		want:         "proxy",
+	}, {
+		name:         "go-template-file",
+		outputFormat: printer.OutputFormat("go-template-file=" + templateFile),
+		want:         "proxy is healthy",
+	}, {
+		name:         "custom-columns",
+		outputFormat: "custom-columns=NAME:.name,HEALTHY:.healthy",
+		want: `NAME    HEALTHY
+proxy   true`,
+	}, {
+		name:         "name",
+		outputFormat: printer.OutputFormatName,
+		shouldError:  true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := printer.OutputObject(obj, test.outputFormat)
+			if (err != nil) != test.shouldError {
+				t.Errorf("OutputObject() error = %v, expected %v", err, test.shouldError)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("OutputObject() (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
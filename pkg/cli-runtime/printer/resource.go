@@ -20,6 +20,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/fatih/color"
@@ -47,12 +48,20 @@ type Object interface {
 }
 
 func ExportResource(obj Object, format OutputFormat, scheme *runtime.Scheme) (string, error) {
+	u, err := exportUnstructured(obj, scheme)
+	if err != nil {
+		return "", err
+	}
+	return printObject(u, format)
+}
+
+func exportUnstructured(obj Object, scheme *runtime.Scheme) (map[string]interface{}, error) {
 	copy := obj.DeepCopyObject().(Object)
 
 	// force apiVersion and kind to be set
 	gvks, _, err := scheme.ObjectKinds(obj)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	copy.SetGroupVersionKind(gvks[0])
 
@@ -70,13 +79,13 @@ func ExportResource(obj Object, format OutputFormat, scheme *runtime.Scheme) (st
 	// remove status and other nuisance fields
 	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(copy)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	unstructured.RemoveNestedField(u, "metadata", "creationTimestamp")
 	unstructured.RemoveNestedField(u, "status")
 
-	return printObject(u, format)
+	return u, nil
 }
 
 func setGVK(obj Object, scheme *runtime.Scheme) (Object, error) {
@@ -92,18 +101,36 @@ func setGVK(obj Object, scheme *runtime.Scheme) (Object, error) {
 }
 
 func OutputResource(obj Object, format OutputFormat, scheme *runtime.Scheme) (string, error) {
-	copy, err := setGVK(obj, scheme)
+	if format == OutputFormatName {
+		copy, err := setGVK(obj, scheme)
+		if err != nil {
+			return "", err
+		}
+		return resourceName(copy), nil
+	}
+	u, err := UnstructuredResource(obj, scheme)
 	if err != nil {
 		return "", err
 	}
+
+	return printObject(u, format)
+}
+
+// UnstructuredResource converts the resource into the map that is rendered by OutputResource,
+// so it can be embedded in a larger document passed to OutputObject
+func UnstructuredResource(obj Object, scheme *runtime.Scheme) (map[string]interface{}, error) {
+	copy, err := setGVK(obj, scheme)
+	if err != nil {
+		return nil, err
+	}
 	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(copy)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	unstructured.RemoveNestedField(u, "metadata", "managedFields")
 
-	return printObject(u, format)
+	return u, nil
 }
 
 func OutputResources(objList []Object, format OutputFormat, scheme *runtime.Scheme) (string, error) {
@@ -116,9 +143,28 @@ func OutputResources(objList []Object, format OutputFormat, scheme *runtime.Sche
 		}
 		updatedList = append(updatedList, copy)
 	}
+	if format == OutputFormatName {
+		names := make([]string, len(updatedList))
+		for i, o := range updatedList {
+			names[i] = resourceName(o)
+		}
+		return strings.Join(names, "\n"), nil
+	}
 	return printObject(updatedList, format)
 }
 
+// resourceName returns the kind, group and name of the resource in the form
+// "workload.carto.run/petclinic"
+func resourceName(obj Object) string {
+	return fmt.Sprintf("%s/%s", strings.ToLower(obj.GetObjectKind().GroupVersionKind().GroupKind().String()), obj.GetName())
+}
+
+// OutputObject renders an arbitrary value in the desired format, it is
+// intended for documents that are not kubernetes resources
+func OutputObject(obj interface{}, format OutputFormat) (string, error) {
+	return printObject(obj, format)
+}
+
 func printObject(obj interface{}, format OutputFormat) (string, error) {
 	// render according to desired format
 	switch format {
@@ -129,7 +175,7 @@ func printObject(obj interface{}, format OutputFormat) (string, error) {
 		b, err := yaml.Marshal(obj)
 		return fmt.Sprintf("---\n%s", strings.TrimSpace(string(b))), err
 	default:
-		return "", fmt.Errorf("unknown output format %q", format)
+		return printTemplate(obj, format)
 	}
 }
 
@@ -190,6 +236,109 @@ func ResourceDiff(left, right Object, scheme *runtime.Scheme) (string, bool, err
 	return sb.String(), !hasDiff, nil
 }
 
+const (
+	FieldChangeAdded    = "added"
+	FieldChangeRemoved  = "removed"
+	FieldChangeModified = "modified"
+)
+
+// FieldChange describes a single field that differs between two resources
+type FieldChange struct {
+	Path      string `json:"path"`
+	Operation string `json:"operation"`
+}
+
+// ResourceDiffFields returns the paths of the fields that differ between left
+// and right. Both resources are pruned the same way as in ResourceDiff, so only
+// metadata name, namespace, labels and annotations are compared alongside the
+// rest of the resource content, status excluded.
+func ResourceDiffFields(left, right Object, scheme *runtime.Scheme) ([]FieldChange, error) {
+	l, r := map[string]interface{}{}, map[string]interface{}{}
+	var err error
+	if left != nil && !reflect.ValueOf(left).IsNil() {
+		if l, err = exportUnstructured(left, scheme); err != nil {
+			return nil, err
+		}
+	}
+	if right != nil && !reflect.ValueOf(right).IsNil() {
+		if r, err = exportUnstructured(right, scheme); err != nil {
+			return nil, err
+		}
+	}
+
+	changes := []FieldChange{}
+	diffFields("", l, r, &changes)
+	return changes, nil
+}
+
+func diffFields(path string, left, right interface{}, changes *[]FieldChange) {
+	leftMap, leftIsMap := left.(map[string]interface{})
+	rightMap, rightIsMap := right.(map[string]interface{})
+	if leftIsMap && rightIsMap {
+		keys := []string{}
+		for k := range leftMap {
+			keys = append(keys, k)
+		}
+		for k := range rightMap {
+			if _, ok := leftMap[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			l, inLeft := leftMap[k]
+			r, inRight := rightMap[k]
+			fieldPath := appendFieldPath(path, k)
+			switch {
+			case !inLeft:
+				*changes = append(*changes, FieldChange{Path: fieldPath, Operation: FieldChangeAdded})
+			case !inRight:
+				*changes = append(*changes, FieldChange{Path: fieldPath, Operation: FieldChangeRemoved})
+			default:
+				diffFields(fieldPath, l, r, changes)
+			}
+		}
+		return
+	}
+
+	leftSlice, leftIsSlice := left.([]interface{})
+	rightSlice, rightIsSlice := right.([]interface{})
+	if leftIsSlice && rightIsSlice {
+		for i := 0; i < max(len(leftSlice), len(rightSlice)); i++ {
+			itemPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(leftSlice):
+				*changes = append(*changes, FieldChange{Path: itemPath, Operation: FieldChangeAdded})
+			case i >= len(rightSlice):
+				*changes = append(*changes, FieldChange{Path: itemPath, Operation: FieldChangeRemoved})
+			default:
+				diffFields(itemPath, leftSlice[i], rightSlice[i], changes)
+			}
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(left, right) {
+		operation := FieldChangeModified
+		if left == nil {
+			operation = FieldChangeAdded
+		} else if right == nil {
+			operation = FieldChangeRemoved
+		}
+		*changes = append(*changes, FieldChange{Path: path, Operation: operation})
+	}
+}
+
+func appendFieldPath(path, key string) string {
+	if strings.ContainsAny(key, "./[]") {
+		return fmt.Sprintf("%s[%q]", path, key)
+	}
+	if path == "" {
+		return key
+	}
+	return fmt.Sprintf("%s.%s", path, key)
+}
+
 func inContext(lineNum int, diff []difflib.DiffRecord) bool {
 	start := max(0, lineNum-DiffContextToShow)
 	end := min(len(diff), lineNum+DiffContextToShow+1)
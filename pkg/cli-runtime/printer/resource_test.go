@@ -438,6 +438,53 @@ status:
 	}
 }
 `,
+	}, {
+		name:         "print output with name",
+		outputFormat: printer.OutputFormatName,
+		obj: &cartov1alpha1.Workload{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-workload", Namespace: "default"},
+		},
+		want: "workload.carto.run/my-workload",
+	}, {
+		name:         "print output with jsonpath",
+		outputFormat: "jsonpath={.metadata.namespace}/{.metadata.name} {.status.conditions[?(@.type==\"Ready\")].status}",
+		obj: &cartov1alpha1.Workload{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-workload", Namespace: "default"},
+			Status: cartov1alpha1.WorkloadStatus{
+				Conditions: []metav1.Condition{
+					{Type: cartov1alpha1.WorkloadConditionReady, Status: metav1.ConditionTrue},
+				},
+			},
+		},
+		want: "default/my-workload True",
+	}, {
+		name:         "print output with go-template",
+		outputFormat: "go-template={{.kind}} {{.metadata.name}} {{.metadata.labels.missing}}",
+		obj: &cartov1alpha1.Workload{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-workload", Namespace: "default"},
+		},
+		want: "Workload my-workload <no value>",
+	}, {
+		name:         "print output with custom-columns",
+		outputFormat: "custom-columns=NAME:.metadata.name,TYPE:{.metadata.labels.apps\\.tanzu\\.vmware\\.com/workload-type},IMAGE:.spec.image",
+		obj: &cartov1alpha1.Workload{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-workload",
+				Namespace: "default",
+				Labels: map[string]string{
+					"apps.tanzu.vmware.com/workload-type": "web",
+				},
+			},
+		},
+		want: `
+NAME          TYPE   IMAGE
+my-workload   web    <none>
+`,
+	}, {
+		name:         "print output with invalid jsonpath",
+		outputFormat: "jsonpath={.metadata.name",
+		obj:          &cartov1alpha1.Workload{},
+		shouldError:  true,
 	}, {
 		name:         "not valid output",
 		outputFormat: "myFormat",
@@ -817,6 +864,45 @@ func TestOutputResources(t *testing.T) {
 		objs:         []printer.Object{},
 		want: `---
 []`,
+	}, {
+		name:         "print output with name",
+		outputFormat: printer.OutputFormatName,
+		objs: []printer.Object{
+			&cartov1alpha1.Workload{ObjectMeta: metav1.ObjectMeta{Name: "my-workload", Namespace: "default"}},
+			&cartov1alpha1.Workload{ObjectMeta: metav1.ObjectMeta{Name: "another-workload", Namespace: "default"}},
+		},
+		want: `
+workload.carto.run/my-workload
+workload.carto.run/another-workload
+`,
+	}, {
+		name:         "print output with jsonpath",
+		outputFormat: `jsonpath={range [*]}{.metadata.name}{"\n"}{end}`,
+		objs: []printer.Object{
+			&cartov1alpha1.Workload{ObjectMeta: metav1.ObjectMeta{Name: "my-workload", Namespace: "default"}},
+			&cartov1alpha1.Workload{ObjectMeta: metav1.ObjectMeta{Name: "another-workload", Namespace: "default"}},
+		},
+		want: `
+my-workload
+another-workload
+`,
+	}, {
+		name:         "print output with custom-columns",
+		outputFormat: "custom-columns=NAMESPACE:.metadata.namespace,NAME:.metadata.name",
+		objs: []printer.Object{
+			&cartov1alpha1.Workload{ObjectMeta: metav1.ObjectMeta{Name: "my-workload", Namespace: "default"}},
+			&cartov1alpha1.Workload{ObjectMeta: metav1.ObjectMeta{Name: "another-workload", Namespace: "dev"}},
+		},
+		want: `
+NAMESPACE   NAME
+default     my-workload
+dev         another-workload
+`,
+	}, {
+		name:         "empty list with custom-columns",
+		outputFormat: "custom-columns=NAME:.metadata.name",
+		objs:         []printer.Object{},
+		want:         "",
 	}, {
 		name:         "not valid output",
 		outputFormat: "myFormat",
@@ -986,3 +1072,111 @@ func TestResourceDiff(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceDiffFields(t *testing.T) {
+	scheme := runtime.NewScheme()
+	cartov1alpha1.AddToScheme(scheme)
+
+	tests := []struct {
+		name        string
+		left        printer.Object
+		right       printer.Object
+		want        []printer.FieldChange
+		shouldError bool
+	}{{
+		name: "changed fields",
+		left: &cartov1alpha1.Workload{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "change",
+				Labels: map[string]string{
+					apis.AppPartOfLabelName: "my-app",
+				},
+			},
+			Spec: cartov1alpha1.WorkloadSpec{
+				Image: "ubuntu:bionic",
+				Env: []corev1.EnvVar{
+					{Name: "FOO", Value: "foo"},
+					{Name: "BAR", Value: "bar"},
+				},
+			},
+		},
+		right: &cartov1alpha1.Workload{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "change",
+				Labels: map[string]string{
+					apis.WorkloadTypeLabelName: "web",
+				},
+			},
+			Spec: cartov1alpha1.WorkloadSpec{
+				Image: "ubuntu:focal",
+				Env: []corev1.EnvVar{
+					{Name: "FOO", Value: "baz"},
+				},
+			},
+		},
+		want: []printer.FieldChange{
+			{Path: `metadata.labels["app.kubernetes.io/part-of"]`, Operation: printer.FieldChangeRemoved},
+			{Path: `metadata.labels["apps.tanzu.vmware.com/workload-type"]`, Operation: printer.FieldChangeAdded},
+			{Path: "spec.env[0].value", Operation: printer.FieldChangeModified},
+			{Path: "spec.env[1]", Operation: printer.FieldChangeRemoved},
+			{Path: "spec.image", Operation: printer.FieldChangeModified},
+		},
+	}, {
+		name: "no changes",
+		left: &cartov1alpha1.Workload{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       "default",
+				Name:            "no-change",
+				ResourceVersion: "1",
+			},
+			Spec: cartov1alpha1.WorkloadSpec{
+				Image: "ubuntu:bionic",
+			},
+		},
+		right: &cartov1alpha1.Workload{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "no-change",
+			},
+			Spec: cartov1alpha1.WorkloadSpec{
+				Image: "ubuntu:bionic",
+			},
+		},
+		want: []printer.FieldChange{},
+	}, {
+		name: "missing left",
+		right: &cartov1alpha1.Workload{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "new",
+			},
+			Spec: cartov1alpha1.WorkloadSpec{
+				Image: "ubuntu:bionic",
+			},
+		},
+		want: []printer.FieldChange{
+			{Path: "apiVersion", Operation: printer.FieldChangeAdded},
+			{Path: "kind", Operation: printer.FieldChangeAdded},
+			{Path: "metadata", Operation: printer.FieldChangeAdded},
+			{Path: "spec", Operation: printer.FieldChangeAdded},
+		},
+	}, {
+		name:        "unknown type",
+		left:        &corev1.ConfigMap{},
+		right:       &corev1.ConfigMap{},
+		shouldError: true,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := printer.ResourceDiffFields(test.left, test.right, scheme)
+			if (err != nil) != test.shouldError {
+				t.Errorf("ResourceDiffFields() error = %v, expected %v", err, test.shouldError)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("ResourceDiffFields() (-want, +got) = %v", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package table
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+var relaxedJSONPathRegexp = regexp.MustCompile(`^\{\.?([^{}]+)\}$|^\.?([^{}]+)$`)
+
+// CustomColumn is a column whose cells are the result of evaluating a JSONPath
+// expression against each object
+type CustomColumn struct {
+	Header    string
+	FieldSpec string
+}
+
+// ParseCustomColumns parses a comma separated list of columns in the form
+// HEADER:.json.path, for example "NAME:.metadata.name,TYPE:.metadata.labels.type"
+func ParseCustomColumns(spec string) ([]CustomColumn, error) {
+	if spec == "" {
+		return nil, fmt.Errorf("custom-columns format specified but no custom columns given")
+	}
+	parts := strings.Split(spec, ",")
+	columns := make([]CustomColumn, len(parts))
+	for i, part := range parts {
+		header, fieldSpec, found := strings.Cut(part, ":")
+		if !found || header == "" || fieldSpec == "" {
+			return nil, fmt.Errorf("unexpected custom-columns spec %q, expected <header>:<json-path-expr>", part)
+		}
+		expression, err := relaxedJSONPathExpression(fieldSpec)
+		if err != nil {
+			return nil, err
+		}
+		if err := jsonpath.New(header).Parse(expression); err != nil {
+			return nil, fmt.Errorf("invalid JSONPath expression %q for column %q: %v", fieldSpec, header, err)
+		}
+		columns[i] = CustomColumn{Header: header, FieldSpec: expression}
+	}
+	return columns, nil
+}
+
+// CustomColumnsTable builds a table with a row for each item. Items are expected to
+// be the generic json representation of an object, as decoded by encoding/json.
+// Missing fields are displayed as <none>.
+func CustomColumnsTable(columns []CustomColumn, items []interface{}) (*metav1beta1.Table, error) {
+	table := &metav1beta1.Table{}
+	parsers := make([]*jsonpath.JSONPath, len(columns))
+	for i, column := range columns {
+		table.ColumnDefinitions = append(table.ColumnDefinitions, metav1beta1.TableColumnDefinition{Name: column.Header, Type: "string"})
+		parsers[i] = jsonpath.New(column.Header).AllowMissingKeys(true)
+		if err := parsers[i].Parse(column.FieldSpec); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, item := range items {
+		row := metav1beta1.TableRow{}
+		for _, parser := range parsers {
+			results, err := parser.FindResults(item)
+			if err != nil {
+				return nil, err
+			}
+			values := []string{}
+			for _, result := range results {
+				for _, value := range result {
+					values = append(values, fmt.Sprintf("%v", value.Interface()))
+				}
+			}
+			cell := "<none>"
+			if len(values) != 0 {
+				cell = strings.Join(values, ",")
+			}
+			row.Cells = append(row.Cells, cell)
+		}
+		table.Rows = append(table.Rows, row)
+	}
+
+	return table, nil
+}
+
+// relaxedJSONPathExpression accepts "name1.name2", ".name1.name2", "{name1.name2}" and
+// "{.name1.name2}" and returns the canonical "{.name1.name2}" expression
+func relaxedJSONPathExpression(expression string) (string, error) {
+	submatches := relaxedJSONPathRegexp.FindStringSubmatch(expression)
+	if submatches == nil {
+		return "", fmt.Errorf("unexpected path %q, expected a 'name1.name2' or '.name1.name2' or '{name1.name2}' or '{.name1.name2}'", expression)
+	}
+	fieldSpec := submatches[1]
+	if fieldSpec == "" {
+		fieldSpec = submatches[2]
+	}
+	return fmt.Sprintf("{.%s}", fieldSpec), nil
+}
@@ -160,6 +160,10 @@ type CommandTestCase struct {
 	// a diff of any changes. The comparison is ignored for empty strings and ignores a leading
 	// new line.
 	ExpectOutput string
+	// ExpectStderr performs a direct comparison of this content with what the command wrote to
+	// stderr. When set, stderr is captured on its own and ExpectOutput only covers stdout. The
+	// comparison is ignored for empty strings and ignores a leading new line.
+	ExpectStderr string
 	// Verify provides the command output and error for custom assertions.
 	Verify func(t *testing.T, output string, err error)
 
@@ -248,26 +252,34 @@ func (tc CommandTestCase) Run(t *testing.T, scheme *k8sruntime.Scheme, cmdFactor
 
 		// set up a fake builder that operates on generic objects. Testing should access the unstructured fake client
 		// with the POD details as http response.
-		c.Builder = resource.NewFakeBuilder(
-			func(version schema.GroupVersion) (resource.RESTClient, error) {
-				codec := k8sscheme.Codecs.LegacyCodec(scheme.PrioritizedVersionsAllGroups()...)
-				UnstructuredClient := &fake.RESTClient{
-					NegotiatedSerializer: resource.UnstructuredPlusDefaultContentConfig().NegotiatedSerializer,
-					Resp:                 &http.Response{StatusCode: http.StatusOK, Header: DefaultHeader(), Body: PodV1TableObjBody(codec, tc.BuilderObjects)},
-				}
-				return UnstructuredClient, nil
-
-			},
-			c.ToRESTMapper,
-			func() (restmapper.CategoryExpander, error) {
-				return resource.FakeCategoryExpander, nil
-			},
-		)
+		newFakeBuilder := func() *resource.Builder {
+			return resource.NewFakeBuilder(
+				func(version schema.GroupVersion) (resource.RESTClient, error) {
+					codec := k8sscheme.Codecs.LegacyCodec(scheme.PrioritizedVersionsAllGroups()...)
+					UnstructuredClient := &fake.RESTClient{
+						NegotiatedSerializer: resource.UnstructuredPlusDefaultContentConfig().NegotiatedSerializer,
+						Resp:                 &http.Response{StatusCode: http.StatusOK, Header: DefaultHeader(), Body: PodV1TableObjBody(codec, tc.BuilderObjects)},
+					}
+					return UnstructuredClient, nil
+
+				},
+				c.ToRESTMapper,
+				func() (restmapper.CategoryExpander, error) {
+					return resource.FakeCategoryExpander, nil
+				},
+			)
+		}
+		c.Builder = newFakeBuilder()
+		c.NewBuilder = newFakeBuilder
 
 		c.Stdin = bytes.NewBuffer(tc.Stdin)
 		output := &bytes.Buffer{}
 		c.Stdout = output
 		c.Stderr = output
+		stderr := &bytes.Buffer{}
+		if tc.ExpectStderr != "" {
+			c.Stderr = stderr
+		}
 		if tc.ShouldPanic {
 			defer func() {
 				if r := recover(); r == nil {
@@ -330,6 +342,14 @@ func (tc CommandTestCase) Run(t *testing.T, scheme *k8sruntime.Scheme, cmdFactor
 			}
 		}
 
+		if tc.ExpectStderr != "" {
+			stderrString := strings.ReplaceAll(stderr.String(), pkg.CR, "")
+			tc.ExpectStderr = strings.ReplaceAll(tc.ExpectStderr, pkg.CR, "")
+			if diff := cmp.Diff(strings.TrimPrefix(tc.ExpectStderr, pkg.LF), stderrString); diff != "" {
+				t.Errorf("Unexpected stderr (-expected, +actual): %s", diff)
+			}
+		}
+
 		if tc.Verify != nil {
 			tc.Verify(t, outputString, cmdErr)
 		}
@@ -34,3 +34,9 @@ func ErrMultipleSources(names ...string) FieldErrors {
 		k8sfield.Required(k8sfield.NewPath(fmt.Sprintf("[%s]", strings.Join(names, ", "))), "expected exactly one, got multiple"),
 	}
 }
+
+func ErrForbiddenFieldWithDetail(field string, detail string) FieldErrors {
+	return FieldErrors{
+		k8sfield.Forbidden(k8sfield.NewPath(field), detail),
+	}
+}
@@ -96,3 +96,34 @@ func TestErrMultipleSources(t *testing.T) {
 		})
 	}
 }
+
+func TestErrForbiddenFieldWithDetail(t *testing.T) {
+	tests := []struct {
+		testName string
+		field    string
+		msg      string
+		expected validation.FieldErrors
+	}{
+		{
+			testName: "valid",
+			expected: validation.FieldErrors{k8sfield.Forbidden(k8sfield.NewPath(flags.LocalPathFlagName), "")},
+			field:    flags.LocalPathFlagName,
+			msg:      "",
+		}, {
+			testName: "valid with msg",
+			expected: validation.FieldErrors{k8sfield.Forbidden(k8sfield.NewPath(flags.LocalPathFlagName), "not supported with multiple workloads")},
+			field:    flags.LocalPathFlagName,
+			msg:      "not supported with multiple workloads",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			expected := test.expected
+			actual := validation.ErrForbiddenFieldWithDetail(test.field, test.msg)
+			if diff := cmp.Diff(expected, actual); diff != "" {
+				t.Errorf("%s() = (-expected, +actual): %s", test.testName, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+)
+
+// OutputFormat validates the type of the format is one of the valid formats, and that the
+// template or columns following the type can be parsed
+func OutputFormat(format string, field string, validFormats []string) FieldErrors {
+	errs := FieldErrors{}
+
+	f := printer.OutputFormat(format)
+	if !contains(f.Type(), validFormats) {
+		return errs.Also(EnumInvalidValue(format, field, validFormats))
+	}
+	if err := f.Validate(); err != nil {
+		errs = errs.Also(FieldErrors{
+			k8sfield.Invalid(k8sfield.NewPath(field), format, err.Error()),
+		})
+	}
+
+	return errs
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+)
+
+func TestOutputFormat(t *testing.T) {
+	validFormats := []string{"json", "name", "custom-columns", "jsonpath", "go-template", "go-template-file"}
+	tests := []struct {
+		name     string
+		expected validation.FieldErrors
+		value    string
+	}{{
+		name:     "valid",
+		expected: validation.FieldErrors{},
+		value:    "json",
+	}, {
+		name:     "valid jsonpath",
+		expected: validation.FieldErrors{},
+		value:    "jsonpath={.metadata.name}",
+	}, {
+		name:     "valid go-template",
+		expected: validation.FieldErrors{},
+		value:    "go-template={{.metadata.name}}",
+	}, {
+		name:     "valid custom-columns",
+		expected: validation.FieldErrors{},
+		value:    "custom-columns=NAME:.metadata.name,READY:{.status.conditions[0].status}",
+	}, {
+		name:     "unsupported",
+		expected: validation.EnumInvalidValue("wide", clitesting.TestField, validFormats),
+		value:    "wide",
+	}, {
+		name:     "unsupported with argument",
+		expected: validation.EnumInvalidValue("template={{.}}", clitesting.TestField, validFormats),
+		value:    "template={{.}}",
+	}, {
+		name: "missing jsonpath expression",
+		expected: validation.FieldErrors{
+			k8sfield.Invalid(k8sfield.NewPath(clitesting.TestField), "jsonpath", "jsonpath format specified but no expression given"),
+		},
+		value: "jsonpath",
+	}, {
+		name: "invalid jsonpath",
+		expected: validation.FieldErrors{
+			k8sfield.Invalid(k8sfield.NewPath(clitesting.TestField), "jsonpath={.metadata.name", "error parsing jsonpath {.metadata.name: unclosed action"),
+		},
+		value: "jsonpath={.metadata.name",
+	}, {
+		name: "invalid go-template",
+		expected: validation.FieldErrors{
+			k8sfield.Invalid(k8sfield.NewPath(clitesting.TestField), "go-template={{.metadata.name", `error parsing template {{.metadata.name: template: go-template:1: unclosed action`),
+		},
+		value: "go-template={{.metadata.name",
+	}, {
+		name: "missing template file",
+		expected: validation.FieldErrors{
+			k8sfield.Invalid(k8sfield.NewPath(clitesting.TestField), "go-template-file=testdata/missing.tmpl", "error reading template testdata/missing.tmpl: open testdata/missing.tmpl: no such file or directory"),
+		},
+		value: "go-template-file=testdata/missing.tmpl",
+	}, {
+		name: "invalid custom-columns",
+		expected: validation.FieldErrors{
+			k8sfield.Invalid(k8sfield.NewPath(clitesting.TestField), "custom-columns=NAME", `unexpected custom-columns spec "NAME", expected <header>:<json-path-expr>`),
+		},
+		value: "custom-columns=NAME",
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expected := test.expected
+			actual := validation.OutputFormat(test.value, clitesting.TestField, validFormats)
+			if diff := cmp.Diff(expected, actual); diff != "" {
+				t.Errorf("%s() = (-expected, +actual): %s", test.name, diff)
+			}
+		})
+	}
+}
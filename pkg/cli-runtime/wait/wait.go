@@ -70,10 +70,10 @@ func UntilCondition(ctx context.Context, watchClient client.WithWatch, target ty
 			// the workload apply flows through supply chain steps to rerun, which may result in the workload status
 			// switching between Ready - unknown - Ready - unknown, and so on.
 			// The delay timer provides an option to allow the supply chain to parse through the steps before exiting the tail.
+			// The timer is only started again once the condition is met, so a zero delay does not
+			// result in a busy loop while waiting for the next event.
 			if readyStatus {
 				return nil
-			} else {
-				timer.Reset(delayTime)
 			}
 		case <-ctx.Done():
 			return ctx.Err()
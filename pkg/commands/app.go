@@ -0,0 +1,44 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+)
+
+func NewAppCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "app",
+		Short: "Applications made of several workloads",
+		Long: strings.TrimSpace(`
+An application groups the workloads that share the same app.kubernetes.io/part-of label. The
+application commands aggregate the status of those workloads, so an application can be
+inspected at once instead of one workload at a time.
+`),
+		Aliases: []string{"apps", "application", "applications"},
+	}
+
+	cmd.AddCommand(NewAppListCommand(ctx, c))
+	cmd.AddCommand(NewAppGetCommand(ctx, c))
+
+	return cmd
+}
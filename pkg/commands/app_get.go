@@ -0,0 +1,218 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	cliprinter "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+type AppGetOptions struct {
+	Namespace string
+	Name      string
+	Output    string
+}
+
+var (
+	_ validation.Validatable = (*AppGetOptions)(nil)
+	_ cli.Executable         = (*AppGetOptions)(nil)
+)
+
+// AppDetail is the machine readable form of an application
+type AppDetail struct {
+	Name      string        `json:"name"`
+	Namespace string        `json:"namespace"`
+	Ready     bool          `json:"ready"`
+	Workloads []AppWorkload `json:"workloads"`
+}
+
+// AppWorkload is the status of a workload part of an application
+type AppWorkload struct {
+	Name        string                   `json:"name"`
+	Type        string                   `json:"type"`
+	Ready       bool                     `json:"ready"`
+	SupplyChain string                   `json:"supplyChain"`
+	URLs        []string                 `json:"urls"`
+	Issues      []printer.ConditionIssue `json:"issues"`
+}
+
+func (opts *AppGetOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	if opts.Namespace == "" {
+		errs = errs.Also(validation.ErrMissingField(flags.NamespaceFlagName))
+	}
+
+	if opts.Name == "" {
+		errs = errs.Also(validation.ErrMissingField(cli.NameArgumentName))
+	} else {
+		errs = errs.Also(validation.K8sLabelValue(opts.Name, cli.NameArgumentName))
+	}
+
+	if opts.Output != "" {
+		errs = errs.Also(validation.OutputFormat(opts.Output, flags.OutputFlagName, printer.ObjectOutputFormats))
+	}
+
+	return errs
+}
+
+func (opts *AppGetOptions) Exec(ctx context.Context, c *cli.Config) error {
+	workloads := &cartov1alpha1.WorkloadList{}
+	if err := c.List(ctx, workloads, client.InNamespace(opts.Namespace), client.MatchingLabels{apis.AppPartOfLabelName: opts.Name}); err != nil {
+		return err
+	}
+	if len(workloads.Items) == 0 {
+		nsGet := &corev1.Namespace{}
+		if getErr := c.Get(ctx, types.NamespacedName{Name: opts.Namespace}, nsGet); getErr != nil && apierrs.IsNotFound(getErr) {
+			c.Eprintf("%s %s\n", printer.Serrorf("Error:"), fmt.Sprintf("namespace %q not found, it may not exist or user does not have permissions to read it.", opts.Namespace))
+			return cli.SilenceError(getErr)
+		}
+		c.Errorf("Application %q not found\n", fmt.Sprintf("%s/%s", opts.Namespace, opts.Name))
+		return cli.SilenceError(fmt.Errorf("no workloads found for app %q", opts.Name))
+	}
+	printer.SortByNamespaceAndName(workloads.Items)
+
+	urls := workloadURLs(ctx, c, opts.Namespace)
+	app := &AppDetail{
+		Name:      opts.Name,
+		Namespace: opts.Namespace,
+		Ready:     true,
+		Workloads: make([]AppWorkload, len(workloads.Items)),
+	}
+	issues := map[string][]printer.ConditionIssue{}
+	readiness := map[types.NamespacedName]bool{}
+	readyWorkloads := 0
+	for i := range workloads.Items {
+		workload := &workloads.Items[i]
+		ready, workloadIssues := workloadReadiness(workload, getWorkloadDeliverable(ctx, c, workload))
+		readiness[types.NamespacedName{Namespace: workload.Namespace, Name: workload.Name}] = ready
+		if ready {
+			readyWorkloads++
+		}
+		workloadURLs := urls[types.NamespacedName{Namespace: workload.Namespace, Name: workload.Name}]
+		if workloadURLs == nil {
+			workloadURLs = []string{}
+		}
+		app.Workloads[i] = AppWorkload{
+			Name:        workload.Name,
+			Type:        workload.Labels[apis.WorkloadTypeLabelName],
+			Ready:       ready,
+			SupplyChain: workload.Status.SupplyChainRef.Name,
+			URLs:        workloadURLs,
+			Issues:      workloadIssues,
+		}
+		app.Ready = app.Ready && ready
+		if len(workloadIssues) != 0 {
+			issues[workload.Name] = workloadIssues
+		}
+	}
+
+	if opts.Output != "" {
+		export, err := printer.OutputObject(app, printer.OutputFormat(opts.Output))
+		if err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Failed to output application:"), err)
+			return cli.SilenceError(err)
+		}
+		c.Printf("%s\n", export)
+		return nil
+	}
+
+	c.Emoji(cli.Antenna, cliprinter.Sboldf("Overview\n"))
+	if err := printer.AppOverviewPrinter(c.Stdout, opts.Name, opts.Namespace, workloads, readyWorkloads); err != nil {
+		return err
+	}
+
+	c.Printf("\n")
+	c.Emoji(cli.Package, cliprinter.Sboldf("Workloads\n"))
+	if err := printer.AppWorkloadsPrinter(c.Stdout, workloads, readiness, urls); err != nil {
+		return err
+	}
+
+	c.Printf("\n")
+	c.Emoji(cli.SpeechBalloon, cliprinter.Sboldf("Messages\n"))
+	if len(issues) == 0 {
+		c.Infof(printer.AddPaddingStart("No messages found.\n"))
+	} else if err := printer.AppMessagesPrinter(c.Stdout, issues); err != nil {
+		return err
+	}
+
+	c.Printf("\n")
+	if opts.Namespace != c.Client.DefaultNamespace() {
+		c.Infof("To see the details of a workload: \"tanzu apps workload get <name> %s %s\"\n", flags.NamespaceFlagName, opts.Namespace)
+	} else {
+		c.Infof("To see the details of a workload: \"tanzu apps workload get <name>\"\n")
+	}
+	c.Printf("\n")
+
+	return nil
+}
+
+// readyAppWorkloads returns how many of the workloads are ready, a workload is ready when it and
+// its deliverable are. This is the readiness reported for each workload by app get
+func readyAppWorkloads(ctx context.Context, c *cli.Config, workloads []cartov1alpha1.Workload) int {
+	ready := 0
+	for i := range workloads {
+		if ok, _ := workloadReadiness(&workloads[i], getWorkloadDeliverable(ctx, c, &workloads[i])); ok {
+			ready++
+		}
+	}
+	return ready
+}
+
+func NewAppGetCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &AppGetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Get the status of the workloads of an application",
+		Long: strings.TrimSpace(`
+Get the status of all the workloads part of an application: whether each workload and its
+deliverable are ready, the supply chain selecting it, the urls of its Knative services and the
+messages reported by the workloads that are not ready.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s app get spring-petclinic", c.Name),
+			fmt.Sprintf("%s app get spring-petclinic %s json", c.Name, flags.OutputFlagName),
+		}, "\n"),
+		PreRunE: cli.ValidateE(ctx, opts),
+		RunE:    cli.ExecE(ctx, c, opts),
+	}
+
+	cli.Args(cmd,
+		cli.NameArg(&opts.Name),
+	)
+
+	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the application formatted. Supported formats: \"json\", \"yaml\", \"yml\", \"custom-columns=<columns>\", \"jsonpath=<expression>\", \"go-template=<template>\", \"go-template-file=<path>\"")
+
+	return cmd
+}
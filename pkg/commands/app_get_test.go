@@ -0,0 +1,354 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"testing"
+
+	diecorev1 "dies.dev/apis/core/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	knativeservingv1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/knative/serving/v1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	diev1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/knative/serving/v1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+func TestAppGetOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:        "invalid empty",
+			Validatable: &commands.AppGetOptions{},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMissingField(flags.NamespaceFlagName),
+				validation.ErrMissingField(cli.NameArgumentName),
+			),
+		},
+		{
+			Name: "valid",
+			Validatable: &commands.AppGetOptions{
+				Namespace: "default",
+				Name:      "my-app",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid name",
+			Validatable: &commands.AppGetOptions{
+				Namespace: "default",
+				Name:      "my-",
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("my-", cli.NameArgumentName),
+		},
+		{
+			Name: "valid output format",
+			Validatable: &commands.AppGetOptions{
+				Namespace: "default",
+				Name:      "my-app",
+				Output:    "json",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid output format",
+			Validatable: &commands.AppGetOptions{
+				Namespace: "default",
+				Name:      "my-app",
+				Output:    "wide",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("wide", flags.OutputFlagName, printer.ObjectOutputFormats),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestAppGetCommand(t *testing.T) {
+	defaultNamespace := "default"
+	appName := "my-app"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	_ = knativeservingv1.AddToScheme(scheme)
+
+	apiWorkload := diecartov1alpha1.WorkloadBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("api")
+			d.Namespace(defaultNamespace)
+			d.AddLabel(apis.AppPartOfLabelName, appName)
+			d.AddLabel(apis.WorkloadTypeLabelName, "web")
+		}).
+		StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+			d.SupplyChainRef(cartov1alpha1.ObjectReference{Kind: "ClusterSupplyChain", Name: "source-to-url"})
+			d.ConditionsDie(
+				diecartov1alpha1.WorkloadConditionReadyBlank.
+					Status(metav1.ConditionTrue).
+					Reason("Ready"),
+			)
+		})
+	uiWorkload := diecartov1alpha1.WorkloadBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("ui")
+			d.Namespace(defaultNamespace)
+			d.AddLabel(apis.AppPartOfLabelName, appName)
+			d.AddLabel(apis.WorkloadTypeLabelName, "web")
+		}).
+		StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+			d.SupplyChainRef(cartov1alpha1.ObjectReference{Kind: "ClusterSupplyChain", Name: "source-to-url"})
+			d.ConditionsDie(
+				diecartov1alpha1.WorkloadConditionReadyBlank.
+					Status(metav1.ConditionFalse).
+					Reason("MissingValueAtPath").
+					Message("waiting to read value [.status.latestImage] from resource [image.kpack.io/ui] in namespace [default]"),
+			)
+		})
+	otherWorkload := diecartov1alpha1.WorkloadBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("other")
+			d.Namespace(defaultNamespace)
+			d.AddLabel(apis.AppPartOfLabelName, "other-app")
+		})
+	apiWorkloadWithDeliverable := apiWorkload.
+		StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+			d.Resources(
+				diecartov1alpha1.RealizedResourceBlank.
+					Name("deliverable").
+					StampedRef(&cartov1alpha1.StampedRef{
+						ObjectReference: &corev1.ObjectReference{
+							Kind:      cartov1alpha1.DeliverableKind,
+							Namespace: defaultNamespace,
+							Name:      "api",
+						},
+					}).
+					DieRelease(),
+			)
+		})
+	apiDeliverable := diecartov1alpha1.DeliverableBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("api")
+			d.Namespace(defaultNamespace)
+		}).
+		StatusDie(func(d *diecartov1alpha1.DeliverableStatusDie) {
+			d.ConditionsDie(
+				diecartov1alpha1.CreateConditionReadyFalse("TemplateRejectedByAPIServer", "unable to apply object"),
+			)
+		})
+	apiKsvc := diev1.ServiceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("api")
+			d.Namespace(defaultNamespace)
+			d.AddLabel(cartov1alpha1.WorkloadLabelName, "api")
+		}).
+		StatusDie(func(d *diev1.ServiceStatusDie) {
+			d.URL("https://api.example.com")
+		})
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "invalid args",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name:         "show app",
+			Args:         []string{appName},
+			GivenObjects: []client.Object{apiWorkload, uiWorkload, otherWorkload, apiKsvc},
+			ExpectOutput: `
+📡 Overview
+   name:        my-app
+   namespace:   default
+   workloads:   1/2 ready
+
+📦 Workloads
+   NAME   TYPE   READY                SUPPLY CHAIN    URL
+   api    web    Ready                source-to-url   https://api.example.com
+   ui     web    MissingValueAtPath   source-to-url   <empty>
+
+💬 Messages
+   ui    Workload [MissingValueAtPath]:   waiting to read value [.status.latestImage] from resource [image.kpack.io/ui] in namespace [default]
+
+To see the details of a workload: "tanzu apps workload get <name>"
+
+`,
+		},
+		{
+			Name:         "show app with all workloads ready",
+			Args:         []string{appName},
+			GivenObjects: []client.Object{apiWorkload, apiKsvc},
+			ExpectOutput: `
+📡 Overview
+   name:        my-app
+   namespace:   default
+   workloads:   1/1 ready
+
+📦 Workloads
+   NAME   TYPE   READY   SUPPLY CHAIN    URL
+   api    web    Ready   source-to-url   https://api.example.com
+
+💬 Messages
+   No messages found.
+
+To see the details of a workload: "tanzu apps workload get <name>"
+
+`,
+		},
+		{
+			Name:         "show app with deliverable not ready",
+			Args:         []string{appName},
+			GivenObjects: []client.Object{apiWorkloadWithDeliverable, apiDeliverable, apiKsvc},
+			ExpectOutput: `
+📡 Overview
+   name:        my-app
+   namespace:   default
+   workloads:   0/1 ready
+
+📦 Workloads
+   NAME   TYPE   READY       SUPPLY CHAIN    URL
+   api    web    not-Ready   source-to-url   https://api.example.com
+
+💬 Messages
+   api   Deliverable [TemplateRejectedByAPIServer]:   unable to apply object
+
+To see the details of a workload: "tanzu apps workload get <name>"
+
+`,
+		},
+		{
+			Name: "show app in other namespace",
+			Args: []string{appName, flags.NamespaceFlagName, "my-namespace"},
+			GivenObjects: []client.Object{
+				apiWorkload.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Namespace("my-namespace")
+					}),
+			},
+			ExpectOutput: `
+📡 Overview
+   name:        my-app
+   namespace:   my-namespace
+   workloads:   1/1 ready
+
+📦 Workloads
+   NAME   TYPE   READY   SUPPLY CHAIN    URL
+   api    web    Ready   source-to-url   <empty>
+
+💬 Messages
+   No messages found.
+
+To see the details of a workload: "tanzu apps workload get <name> --namespace my-namespace"
+
+`,
+		},
+		{
+			Name:         "app in json format",
+			Args:         []string{appName, flags.OutputFlagName, printer.OutputFormatJson},
+			GivenObjects: []client.Object{apiWorkload, uiWorkload, otherWorkload, apiKsvc},
+			ExpectOutput: `
+{
+	"name": "my-app",
+	"namespace": "default",
+	"ready": false,
+	"workloads": [
+		{
+			"name": "api",
+			"type": "web",
+			"ready": true,
+			"supplyChain": "source-to-url",
+			"urls": [
+				"https://api.example.com"
+			],
+			"issues": []
+		},
+		{
+			"name": "ui",
+			"type": "web",
+			"ready": false,
+			"supplyChain": "source-to-url",
+			"urls": [],
+			"issues": [
+				{
+					"kind": "Workload",
+					"reason": "MissingValueAtPath",
+					"message": "waiting to read value [.status.latestImage] from resource [image.kpack.io/ui] in namespace [default]"
+				}
+			]
+		}
+	]
+}
+`,
+		},
+		{
+			Name:         "app with jsonpath",
+			Args:         []string{appName, flags.OutputFlagName, "jsonpath={.ready}"},
+			GivenObjects: []client.Object{apiWorkload, apiKsvc},
+			ExpectOutput: `
+true
+`,
+		},
+		{
+			Name: "app not found",
+			Args: []string{appName},
+			GivenObjects: []client.Object{
+				otherWorkload,
+				diecorev1.NamespaceBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(defaultNamespace)
+					}),
+			},
+			ShouldError: true,
+			ExpectOutput: `
+Application "default/my-app" not found
+`,
+		},
+		{
+			Name: "namespace not found",
+			Args: []string{appName, flags.NamespaceFlagName, "my-namespace"},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "Namespace", clitesting.InduceFailureOpts{
+					Error: apierrors.NewNotFound(corev1.Resource("Namespace"), "my-namespace"),
+				}),
+			},
+			ShouldError: true,
+			ExpectOutput: `
+Error: namespace "my-namespace" not found, it may not exist or user does not have permissions to read it.
+`,
+		},
+		{
+			Name: "list error",
+			Args: []string{appName},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("list", "WorkloadList"),
+			},
+			ShouldError: true,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewAppGetCommand)
+}
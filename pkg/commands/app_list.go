@@ -0,0 +1,184 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+type AppListOptions struct {
+	Namespace     string
+	AllNamespaces bool
+	Output        string
+}
+
+var (
+	_ validation.Validatable = (*AppListOptions)(nil)
+	_ cli.Executable         = (*AppListOptions)(nil)
+)
+
+// AppSummary is the machine readable form of an application in the list
+type AppSummary struct {
+	Name           string   `json:"name"`
+	Namespace      string   `json:"namespace"`
+	Workloads      []string `json:"workloads"`
+	ReadyWorkloads int      `json:"readyWorkloads"`
+}
+
+func (opts *AppListOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	if opts.Namespace == "" && !opts.AllNamespaces {
+		errs = errs.Also(validation.ErrMissingOneOf(flags.NamespaceFlagName, flags.AllNamespacesFlagName))
+	}
+	if opts.Namespace != "" && opts.AllNamespaces {
+		errs = errs.Also(validation.ErrMultipleOneOf(flags.NamespaceFlagName, flags.AllNamespacesFlagName))
+	}
+
+	if opts.Output != "" {
+		errs = errs.Also(validation.OutputFormat(opts.Output, flags.OutputFlagName, printer.ObjectOutputFormats))
+	}
+
+	return errs
+}
+
+func (opts *AppListOptions) Exec(ctx context.Context, c *cli.Config) error {
+	workloads := &cartov1alpha1.WorkloadList{}
+	if err := c.List(ctx, workloads, client.InNamespace(opts.Namespace), client.HasLabels{apis.AppPartOfLabelName}); err != nil {
+		return err
+	}
+	printer.SortByNamespaceAndName(workloads.Items)
+
+	// group the workloads by namespace and application
+	keys := []types.NamespacedName{}
+	groups := map[types.NamespacedName][]cartov1alpha1.Workload{}
+	for _, workload := range workloads.Items {
+		key := types.NamespacedName{Namespace: workload.Namespace, Name: workload.Labels[apis.AppPartOfLabelName]}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], workload)
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		if keys[i].Namespace != keys[j].Namespace {
+			return keys[i].Namespace < keys[j].Namespace
+		}
+		return keys[i].Name < keys[j].Name
+	})
+	apps := make([]AppSummary, len(keys))
+	for i, key := range keys {
+		apps[i] = AppSummary{
+			Name:           key.Name,
+			Namespace:      key.Namespace,
+			Workloads:      []string{},
+			ReadyWorkloads: readyAppWorkloads(ctx, c, groups[key]),
+		}
+		for _, workload := range groups[key] {
+			apps[i].Workloads = append(apps[i].Workloads, workload.Name)
+		}
+	}
+
+	if opts.Output != "" {
+		export, err := printer.OutputObject(apps, printer.OutputFormat(opts.Output))
+		if err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Failed to output applications:"), err)
+			return cli.SilenceError(err)
+		}
+		c.Printf("%s\n", export)
+		return nil
+	}
+
+	if len(apps) == 0 {
+		nsGet := &corev1.Namespace{}
+		if getErr := c.Get(ctx, types.NamespacedName{Name: opts.Namespace}, nsGet); !opts.AllNamespaces && getErr != nil && apierrs.IsNotFound(getErr) {
+			c.Eprintf("%s %s\n", printer.Serrorf("Error:"), fmt.Sprintf("namespace %q not found, it may not exist or user does not have permissions to read it.", opts.Namespace))
+			return cli.SilenceError(getErr)
+		}
+		c.Infof("No applications found.\n")
+		return nil
+	}
+
+	appTable := &metav1beta1.Table{}
+	if opts.AllNamespaces {
+		appTable.ColumnDefinitions = append(appTable.ColumnDefinitions, metav1beta1.TableColumnDefinition{Name: "Namespace", Type: "string"})
+	}
+	appTable.ColumnDefinitions = append(appTable.ColumnDefinitions,
+		metav1beta1.TableColumnDefinition{Name: "Name", Type: "string"},
+		metav1beta1.TableColumnDefinition{Name: "Ready", Type: "string"},
+		metav1beta1.TableColumnDefinition{Name: "Workloads", Type: "string"},
+	)
+	for _, app := range apps {
+		cells := []interface{}{}
+		if opts.AllNamespaces {
+			cells = append(cells, app.Namespace)
+		}
+		cells = append(cells, app.Name, fmt.Sprintf("%d/%d", app.ReadyWorkloads, len(app.Workloads)), strings.Join(app.Workloads, ","))
+		appTable.Rows = append(appTable.Rows, metav1beta1.TableRow{Cells: cells})
+	}
+
+	if err := table.NewTablePrinter(table.PrintOptions{}).PrintObj(appTable, c.Stdout); err != nil {
+		return err
+	}
+	c.Printf("\n")
+	c.Infof("To view details: \"tanzu apps app get <name>\"\n")
+	c.Printf("\n")
+	return nil
+}
+
+func NewAppListCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &AppListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Table listing of applications",
+		Long: strings.TrimSpace(`
+List the applications in a namespace or across all namespaces. An application is made of the
+workloads sharing the same app.kubernetes.io/part-of label, the number of those workloads that
+are ready, along with their deliverables, is listed next to their names.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s app list", c.Name),
+			fmt.Sprintf("%s app list %s", c.Name, flags.AllNamespacesFlagName),
+			fmt.Sprintf("%s app list %s json", c.Name, flags.OutputFlagName),
+		}, "\n"),
+		PreRunE: cli.ValidateE(ctx, opts),
+		RunE:    cli.ExecE(ctx, c, opts),
+	}
+
+	cli.AllNamespacesFlag(ctx, cmd, c, &opts.Namespace, &opts.AllNamespaces)
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the applications formatted. Supported formats: \"json\", \"yaml\", \"yml\", \"custom-columns=<columns>\", \"jsonpath=<expression>\", \"go-template=<template>\", \"go-template-file=<path>\"")
+
+	return cmd
+}
@@ -0,0 +1,233 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"testing"
+
+	diecorev1 "dies.dev/apis/core/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+func TestAppListOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:              "empty",
+			Validatable:       &commands.AppListOptions{},
+			ExpectFieldErrors: validation.ErrMissingOneOf(flags.NamespaceFlagName, flags.AllNamespacesFlagName),
+		},
+		{
+			Name: "invalid namespace + all",
+			Validatable: &commands.AppListOptions{
+				Namespace:     "default",
+				AllNamespaces: true,
+			},
+			ExpectFieldErrors: validation.ErrMultipleOneOf(flags.NamespaceFlagName, flags.AllNamespacesFlagName),
+		},
+		{
+			Name: "valid",
+			Validatable: &commands.AppListOptions{
+				Namespace: "default",
+				Output:    "yaml",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid output format",
+			Validatable: &commands.AppListOptions{
+				Namespace: "default",
+				Output:    "wide",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("wide", flags.OutputFlagName, printer.ObjectOutputFormats),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestAppListCommand(t *testing.T) {
+	defaultNamespace := "default"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	workload := func(namespace, name, app string, ready metav1.ConditionStatus) *diecartov1alpha1.WorkloadDie {
+		return diecartov1alpha1.WorkloadBlank.
+			MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+				d.Name(name)
+				d.Namespace(namespace)
+				if app != "" {
+					d.AddLabel(apis.AppPartOfLabelName, app)
+				}
+			}).
+			StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+				d.ConditionsDie(
+					diecartov1alpha1.WorkloadConditionReadyBlank.Status(ready),
+				)
+			})
+	}
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name: "empty",
+			Args: []string{},
+			GivenObjects: []client.Object{
+				diecorev1.NamespaceBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(defaultNamespace)
+					}),
+			},
+			ExpectOutput: `
+No applications found.
+`,
+		},
+		{
+			Name: "lists applications",
+			Args: []string{},
+			GivenObjects: []client.Object{
+				workload(defaultNamespace, "api", "petclinic", metav1.ConditionTrue),
+				workload(defaultNamespace, "ui", "petclinic", metav1.ConditionFalse),
+				workload(defaultNamespace, "backend", "inventory", metav1.ConditionTrue),
+				workload(defaultNamespace, "standalone", "", metav1.ConditionTrue),
+				workload("other", "api", "petclinic", metav1.ConditionTrue),
+			},
+			ExpectOutput: `
+NAME        READY   WORKLOADS
+inventory   1/1     backend
+petclinic   1/2     api,ui
+
+To view details: "tanzu apps app get <name>"
+
+`,
+		},
+		{
+			Name: "lists applications with deliverable not ready",
+			Args: []string{},
+			GivenObjects: []client.Object{
+				workload(defaultNamespace, "api", "petclinic", metav1.ConditionTrue).
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.Resources(
+							diecartov1alpha1.RealizedResourceBlank.
+								Name("deliverable").
+								StampedRef(&cartov1alpha1.StampedRef{
+									ObjectReference: &corev1.ObjectReference{
+										Kind:      cartov1alpha1.DeliverableKind,
+										Namespace: defaultNamespace,
+										Name:      "api",
+									},
+								}).
+								DieRelease(),
+						)
+					}),
+				workload(defaultNamespace, "ui", "petclinic", metav1.ConditionTrue),
+				diecartov1alpha1.DeliverableBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("api")
+						d.Namespace(defaultNamespace)
+					}).
+					StatusDie(func(d *diecartov1alpha1.DeliverableStatusDie) {
+						d.ConditionsDie(
+							diecartov1alpha1.CreateConditionReadyFalse("TemplateRejectedByAPIServer", "unable to apply object"),
+						)
+					}),
+			},
+			ExpectOutput: `
+NAME        READY   WORKLOADS
+petclinic   1/2     api,ui
+
+To view details: "tanzu apps app get <name>"
+
+`,
+		},
+		{
+			Name: "lists applications in all namespaces",
+			Args: []string{flags.AllNamespacesFlagName},
+			GivenObjects: []client.Object{
+				workload(defaultNamespace, "api", "petclinic", metav1.ConditionTrue),
+				workload(defaultNamespace, "ui", "petclinic", metav1.ConditionFalse),
+				workload("other", "api", "petclinic", metav1.ConditionTrue),
+			},
+			ExpectOutput: `
+NAMESPACE   NAME        READY   WORKLOADS
+default     petclinic   1/2     api,ui
+other       petclinic   1/1     api
+
+To view details: "tanzu apps app get <name>"
+
+`,
+		},
+		{
+			Name: "lists applications in json format",
+			Args: []string{flags.OutputFlagName, printer.OutputFormatJson},
+			GivenObjects: []client.Object{
+				workload(defaultNamespace, "api", "petclinic", metav1.ConditionTrue),
+				workload(defaultNamespace, "ui", "petclinic", metav1.ConditionFalse),
+			},
+			ExpectOutput: `
+[
+	{
+		"name": "petclinic",
+		"namespace": "default",
+		"workloads": [
+			"api",
+			"ui"
+		],
+		"readyWorkloads": 1
+	}
+]
+`,
+		},
+		{
+			Name: "namespace not found",
+			Args: []string{flags.NamespaceFlagName, "foo"},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "Namespace", clitesting.InduceFailureOpts{
+					Error: apierrors.NewNotFound(corev1.Resource("Namespace"), "foo"),
+				}),
+			},
+			ShouldError: true,
+			ExpectOutput: `
+Error: namespace "foo" not found, it may not exist or user does not have permissions to read it.
+`,
+		},
+		{
+			Name: "list error",
+			Args: []string{},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("list", "WorkloadList"),
+			},
+			ShouldError: true,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewAppListCommand)
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+type ClusterDeliveryGetOptions struct {
+	Name   string
+	Output string
+}
+
+var (
+	_ validation.Validatable = (*ClusterDeliveryGetOptions)(nil)
+	_ cli.Executable         = (*ClusterDeliveryGetOptions)(nil)
+)
+
+func (opts *ClusterDeliveryGetOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+	if opts.Name == "" {
+		errs = errs.Also(validation.ErrMissingField(cli.NameArgumentName))
+	}
+
+	if opts.Output != "" {
+		errs = errs.Also(validation.OutputFormat(opts.Output, flags.OutputFlagName, printer.TableOutputFormats))
+	}
+
+	return errs
+}
+
+func (opts *ClusterDeliveryGetOptions) Exec(ctx context.Context, c *cli.Config) error {
+	delivery := &cartov1alpha1.ClusterDelivery{}
+	err := c.Get(ctx, client.ObjectKey{Name: opts.Name}, delivery)
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			c.Errorf("Cluster Delivery %q not found\n", opts.Name)
+			return cli.SilenceError(err)
+		}
+		return err
+	}
+
+	if opts.Output == printer.OutputFormatWide {
+		listOpts := &ClusterDeliveryListOptions{Output: opts.Output}
+		return listOpts.printTable(c, &cartov1alpha1.ClusterDeliveryList{Items: []cartov1alpha1.ClusterDelivery{*delivery}}, countSelectedDeliverables(ctx, c))
+	}
+
+	if opts.Output != "" {
+		export, err := printer.OutputResource(delivery, printer.OutputFormat(opts.Output), c.Scheme)
+		if err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Failed to output cluster delivery:"), err)
+			return cli.SilenceError(err)
+		}
+
+		c.Printf("%s\n", export)
+		return nil
+	}
+
+	c.Printf(printer.ResourceStatus(delivery.Name, printer.FindCondition(delivery.Status.Conditions, cartov1alpha1.DeliveryReady)))
+
+	c.Boldf("Delivery Selectors\n")
+	if len(delivery.Spec.Selector) == 0 && len(delivery.Spec.SelectorMatchExpressions) == 0 && len(delivery.Spec.SelectorMatchFields) == 0 {
+		c.Infof("No delivery selectors found\n")
+	} else if err := printer.ClusterDeliveryPrinter(c.Stdout, delivery); err != nil {
+		return err
+	}
+
+	c.Printf("\n")
+	c.Boldf("Delivery Resources\n")
+	if len(delivery.Spec.Resources) == 0 {
+		c.Infof("No delivery resources found\n")
+	} else if err := printer.ClusterDeliveryResourcesPrinter(c.Stdout, delivery); err != nil {
+		return err
+	}
+	return nil
+}
+
+func NewClusterDeliveryGetCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &ClusterDeliveryGetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Get details from a cluster delivery",
+		Long: strings.TrimSpace(`
+Get details from a cluster delivery: its selectors and the resources it stamps, along with the
+sources, deployment and configs each resource consumes from the others.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s cluster-delivery get delivery-basic", c.Name),
+			fmt.Sprintf("%s cluster-delivery get delivery-basic %s yaml", c.Name, flags.OutputFlagName),
+			fmt.Sprintf("%s cluster-delivery get delivery-basic %s jsonpath='{.spec.selector}'", c.Name, flags.OutputFlagName),
+		}, "\n"),
+		PreRunE:           cli.ValidateE(ctx, opts),
+		RunE:              cli.ExecE(ctx, c, opts),
+		ValidArgsFunction: completion.SuggestClusterDeliveryNames(ctx, c),
+	}
+	cli.Args(cmd,
+		cli.NameArg(&opts.Name),
+	)
+
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the cluster delivery formatted. Supported formats: \"json\", \"yaml\", \"yml\", \"wide\", \"name\", \"custom-columns=<columns>\", \"jsonpath=<expression>\", \"go-template=<template>\", \"go-template-file=<path>\"")
+
+	return cmd
+}
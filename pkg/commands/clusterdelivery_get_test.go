@@ -0,0 +1,249 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+func TestClusterDeliveryGetOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:        "invalid empty",
+			Validatable: &commands.ClusterDeliveryGetOptions{},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMissingField(cli.NameArgumentName),
+			),
+		},
+		{
+			Name: "valid",
+			Validatable: &commands.ClusterDeliveryGetOptions{
+				Name: "delivery-basic",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid output",
+			Validatable: &commands.ClusterDeliveryGetOptions{
+				Name:   "delivery-basic",
+				Output: "mermaid",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("mermaid", flags.OutputFlagName, []string{"json", "yaml", "yml", "wide", "name", "custom-columns", "jsonpath", "go-template", "go-template-file"}),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestClusterDeliveryGetCommand(t *testing.T) {
+	deliveryName := "delivery-basic"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+
+	delivery := &cartov1alpha1.ClusterDelivery{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(time.Now().AddDate(-2, 0, 0)),
+			Name:              deliveryName,
+		},
+		Spec: cartov1alpha1.DeliverySpec{
+			Resources: []cartov1alpha1.DeliveryResource{{
+				Name:        "source-provider",
+				TemplateRef: cartov1alpha1.DeliveryTemplateReference{Kind: "ClusterSourceTemplate", Name: "delivery-source-template"},
+			}, {
+				Name:        "deployer",
+				TemplateRef: cartov1alpha1.DeliveryTemplateReference{Kind: "ClusterDeploymentTemplate", Name: "app-deploy"},
+				Deployment:  &cartov1alpha1.DeploymentReference{Resource: "source-provider"},
+			}},
+			Selector: map[string]string{"app.tanzu.vmware.com/deliverable-type": "web"},
+			SelectorMatchExpressions: []metav1.LabelSelectorRequirement{{
+				Key:      "environment",
+				Operator: metav1.LabelSelectorOpIn,
+				Values:   []string{"prod"},
+			}},
+		},
+		Status: cartov1alpha1.DeliveryStatus{
+			Conditions: []metav1.Condition{{
+				Type:   cartov1alpha1.DeliveryReady,
+				Status: metav1.ConditionTrue,
+				Reason: "Ready",
+			}},
+		},
+	}
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "empty",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name: "show selectors and resources",
+			Args: []string{deliveryName},
+			GivenObjects: []client.Object{
+				delivery,
+			},
+			ExpectOutput: `
+---
+# delivery-basic: Ready
+---
+Delivery Selectors
+   TYPE          KEY                                     OPERATOR   VALUE
+   labels        app.tanzu.vmware.com/deliverable-type              web
+   expressions   environment                             In         prod
+
+Delivery Resources
+   NAME              TEMPLATE                                         SOURCES   DEPLOYMENT        CONFIGS
+   source-provider   ClusterSourceTemplate/delivery-source-template   <none>    <none>            <none>
+   deployer          ClusterDeploymentTemplate/app-deploy             <none>    source-provider   <none>
+`,
+		},
+		{
+			Name: "no selectors nor resources",
+			Args: []string{deliveryName},
+			GivenObjects: []client.Object{
+				&cartov1alpha1.ClusterDelivery{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: deliveryName,
+					},
+				},
+			},
+			ExpectOutput: `
+---
+# delivery-basic: <unknown>
+---
+Delivery Selectors
+No delivery selectors found
+
+Delivery Resources
+No delivery resources found
+`,
+		},
+		{
+			Name: "wide output",
+			Args: []string{deliveryName, flags.OutputFlagName, "wide"},
+			GivenObjects: []client.Object{
+				delivery,
+			},
+			ExpectOutput: `
+NAME             READY   STEPS   DELIVERABLES   AGE   REASON   SELECTOR
+delivery-basic   Ready   2       0              2y    Ready    app.tanzu.vmware.com/deliverable-type=web
+`,
+		},
+		{
+			Name: "json output",
+			Args: []string{deliveryName, flags.OutputFlagName, "json"},
+			GivenObjects: []client.Object{
+				withoutCreationTimestamp(delivery),
+			},
+			ExpectOutput: `
+{
+	"apiVersion": "carto.run/v1alpha1",
+	"kind": "ClusterDelivery",
+	"metadata": {
+		"creationTimestamp": "1970-01-01T00:00:01Z",
+		"name": "delivery-basic",
+		"resourceVersion": "999"
+	},
+	"spec": {
+		"resources": [
+			{
+				"name": "source-provider",
+				"templateRef": {
+					"kind": "ClusterSourceTemplate",
+					"name": "delivery-source-template"
+				}
+			},
+			{
+				"deployment": {
+					"resource": "source-provider"
+				},
+				"name": "deployer",
+				"templateRef": {
+					"kind": "ClusterDeploymentTemplate",
+					"name": "app-deploy"
+				}
+			}
+		],
+		"selector": {
+			"app.tanzu.vmware.com/deliverable-type": "web"
+		},
+		"selectorMatchExpressions": [
+			{
+				"key": "environment",
+				"operator": "In",
+				"values": [
+					"prod"
+				]
+			}
+		],
+		"serviceAccountRef": {
+			"name": ""
+		}
+	},
+	"status": {
+		"conditions": [
+			{
+				"lastTransitionTime": null,
+				"message": "",
+				"reason": "Ready",
+				"status": "True",
+				"type": "Ready"
+			}
+		]
+	}
+}
+`,
+		},
+		{
+			Name: "not found",
+			Args: []string{deliveryName},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "ClusterDelivery", clitesting.InduceFailureOpts{
+					Error: apierrors.NewNotFound(cartov1alpha1.Resource("ClusterDelivery"), deliveryName),
+				}),
+			},
+			ExpectOutput: `
+Cluster Delivery "delivery-basic" not found
+`,
+			ShouldError: true,
+		},
+		{
+			Name: "get error",
+			Args: []string{deliveryName},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "ClusterDelivery"),
+			},
+			ShouldError: true,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewClusterDeliveryGetCommand)
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+type ClusterDeliveryListOptions struct {
+	Output string
+}
+
+var (
+	_ validation.Validatable = (*ClusterDeliveryListOptions)(nil)
+	_ cli.Executable         = (*ClusterDeliveryListOptions)(nil)
+)
+
+func (opts *ClusterDeliveryListOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	if opts.Output != "" {
+		errs = errs.Also(validation.OutputFormat(opts.Output, flags.OutputFlagName, printer.TableOutputFormats))
+	}
+
+	return errs
+}
+
+func (opts *ClusterDeliveryListOptions) Exec(ctx context.Context, c *cli.Config) error {
+	deliveries := &cartov1alpha1.ClusterDeliveryList{}
+	if err := c.List(ctx, deliveries); err != nil {
+		return err
+	}
+
+	deliveries = deliveries.DeepCopy()
+	printer.SortByNamespaceAndName(deliveries.Items)
+
+	if opts.Output != "" && opts.Output != printer.OutputFormatWide {
+		var list []printer.Object
+		for i := range deliveries.Items {
+			list = append(list, &deliveries.Items[i])
+		}
+		export, err := printer.OutputResources(list, printer.OutputFormat(opts.Output), c.Scheme)
+		if err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Failed to output cluster deliveries:"), err)
+			return cli.SilenceError(err)
+		}
+
+		c.Printf("%s\n", export)
+		return nil
+	}
+
+	if len(deliveries.Items) == 0 {
+		c.Infof("No cluster deliveries found.\n")
+		return nil
+	}
+
+	if err := opts.printTable(c, deliveries, countSelectedDeliverables(ctx, c)); err != nil {
+		return err
+	}
+
+	c.Printf("\n")
+	c.Infof("To view details: \"tanzu apps cluster-delivery get <name>\"\n")
+	c.Printf("\n")
+
+	return nil
+}
+
+func (opts *ClusterDeliveryListOptions) printTable(c *cli.Config, deliveries *cartov1alpha1.ClusterDeliveryList, deliverables map[string]int) error {
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{
+		// none for now
+	}).With(func(h table.PrintHandler) {
+		columns := opts.printColumns()
+		h.TableHandler(columns, func(deliveries *cartov1alpha1.ClusterDeliveryList, printOpts table.PrintOptions) ([]metav1beta1.TableRow, error) {
+			return opts.printList(deliveries, deliverables, printOpts)
+		})
+		h.TableHandler(columns, func(delivery *cartov1alpha1.ClusterDelivery, printOpts table.PrintOptions) ([]metav1beta1.TableRow, error) {
+			return opts.print(delivery, deliverables, printOpts)
+		})
+	})
+
+	return tablePrinter.PrintObj(deliveries, c.Stdout)
+}
+
+// countSelectedDeliverables counts, for each delivery, the deliverables across all namespaces
+// that report being selected by it. The result is nil when the deliverables cannot be listed
+func countSelectedDeliverables(ctx context.Context, c *cli.Config) map[string]int {
+	deliverables := &cartov1alpha1.DeliverableList{}
+	if err := c.List(ctx, deliverables); err != nil {
+		return nil
+	}
+	counts := map[string]int{}
+	for _, deliverable := range deliverables.Items {
+		if name := deliverable.Status.DeliveryRef.Name; name != "" {
+			counts[name]++
+		}
+	}
+	return counts
+}
+
+func NewClusterDeliveryListCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &ClusterDeliveryListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "table listing of cluster deliveries",
+		Long: strings.TrimSpace(`
+List cluster deliveries, along with the number of steps of each one and the number of
+deliverables across all namespaces it currently selects.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s cluster-delivery list", c.Name),
+			fmt.Sprintf("%s cluster-delivery list %s wide", c.Name, flags.OutputFlagName),
+			fmt.Sprintf("%s cluster-delivery list %s yaml", c.Name, flags.OutputFlagName),
+		}, "\n"),
+		PreRunE: cli.ValidateE(ctx, opts),
+		RunE:    cli.ExecE(ctx, c, opts),
+	}
+
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the cluster deliveries formatted. Supported formats: \"json\", \"yaml\", \"yml\", \"wide\", \"name\", \"custom-columns=<columns>\", \"jsonpath=<expression>\", \"go-template=<template>\", \"go-template-file=<path>\"")
+
+	return cmd
+}
+
+func (opts *ClusterDeliveryListOptions) printList(deliveries *cartov1alpha1.ClusterDeliveryList, deliverables map[string]int, printOpts table.PrintOptions) ([]metav1beta1.TableRow, error) {
+	rows := make([]metav1beta1.TableRow, 0, len(deliveries.Items))
+	for i := range deliveries.Items {
+		r, err := opts.print(&deliveries.Items[i], deliverables, printOpts)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
+func (opts *ClusterDeliveryListOptions) print(delivery *cartov1alpha1.ClusterDelivery, deliverables map[string]int, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+	now := time.Now()
+	row := metav1beta1.TableRow{
+		Object: runtime.RawExtension{Object: delivery},
+	}
+	ready := printer.FindCondition(delivery.Status.Conditions, cartov1alpha1.DeliveryReady)
+	deliverablesCount := printer.Sfaintf("<unknown>")
+	if deliverables != nil {
+		deliverablesCount = fmt.Sprintf("%d", deliverables[delivery.Name])
+	}
+	row.Cells = append(row.Cells,
+		delivery.Name,
+		printer.ConditionStatus(ready),
+		len(delivery.Spec.Resources),
+		deliverablesCount,
+		printer.TimestampSince(delivery.CreationTimestamp, now),
+	)
+	if opts.Output == printer.OutputFormatWide {
+		reason := ""
+		if ready != nil {
+			reason = ready.Reason
+		}
+		row.Cells = append(row.Cells,
+			printer.EmptyString(reason),
+			printer.Labels(delivery.Spec.Selector),
+		)
+	}
+	return []metav1beta1.TableRow{row}, nil
+}
+
+func (opts *ClusterDeliveryListOptions) printColumns() []metav1beta1.TableColumnDefinition {
+	columns := []metav1beta1.TableColumnDefinition{
+		{Name: "Name", Type: "string"},
+		{Name: "Ready", Type: "string"},
+		{Name: "Steps", Type: "integer"},
+		{Name: "Deliverables", Type: "string"},
+		{Name: "Age", Type: "string"},
+	}
+	if opts.Output == printer.OutputFormatWide {
+		columns = append(columns,
+			metav1beta1.TableColumnDefinition{Name: "Reason", Type: "string"},
+			metav1beta1.TableColumnDefinition{Name: "Selector", Type: "string"},
+		)
+	}
+	return columns
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+func TestClusterDeliveryListOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:           "valid",
+			Validatable:    &commands.ClusterDeliveryListOptions{},
+			ShouldValidate: true,
+		},
+		{
+			Name: "wide output",
+			Validatable: &commands.ClusterDeliveryListOptions{
+				Output: "wide",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid output",
+			Validatable: &commands.ClusterDeliveryListOptions{
+				Output: "dot",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("dot", flags.OutputFlagName, []string{"json", "yaml", "yml", "wide", "name", "custom-columns", "jsonpath", "go-template", "go-template-file"}),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestClusterDeliveryListCommand(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+
+	delivery := &cartov1alpha1.ClusterDelivery{
+		ObjectMeta: metav1.ObjectMeta{
+			CreationTimestamp: metav1.NewTime(time.Now().AddDate(-2, 0, 0)),
+			Name:              "delivery-basic",
+		},
+		Spec: cartov1alpha1.DeliverySpec{
+			Resources: []cartov1alpha1.DeliveryResource{{
+				Name:        "source-provider",
+				TemplateRef: cartov1alpha1.DeliveryTemplateReference{Kind: "ClusterSourceTemplate", Name: "delivery-source-template"},
+			}, {
+				Name:        "deployer",
+				TemplateRef: cartov1alpha1.DeliveryTemplateReference{Kind: "ClusterDeploymentTemplate", Name: "app-deploy"},
+				Deployment:  &cartov1alpha1.DeploymentReference{Resource: "source-provider"},
+			}},
+			Selector: map[string]string{"app.tanzu.vmware.com/deliverable-type": "web"},
+		},
+		Status: cartov1alpha1.DeliveryStatus{
+			Conditions: []metav1.Condition{{
+				Type:   cartov1alpha1.DeliveryReady,
+				Status: metav1.ConditionTrue,
+				Reason: "Ready",
+			}},
+		},
+	}
+	deliverable := func(namespace, name, delivery string) *cartov1alpha1.Deliverable {
+		return &cartov1alpha1.Deliverable{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Status: cartov1alpha1.DeliverableStatus{
+				DeliveryRef: cartov1alpha1.ObjectReference{Name: delivery},
+			},
+		}
+	}
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name: "empty",
+			ExpectOutput: `
+No cluster deliveries found.
+`,
+		},
+		{
+			Name: "lists items with their deliverables",
+			GivenObjects: []client.Object{
+				delivery,
+				deliverable("default", "petclinic", "delivery-basic"),
+				deliverable("other", "petclinic", "delivery-basic"),
+				deliverable("default", "spring", "other-delivery"),
+			},
+			ExpectOutput: `
+NAME             READY   STEPS   DELIVERABLES   AGE
+delivery-basic   Ready   2       2              2y
+
+To view details: "tanzu apps cluster-delivery get <name>"
+
+`,
+		},
+		{
+			Name: "lists items in wide format",
+			Args: []string{flags.OutputFlagName, "wide"},
+			GivenObjects: []client.Object{
+				delivery,
+			},
+			ExpectOutput: `
+NAME             READY   STEPS   DELIVERABLES   AGE   REASON   SELECTOR
+delivery-basic   Ready   2       0              2y    Ready    app.tanzu.vmware.com/deliverable-type=web
+
+To view details: "tanzu apps cluster-delivery get <name>"
+
+`,
+		},
+		{
+			Name: "lists items in yaml format",
+			Args: []string{flags.OutputFlagName, "yaml"},
+			GivenObjects: []client.Object{
+				withoutCreationTimestamp(delivery),
+			},
+			ExpectOutput: `
+---
+- apiVersion: carto.run/v1alpha1
+  kind: ClusterDelivery
+  metadata:
+    creationTimestamp: "1970-01-01T00:00:01Z"
+    name: delivery-basic
+    resourceVersion: "999"
+  spec:
+    resources:
+    - name: source-provider
+      templateRef:
+        kind: ClusterSourceTemplate
+        name: delivery-source-template
+    - deployment:
+        resource: source-provider
+      name: deployer
+      templateRef:
+        kind: ClusterDeploymentTemplate
+        name: app-deploy
+    selector:
+      app.tanzu.vmware.com/deliverable-type: web
+    serviceAccountRef:
+      name: ""
+  status:
+    conditions:
+    - lastTransitionTime: null
+      message: ""
+      reason: Ready
+      status: "True"
+      type: Ready
+`,
+		},
+		{
+			Name: "deliverables cannot be listed",
+			GivenObjects: []client.Object{
+				delivery,
+			},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("list", "DeliverableList"),
+			},
+			ExpectOutput: `
+NAME             READY   STEPS   DELIVERABLES   AGE
+delivery-basic   Ready   2       <unknown>      2y
+
+To view details: "tanzu apps cluster-delivery get <name>"
+
+`,
+		},
+		{
+			Name: "list error",
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("list", "ClusterDeliveryList"),
+			},
+			ShouldError: true,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewClusterDeliveryListCommand)
+}
+
+// withoutCreationTimestamp lets the fake client stamp the creation timestamp, so that it is stable
+// in the serialized outputs
+func withoutCreationTimestamp(delivery *cartov1alpha1.ClusterDelivery) *cartov1alpha1.ClusterDelivery {
+	delivery = delivery.DeepCopy()
+	delivery.CreationTimestamp = metav1.Time{}
+	return delivery
+}
@@ -36,6 +36,8 @@ func NewClusterSupplyChainCommand(ctx context.Context, c *cli.Config) *cobra.Com
 
 	cmd.AddCommand(NewClusterSupplyChainListCommand(ctx, c))
 	cmd.AddCommand(NewClusterSupplyChainGetCommand(ctx, c))
+	cmd.AddCommand(NewClusterSupplyChainMatchCommand(ctx, c))
+	cmd.AddCommand(NewClusterSupplyChainParamsCommand(ctx, c))
 
 	return cmd
 }
@@ -29,11 +29,17 @@ import (
 	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
 )
 
 type ClusterSupplyChainGetOptions struct {
-	Name string
+	Name   string
+	Output string
+	// Namespace and Workload identify the workload whose realized resources are overlaid on the
+	// supply chain resources
+	Namespace string
+	Workload  string
 }
 
 var (
@@ -46,6 +52,18 @@ func (opts *ClusterSupplyChainGetOptions) Validate(ctx context.Context) validati
 	if opts.Name == "" {
 		errs = errs.Also(validation.ErrMissingField(cli.NameArgumentName))
 	}
+
+	if opts.Output != "" {
+		errs = errs.Also(validation.OutputFormat(opts.Output, flags.OutputFlagName, append(append([]string{}, printer.TableOutputFormats...), printer.GraphOutputFormats...)))
+	}
+
+	if opts.Workload != "" {
+		errs = errs.Also(validation.K8sName(opts.Workload, flags.WorkloadFlagName))
+		if opts.Output != "" && opts.Output != printer.OutputFormatDot && opts.Output != printer.OutputFormatMermaid {
+			errs = errs.Also(validation.ErrMultipleOneOf(flags.WorkloadFlagName, flags.OutputFlagName))
+		}
+	}
+
 	return errs
 }
 
@@ -59,8 +77,67 @@ func (opts *ClusterSupplyChainGetOptions) Exec(ctx context.Context, c *cli.Confi
 		}
 		return err
 	}
+
+	var realized []cartov1alpha1.RealizedResource
+	if opts.Workload != "" {
+		workload := &cartov1alpha1.Workload{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: opts.Namespace, Name: opts.Workload}, workload); err != nil {
+			if apierrs.IsNotFound(err) {
+				c.Errorf("Workload %q not found\n", fmt.Sprintf("%s/%s", opts.Namespace, opts.Workload))
+				return cli.SilenceError(err)
+			}
+			return err
+		}
+		if workload.Status.SupplyChainRef.Name != supplyChain.Name {
+			c.Errorf("Workload %q is not selected by cluster supply chain %q\n", fmt.Sprintf("%s/%s", opts.Namespace, opts.Workload), supplyChain.Name)
+			return cli.SilenceError(fmt.Errorf("workload %q is not selected by cluster supply chain %q", opts.Workload, supplyChain.Name))
+		}
+		realized = workload.Status.Resources
+		if realized == nil {
+			realized = []cartov1alpha1.RealizedResource{}
+		}
+	}
+
+	if opts.Output == printer.OutputFormatDot || opts.Output == printer.OutputFormatMermaid {
+		graph, err := printer.SupplyChainGraph(opts.Output, supplyChain, realized)
+		if err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Failed to output cluster supply chain:"), err)
+			return cli.SilenceError(err)
+		}
+		c.Printf("%s\n", graph)
+		return nil
+	}
+
+	if opts.Output == printer.OutputFormatWide {
+		listOpts := &ClusterSupplyChainListOptions{Output: opts.Output}
+		return listOpts.printTable(c, &cartov1alpha1.ClusterSupplyChainList{Items: []cartov1alpha1.ClusterSupplyChain{*supplyChain}}, countSelectingWorkloads(ctx, c))
+	}
+
+	if opts.Output != "" {
+		export, err := printer.OutputResource(supplyChain, printer.OutputFormat(opts.Output), c.Scheme)
+		if err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Failed to output cluster supply chain:"), err)
+			return cli.SilenceError(err)
+		}
+
+		c.Printf("%s\n", export)
+		return nil
+	}
+
 	c.Printf(printer.ResourceStatus(supplyChain.Name, printer.FindCondition(supplyChain.Status.Conditions, cartov1alpha1.SupplyChainReady)))
 
+	var workloads *int
+	if counts := countSelectingWorkloads(ctx, c); counts != nil {
+		count := counts[supplyChain.Name]
+		workloads = &count
+	}
+	c.Boldf("Overview\n")
+	if err := printer.ClusterSupplyChainOverviewPrinter(c.Stdout, supplyChain, workloads); err != nil {
+		return err
+	}
+
+	c.Printf("\n")
+
 	c.Boldf("Supply Chain Selectors\n")
 	if len(supplyChain.Spec.Selector) == 0 && len(supplyChain.Spec.SelectorMatchExpressions) == 0 && len(supplyChain.Spec.SelectorMatchFields) == 0 {
 		c.Infof("No supply chain selectors found\n")
@@ -69,17 +146,37 @@ func (opts *ClusterSupplyChainGetOptions) Exec(ctx context.Context, c *cli.Confi
 			return err
 		}
 	}
+
+	c.Printf("\n")
+	c.Boldf("Supply Chain Resources\n")
+	if len(supplyChain.Spec.Resources) == 0 {
+		c.Infof("No supply chain resources found\n")
+	} else if err := printer.ClusterSupplyChainResourcesPrinter(c.Stdout, supplyChain, realized); err != nil {
+		return err
+	}
 	return nil
 }
+
 func NewClusterSupplyChainGetCommand(ctx context.Context, c *cli.Config) *cobra.Command {
 	opts := &ClusterSupplyChainGetOptions{}
 
 	cmd := &cobra.Command{
 		Use:   "get",
 		Short: "Get details from a cluster supply chain",
-		Long:  strings.TrimSpace(`Get details from a cluster supply chain`),
+		Long: strings.TrimSpace(`
+Get details from a cluster supply chain: the readiness of its templates, its service account, the
+number of workloads it selects, its selectors and the resources it stamps, along with the sources,
+images and configs each resource consumes from the others.
+
+The resources can also be rendered as a graph with ` + flags.OutputFlagName + ` dot or mermaid. Given ` + flags.WorkloadFlagName + `,
+the readiness of each resource realized for that workload is shown next to it.
+`),
 		Example: strings.Join([]string{
 			fmt.Sprintf("%s cluster-supply-chain get", c.Name),
+			fmt.Sprintf("%s cluster-supply-chain get source-to-url %s yaml", c.Name, flags.OutputFlagName),
+			fmt.Sprintf("%s cluster-supply-chain get source-to-url %s jsonpath='{.spec.selector}'", c.Name, flags.OutputFlagName),
+			fmt.Sprintf("%s cluster-supply-chain get source-to-url %s dot | dot -Tsvg > source-to-url.svg", c.Name, flags.OutputFlagName),
+			fmt.Sprintf("%s cluster-supply-chain get source-to-url %s mermaid %s my-workload %s default", c.Name, flags.OutputFlagName, flags.WorkloadFlagName, flags.NamespaceFlagName),
 		}, "\n"),
 		PreRunE:           cli.ValidateE(ctx, opts),
 		RunE:              cli.ExecE(ctx, c, opts),
@@ -89,5 +186,9 @@ func NewClusterSupplyChainGetCommand(ctx context.Context, c *cli.Config) *cobra.
 		cli.NameArg(&opts.Name),
 	)
 
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the cluster supply chain formatted. Supported formats: \"json\", \"yaml\", \"yml\", \"wide\", \"name\", \"custom-columns=<columns>\", \"jsonpath=<expression>\", \"go-template=<template>\", \"go-template-file=<path>\", \"dot\", \"mermaid\"")
+	cmd.Flags().StringVar(&opts.Workload, cli.StripDash(flags.WorkloadFlagName), "", "show the readiness of each resource realized for the `workload`")
+	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
+
 	return cmd
 }
@@ -18,6 +18,7 @@ package commands_test
 
 import (
 	"testing"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -32,6 +33,7 @@ import (
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
 	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
 )
 
 func TestSupplyChainGetOptionsValidate(t *testing.T) {
@@ -57,6 +59,51 @@ func TestSupplyChainGetOptionsValidate(t *testing.T) {
 			},
 			ShouldValidate: true,
 		},
+		{
+			Name: "valid output format",
+			Validatable: &commands.ClusterSupplyChainGetOptions{
+				Name:   "my-csc",
+				Output: "jsonpath={.spec.selector}",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid output format",
+			Validatable: &commands.ClusterSupplyChainGetOptions{
+				Name:   "my-csc",
+				Output: "table",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("table", flags.OutputFlagName, []string{"json", "yaml", "yml", "wide", "name", "custom-columns", "jsonpath", "go-template", "go-template-file", "dot", "mermaid"}),
+		},
+		{
+			Name: "graph output format with workload",
+			Validatable: &commands.ClusterSupplyChainGetOptions{
+				Name:      "my-csc",
+				Output:    "mermaid",
+				Namespace: "default",
+				Workload:  "my-workload",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "workload with yaml output format",
+			Validatable: &commands.ClusterSupplyChainGetOptions{
+				Name:      "my-csc",
+				Output:    "yaml",
+				Namespace: "default",
+				Workload:  "my-workload",
+			},
+			ExpectFieldErrors: validation.ErrMultipleOneOf(flags.WorkloadFlagName, flags.OutputFlagName),
+		},
+		{
+			Name: "invalid workload name",
+			Validatable: &commands.ClusterSupplyChainGetOptions{
+				Name:      "my-csc",
+				Namespace: "default",
+				Workload:  "my-",
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("my-", flags.WorkloadFlagName),
+		},
 	}
 	table.Run(t)
 }
@@ -71,6 +118,49 @@ func TestClusterSupplyChainGetCommand(t *testing.T) {
 		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
 			d.Name(supplyChainName)
 		})
+	withResources := parent.
+		SpecDie(func(d *diecartov1alpha1.SupplyChainSpecDie) {
+			d.Resources(
+				cartov1alpha1.SupplyChainResource{
+					Name:        "source-provider",
+					TemplateRef: cartov1alpha1.SupplyChainTemplateReference{Kind: "ClusterSourceTemplate", Name: "source-template"},
+				},
+				cartov1alpha1.SupplyChainResource{
+					Name:        "image-builder",
+					TemplateRef: cartov1alpha1.SupplyChainTemplateReference{Kind: "ClusterImageTemplate", Name: "kpack-template"},
+					Sources:     []cartov1alpha1.ResourceReference{{Name: "source", Resource: "source-provider"}},
+				},
+				cartov1alpha1.SupplyChainResource{
+					Name:        "config-provider",
+					TemplateRef: cartov1alpha1.SupplyChainTemplateReference{Kind: "ClusterConfigTemplate", Name: "convention-template"},
+					Images:      []cartov1alpha1.ResourceReference{{Name: "image", Resource: "image-builder"}},
+				},
+				cartov1alpha1.SupplyChainResource{
+					Name:        "deliverable",
+					TemplateRef: cartov1alpha1.SupplyChainTemplateReference{Kind: "ClusterTemplate", Name: "deliverable-template"},
+					Sources:     []cartov1alpha1.ResourceReference{{Name: "source", Resource: "source-provider"}},
+					Configs:     []cartov1alpha1.ResourceReference{{Name: "config", Resource: "config-provider"}},
+				},
+			)
+		})
+	workload := diecartov1alpha1.WorkloadBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("my-workload")
+			d.Namespace("default")
+		}).
+		StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+			d.SupplyChainRef(cartov1alpha1.ObjectReference{Kind: "ClusterSupplyChain", Name: supplyChainName})
+			d.Resources(
+				cartov1alpha1.RealizedResource{
+					Name:       "source-provider",
+					Conditions: []metav1.Condition{{Type: cartov1alpha1.ConditionReady, Status: metav1.ConditionTrue}},
+				},
+				cartov1alpha1.RealizedResource{
+					Name:       "image-builder",
+					Conditions: []metav1.Condition{{Type: cartov1alpha1.ConditionReady, Status: metav1.ConditionFalse, Reason: "MissingValueAtPath"}},
+				},
+			)
+		})
 
 	table := clitesting.CommandTestSuite{
 		{
@@ -86,8 +176,17 @@ func TestClusterSupplyChainGetCommand(t *testing.T) {
 ---
 # test-supply-chain: <unknown>
 ---
+Overview
+   templates ready:   <empty>
+   service account:   <empty>
+   steps:             0
+   workloads:         0
+
 Supply Chain Selectors
 No supply chain selectors found
+
+Supply Chain Resources
+No supply chain resources found
 `,
 		}, {
 			Name: "label selectors",
@@ -104,10 +203,19 @@ No supply chain selectors found
 ---
 # test-supply-chain: <unknown>
 ---
+Overview
+   templates ready:   <empty>
+   service account:   <empty>
+   steps:             0
+   workloads:         0
+
 Supply Chain Selectors
    TYPE     KEY                                                 OPERATOR   VALUE
    labels   apps.tanzu.vmware.com/workload-deployment-cluster              test
    labels   apps.tanzu.vmware.com/workload-type                            web
+
+Supply Chain Resources
+No supply chain resources found
 `,
 		}, {
 			Name: "all selectors",
@@ -135,11 +243,220 @@ Supply Chain Selectors
 ---
 # test-supply-chain: <unknown>
 ---
+Overview
+   templates ready:   <empty>
+   service account:   <empty>
+   steps:             0
+   workloads:         0
+
 Supply Chain Selectors
    TYPE          KEY                                   OPERATOR   VALUE
    labels        apps.tanzu.vmware.com/workload-type              web
    fields        spec.image                            Exists
    expressions   foo                                   In         bar
+
+Supply Chain Resources
+No supply chain resources found
+`,
+		}, {
+			Name: "output in yaml format",
+			Args: []string{supplyChainName, flags.OutputFlagName, "yaml"},
+			GivenObjects: []client.Object{parent.
+				SpecDie(func(d *diecartov1alpha1.SupplyChainSpecDie) {
+					d.Selector(map[string]string{
+						"apps.tanzu.vmware.com/workload-type": "web",
+					})
+				},
+				)},
+			ExpectOutput: `
+---
+apiVersion: carto.run/v1alpha1
+kind: ClusterSupplyChain
+metadata:
+  creationTimestamp: "1970-01-01T00:00:01Z"
+  name: test-supply-chain
+  resourceVersion: "999"
+spec:
+  resources: null
+  selector:
+    apps.tanzu.vmware.com/workload-type: web
+  serviceAccountRef:
+    name: ""
+status: {}
+`,
+		}, {
+			Name: "output in wide format",
+			Args: []string{supplyChainName, flags.OutputFlagName, "wide"},
+			GivenObjects: []client.Object{parent.
+				MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.CreationTimestamp(metav1.NewTime(time.Now().Add(-2 * time.Hour)))
+				}).
+				SpecDie(func(d *diecartov1alpha1.SupplyChainSpecDie) {
+					d.Selector(map[string]string{
+						"apps.tanzu.vmware.com/workload-type": "web",
+					})
+				}).
+				StatusDie(func(d *diecartov1alpha1.SupplyChainStatusDie) {
+					d.ConditionsDie(
+						diecartov1alpha1.ClusterSupplyChainConditionReadyBlank.
+							Status(metav1.ConditionFalse).
+							Reason("TemplatesNotFound"),
+					)
+				}),
+			},
+			ExpectOutput: `
+NAME                READY               STEPS   WORKLOADS   AGE    REASON              TEMPLATES READY   SERVICE ACCOUNT   SELECTOR
+test-supply-chain   TemplatesNotFound   0       0           120m   TemplatesNotFound   <empty>           <empty>           apps.tanzu.vmware.com/workload-type=web
+`,
+		}, {
+			Name:         "output with jsonpath",
+			Args:         []string{supplyChainName, flags.OutputFlagName, "jsonpath={.kind}/{.metadata.name}"},
+			GivenObjects: []client.Object{parent},
+			ExpectOutput: `
+ClusterSupplyChain/test-supply-chain
+`,
+		}, {
+			Name:         "show resources",
+			Args:         []string{supplyChainName},
+			GivenObjects: []client.Object{withResources},
+			ExpectOutput: `
+---
+# test-supply-chain: <unknown>
+---
+Overview
+   templates ready:   <empty>
+   service account:   <empty>
+   steps:             4
+   workloads:         0
+
+Supply Chain Selectors
+No supply chain selectors found
+
+Supply Chain Resources
+   NAME              TEMPLATE                                    SOURCES           IMAGES          CONFIGS
+   source-provider   ClusterSourceTemplate/source-template       <none>            <none>          <none>
+   image-builder     ClusterImageTemplate/kpack-template         source-provider   <none>          <none>
+   config-provider   ClusterConfigTemplate/convention-template   <none>            image-builder   <none>
+   deliverable       ClusterTemplate/deliverable-template        source-provider   <none>          config-provider
+`,
+		}, {
+			Name:         "show resources with workload readiness",
+			Args:         []string{supplyChainName, flags.WorkloadFlagName, "my-workload", flags.NamespaceFlagName, "default"},
+			GivenObjects: []client.Object{withResources, workload},
+			ExpectOutput: `
+---
+# test-supply-chain: <unknown>
+---
+Overview
+   templates ready:   <empty>
+   service account:   <empty>
+   steps:             4
+   workloads:         1
+
+Supply Chain Selectors
+No supply chain selectors found
+
+Supply Chain Resources
+   NAME              TEMPLATE                                    SOURCES           IMAGES          CONFIGS           READY
+   source-provider   ClusterSourceTemplate/source-template       <none>            <none>          <none>            Ready
+   image-builder     ClusterImageTemplate/kpack-template         source-provider   <none>          <none>            MissingValueAtPath
+   config-provider   ClusterConfigTemplate/convention-template   <none>            image-builder   <none>            <unknown>
+   deliverable       ClusterTemplate/deliverable-template        source-provider   <none>          config-provider   <unknown>
+`,
+		}, {
+			Name:         "output in dot format",
+			Args:         []string{supplyChainName, flags.OutputFlagName, "dot"},
+			GivenObjects: []client.Object{withResources},
+			ExpectOutput: `
+digraph "test-supply-chain" {
+  rankdir="LR";
+  node [shape=box];
+  "source-provider" [label="source-provider\nClusterSourceTemplate/source-template"];
+  "image-builder" [label="image-builder\nClusterImageTemplate/kpack-template"];
+  "config-provider" [label="config-provider\nClusterConfigTemplate/convention-template"];
+  "deliverable" [label="deliverable\nClusterTemplate/deliverable-template"];
+  "source-provider" -> "image-builder" [label="source"];
+  "image-builder" -> "config-provider" [label="image"];
+  "source-provider" -> "deliverable" [label="source"];
+  "config-provider" -> "deliverable" [label="config"];
+}
+`,
+		}, {
+			Name:         "output in dot format with workload readiness",
+			Args:         []string{supplyChainName, flags.OutputFlagName, "dot", flags.WorkloadFlagName, "my-workload", flags.NamespaceFlagName, "default"},
+			GivenObjects: []client.Object{withResources, workload},
+			ExpectOutput: `
+digraph "test-supply-chain" {
+  rankdir="LR";
+  node [shape=box];
+  "source-provider" [label="source-provider\nClusterSourceTemplate/source-template\nready: True", color=green];
+  "image-builder" [label="image-builder\nClusterImageTemplate/kpack-template\nready: False", color=red];
+  "config-provider" [label="config-provider\nClusterConfigTemplate/convention-template\nready: Unknown", color=gray];
+  "deliverable" [label="deliverable\nClusterTemplate/deliverable-template\nready: Unknown", color=gray];
+  "source-provider" -> "image-builder" [label="source"];
+  "image-builder" -> "config-provider" [label="image"];
+  "source-provider" -> "deliverable" [label="source"];
+  "config-provider" -> "deliverable" [label="config"];
+}
+`,
+		}, {
+			Name:         "output in mermaid format",
+			Args:         []string{supplyChainName, flags.OutputFlagName, "mermaid"},
+			GivenObjects: []client.Object{withResources},
+			ExpectOutput: `
+flowchart LR
+  r0["source-provider<br/>ClusterSourceTemplate/source-template"]
+  r1["image-builder<br/>ClusterImageTemplate/kpack-template"]
+  r2["config-provider<br/>ClusterConfigTemplate/convention-template"]
+  r3["deliverable<br/>ClusterTemplate/deliverable-template"]
+  r0 -->|source| r1
+  r1 -->|image| r2
+  r0 -->|source| r3
+  r2 -->|config| r3
+`,
+		}, {
+			Name:         "output in mermaid format with workload readiness",
+			Args:         []string{supplyChainName, flags.OutputFlagName, "mermaid", flags.WorkloadFlagName, "my-workload", flags.NamespaceFlagName, "default"},
+			GivenObjects: []client.Object{withResources, workload},
+			ExpectOutput: `
+flowchart LR
+  r0["source-provider<br/>ClusterSourceTemplate/source-template<br/>ready: True"]
+  r1["image-builder<br/>ClusterImageTemplate/kpack-template<br/>ready: False"]
+  r2["config-provider<br/>ClusterConfigTemplate/convention-template<br/>ready: Unknown"]
+  r3["deliverable<br/>ClusterTemplate/deliverable-template<br/>ready: Unknown"]
+  r0 -->|source| r1
+  r1 -->|image| r2
+  r0 -->|source| r3
+  r2 -->|config| r3
+  classDef ready stroke:#2e7d32,fill:#e8f5e9
+  classDef notready stroke:#c62828,fill:#ffebee
+  classDef unknown stroke:#757575,fill:#f5f5f5
+  class r0 ready
+  class r1 notready
+  class r2 unknown
+  class r3 unknown
+`,
+		}, {
+			Name:         "workload not found",
+			Args:         []string{supplyChainName, flags.OutputFlagName, "dot", flags.WorkloadFlagName, "my-workload", flags.NamespaceFlagName, "default"},
+			GivenObjects: []client.Object{withResources},
+			ShouldError:  true,
+			ExpectOutput: `
+Workload "default/my-workload" not found
+`,
+		}, {
+			Name: "workload not selected by the supply chain",
+			Args: []string{supplyChainName, flags.OutputFlagName, "dot", flags.WorkloadFlagName, "my-workload", flags.NamespaceFlagName, "default"},
+			GivenObjects: []client.Object{
+				withResources,
+				workload.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.SupplyChainRef(cartov1alpha1.ObjectReference{Kind: "ClusterSupplyChain", Name: "other-supply-chain"})
+					}),
+			},
+			ShouldError: true,
+			ExpectOutput: `
+Workload "default/my-workload" is not selected by cluster supply chain "test-supply-chain"
 `,
 		}, {
 			Name: "not found",
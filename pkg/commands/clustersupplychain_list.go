@@ -28,13 +28,14 @@ import (
 
 	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
 	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
-	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
 )
 
 type ClusterSupplyChainListOptions struct {
-	// none for now
+	Output string
 }
 
 var (
@@ -45,7 +46,9 @@ var (
 func (opts *ClusterSupplyChainListOptions) Validate(ctx context.Context) validation.FieldErrors {
 	errs := validation.FieldErrors{}
 
-	// none for now
+	if opts.Output != "" {
+		errs = errs.Also(validation.OutputFormat(opts.Output, flags.OutputFlagName, printer.TableOutputFormats))
+	}
 
 	return errs
 }
@@ -56,23 +59,30 @@ func (opts *ClusterSupplyChainListOptions) Exec(ctx context.Context, c *cli.Conf
 		return err
 	}
 
+	supplyChain = supplyChain.DeepCopy()
+	printer.SortByNamespaceAndName(supplyChain.Items)
+
+	if opts.Output != "" && opts.Output != printer.OutputFormatWide {
+		var list []printer.Object
+		for i := range supplyChain.Items {
+			list = append(list, &supplyChain.Items[i])
+		}
+		export, err := printer.OutputResources(list, printer.OutputFormat(opts.Output), c.Scheme)
+		if err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Failed to output cluster supply chains:"), err)
+			return cli.SilenceError(err)
+		}
+
+		c.Printf("%s\n", export)
+		return nil
+	}
+
 	if len(supplyChain.Items) == 0 {
 		c.Infof("No cluster supply chains found.\n")
 		return nil
 	}
 
-	tablePrinter := table.NewTablePrinter(table.PrintOptions{
-		// none for now
-	}).With(func(h table.PrintHandler) {
-		columns := opts.printColumns()
-		h.TableHandler(columns, opts.printList)
-		h.TableHandler(columns, opts.print)
-	})
-
-	supplyChain = supplyChain.DeepCopy()
-	printer.SortByNamespaceAndName(supplyChain.Items)
-
-	if err := tablePrinter.PrintObj(supplyChain, c.Stdout); err != nil {
+	if err := opts.printTable(c, supplyChain, countSelectingWorkloads(ctx, c)); err != nil {
 		return err
 	}
 
@@ -83,6 +93,38 @@ func (opts *ClusterSupplyChainListOptions) Exec(ctx context.Context, c *cli.Conf
 	return nil
 }
 
+func (opts *ClusterSupplyChainListOptions) printTable(c *cli.Config, supplyChains *cartov1alpha1.ClusterSupplyChainList, workloads map[string]int) error {
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{
+		// none for now
+	}).With(func(h table.PrintHandler) {
+		columns := opts.printColumns()
+		h.TableHandler(columns, func(supplyChains *cartov1alpha1.ClusterSupplyChainList, printOpts table.PrintOptions) ([]metav1beta1.TableRow, error) {
+			return opts.printList(supplyChains, workloads, printOpts)
+		})
+		h.TableHandler(columns, func(supplyChain *cartov1alpha1.ClusterSupplyChain, printOpts table.PrintOptions) ([]metav1beta1.TableRow, error) {
+			return opts.print(supplyChain, workloads, printOpts)
+		})
+	})
+
+	return tablePrinter.PrintObj(supplyChains, c.Stdout)
+}
+
+// countSelectingWorkloads counts, for each supply chain, the workloads across all namespaces
+// that report being selected by it. The result is nil when the workloads cannot be listed
+func countSelectingWorkloads(ctx context.Context, c *cli.Config) map[string]int {
+	workloads := &cartov1alpha1.WorkloadList{}
+	if err := c.List(ctx, workloads); err != nil {
+		return nil
+	}
+	counts := map[string]int{}
+	for _, workload := range workloads.Items {
+		if name := workload.Status.SupplyChainRef.Name; name != "" {
+			counts[name]++
+		}
+	}
+	return counts
+}
+
 func NewClusterSupplyChainListCommand(ctx context.Context, c *cli.Config) *cobra.Command {
 	opts := &ClusterSupplyChainListOptions{}
 
@@ -90,22 +132,27 @@ func NewClusterSupplyChainListCommand(ctx context.Context, c *cli.Config) *cobra
 		Use:   "list",
 		Short: "table listing of cluster supply chains",
 		Long: strings.TrimSpace(`
-List cluster supply chains.
+List cluster supply chains, along with the number of steps of each one and the number of
+workloads across all namespaces it currently selects.
 `),
 		Example: strings.Join([]string{
 			fmt.Sprintf("%s cluster-supply-chain list", c.Name),
+			fmt.Sprintf("%s cluster-supply-chain list %s wide", c.Name, flags.OutputFlagName),
+			fmt.Sprintf("%s cluster-supply-chain list %s name", c.Name, flags.OutputFlagName),
 		}, "\n"),
 		PreRunE: cli.ValidateE(ctx, opts),
 		RunE:    cli.ExecE(ctx, c, opts),
 	}
 
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the cluster supply chains formatted. Supported formats: \"json\", \"yaml\", \"yml\", \"wide\", \"name\", \"custom-columns=<columns>\", \"jsonpath=<expression>\", \"go-template=<template>\", \"go-template-file=<path>\"")
+
 	return cmd
 }
 
-func (opts *ClusterSupplyChainListOptions) printList(supplyChains *cartov1alpha1.ClusterSupplyChainList, printOpts table.PrintOptions) ([]metav1beta1.TableRow, error) {
+func (opts *ClusterSupplyChainListOptions) printList(supplyChains *cartov1alpha1.ClusterSupplyChainList, workloads map[string]int, printOpts table.PrintOptions) ([]metav1beta1.TableRow, error) {
 	rows := make([]metav1beta1.TableRow, 0, len(supplyChains.Items))
 	for i := range supplyChains.Items {
-		r, err := opts.print(&supplyChains.Items[i], printOpts)
+		r, err := opts.print(&supplyChains.Items[i], workloads, printOpts)
 		if err != nil {
 			return nil, err
 		}
@@ -114,23 +161,53 @@ func (opts *ClusterSupplyChainListOptions) printList(supplyChains *cartov1alpha1
 	return rows, nil
 }
 
-func (opts *ClusterSupplyChainListOptions) print(supplyChain *cartov1alpha1.ClusterSupplyChain, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+func (opts *ClusterSupplyChainListOptions) print(supplyChain *cartov1alpha1.ClusterSupplyChain, workloads map[string]int, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
 	now := time.Now()
 	row := metav1beta1.TableRow{
 		Object: runtime.RawExtension{Object: supplyChain},
 	}
+	ready := printer.FindCondition(supplyChain.Status.Conditions, "Ready")
+	workloadsCount := printer.Sfaintf("<unknown>")
+	if workloads != nil {
+		workloadsCount = fmt.Sprintf("%d", workloads[supplyChain.Name])
+	}
 	row.Cells = append(row.Cells,
 		supplyChain.Name,
-		printer.ConditionStatus(printer.FindCondition(supplyChain.Status.Conditions, "Ready")),
+		printer.ConditionStatus(ready),
+		len(supplyChain.Spec.Resources),
+		workloadsCount,
 		printer.TimestampSince(supplyChain.CreationTimestamp, now),
 	)
+	if opts.Output == printer.OutputFormatWide {
+		reason := ""
+		if ready != nil {
+			reason = ready.Reason
+		}
+		row.Cells = append(row.Cells,
+			printer.EmptyString(reason),
+			printer.EmptyString(printer.ClusterSupplyChainTemplatesReadyReason(supplyChain)),
+			printer.EmptyString(printer.ClusterSupplyChainServiceAccount(supplyChain)),
+			printer.Labels(supplyChain.Spec.Selector),
+		)
+	}
 	return []metav1beta1.TableRow{row}, nil
 }
 
 func (opts *ClusterSupplyChainListOptions) printColumns() []metav1beta1.TableColumnDefinition {
-	return []metav1beta1.TableColumnDefinition{
+	columns := []metav1beta1.TableColumnDefinition{
 		{Name: "Name", Type: "string"},
 		{Name: "Ready", Type: "string"},
+		{Name: "Steps", Type: "integer"},
+		{Name: "Workloads", Type: "string"},
 		{Name: "Age", Type: "string"},
 	}
+	if opts.Output == printer.OutputFormatWide {
+		columns = append(columns,
+			metav1beta1.TableColumnDefinition{Name: "Reason", Type: "string"},
+			metav1beta1.TableColumnDefinition{Name: "Templates Ready", Type: "string"},
+			metav1beta1.TableColumnDefinition{Name: "Service Account", Type: "string"},
+			metav1beta1.TableColumnDefinition{Name: "Selector", Type: "string"},
+		)
+	}
+	return columns
 }
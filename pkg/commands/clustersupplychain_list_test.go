@@ -26,10 +26,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
 
 	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
 	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
 )
 
 func TestClusterSupplyChainListOptionsValidate(t *testing.T) {
@@ -39,6 +41,20 @@ func TestClusterSupplyChainListOptionsValidate(t *testing.T) {
 			Validatable:    &commands.ClusterSupplyChainListOptions{},
 			ShouldValidate: true,
 		},
+		{
+			Name: "valid output format",
+			Validatable: &commands.ClusterSupplyChainListOptions{
+				Output: "wide",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid output format",
+			Validatable: &commands.ClusterSupplyChainListOptions{
+				Output: "table",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("table", flags.OutputFlagName, []string{"json", "yaml", "yml", "wide", "name", "custom-columns", "jsonpath", "go-template", "go-template-file"}),
+		},
 	}
 
 	table.Run(t)
@@ -81,8 +97,8 @@ No cluster supply chains found.
 					}),
 			},
 			ExpectOutput: `
-NAME                READY   AGE
-test-supply-chain   Ready   2y
+NAME                READY   STEPS   WORKLOADS   AGE
+test-supply-chain   Ready   0       0           2y
 
 To view details: "tanzu apps cluster-supply-chain get <name>"
 
@@ -95,11 +111,133 @@ To view details: "tanzu apps cluster-supply-chain get <name>"
 				parent,
 			},
 			ExpectOutput: `
-NAME                READY       AGE
-test-supply-chain   <unknown>   2y
+NAME                READY       STEPS   WORKLOADS   AGE
+test-supply-chain   <unknown>   0       0           2y
+
+To view details: "tanzu apps cluster-supply-chain get <name>"
+
+`,
+		},
+		{
+			Name: "lists items in wide format",
+			Args: []string{flags.OutputFlagName, "wide"},
+			GivenObjects: []client.Object{
+				parent.
+					SpecDie(func(d *diecartov1alpha1.SupplyChainSpecDie) {
+						d.Selector(map[string]string{
+							"apps.tanzu.vmware.com/workload-type": "web",
+						})
+					}).
+					StatusDie(func(d *diecartov1alpha1.SupplyChainStatusDie) {
+						d.ConditionsDie(
+							diecartov1alpha1.ClusterSupplyChainConditionReadyBlank.
+								Status(metav1.ConditionTrue).
+								Reason("Ready"),
+						)
+					}),
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("basic-image-to-url")
+					}),
+			},
+			ExpectOutput: `
+NAME                 READY       STEPS   WORKLOADS   AGE   REASON    TEMPLATES READY   SERVICE ACCOUNT   SELECTOR
+basic-image-to-url   <unknown>   0       0           2y    <empty>   <empty>           <empty>           <empty>
+test-supply-chain    Ready       0       0           2y    Ready     <empty>           <empty>           apps.tanzu.vmware.com/workload-type=web
+
+To view details: "tanzu apps cluster-supply-chain get <name>"
+
+`,
+		},
+		{
+			Name: "lists items with their workloads",
+			Args: []string{flags.OutputFlagName, "wide"},
+			GivenObjects: []client.Object{
+				parent.
+					SpecDie(func(d *diecartov1alpha1.SupplyChainSpecDie) {
+						d.ServiceAccountRef(cartov1alpha1.ServiceAccountRef{Name: "supply-chain-sa", Namespace: "build"})
+						d.Resources(
+							cartov1alpha1.SupplyChainResource{Name: "source-provider"},
+							cartov1alpha1.SupplyChainResource{Name: "image-builder"},
+						)
+					}).
+					StatusDie(func(d *diecartov1alpha1.SupplyChainStatusDie) {
+						d.ConditionsDie(
+							diecartov1alpha1.ClusterSupplyChainConditionReadyBlank.
+								Status(metav1.ConditionFalse).
+								Reason("TemplatesNotFound"),
+							diecartov1alpha1.ClusterSupplyChainConditionTemplatesReadyBlank.
+								Status(metav1.ConditionFalse).
+								Reason("TemplatesNotFound"),
+						)
+					}),
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("basic-image-to-url")
+					}),
+				diecartov1alpha1.WorkloadBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("my-workload")
+						d.Namespace("default")
+					}).
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.SupplyChainRef(cartov1alpha1.ObjectReference{Kind: "ClusterSupplyChain", Name: supplyChainName})
+					}),
+				diecartov1alpha1.WorkloadBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("my-workload")
+						d.Namespace("dev")
+					}).
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.SupplyChainRef(cartov1alpha1.ObjectReference{Kind: "ClusterSupplyChain", Name: supplyChainName})
+					}),
+			},
+			ExpectOutput: `
+NAME                 READY               STEPS   WORKLOADS   AGE   REASON              TEMPLATES READY     SERVICE ACCOUNT         SELECTOR
+basic-image-to-url   <unknown>           0       0           2y    <empty>             <empty>             <empty>                 <empty>
+test-supply-chain    TemplatesNotFound   2       2           2y    TemplatesNotFound   TemplatesNotFound   build/supply-chain-sa   <empty>
+
+To view details: "tanzu apps cluster-supply-chain get <name>"
+
+`,
+		},
+		{
+			Name: "workloads cannot be listed",
+			Args: []string{},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("list", "WorkloadList"),
+			},
+			ExpectOutput: `
+NAME                READY       STEPS   WORKLOADS   AGE
+test-supply-chain   <unknown>   0       <unknown>   2y
 
 To view details: "tanzu apps cluster-supply-chain get <name>"
 
+`,
+		},
+		{
+			Name: "lists items in name format",
+			Args: []string{flags.OutputFlagName, "name"},
+			GivenObjects: []client.Object{
+				parent,
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("basic-image-to-url")
+					}),
+			},
+			ExpectOutput: `
+clustersupplychain.carto.run/basic-image-to-url
+clustersupplychain.carto.run/test-supply-chain
+`,
+		},
+		{
+			Name: "empty in json format",
+			Args: []string{flags.OutputFlagName, "json"},
+			ExpectOutput: `
+[]
 `,
 		},
 		{
@@ -0,0 +1,397 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	cliprinter "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+type ClusterSupplyChainMatchOptions struct {
+	WorkloadOptions
+}
+
+var (
+	_ validation.Validatable = (*ClusterSupplyChainMatchOptions)(nil)
+	_ cli.Executable         = (*ClusterSupplyChainMatchOptions)(nil)
+)
+
+// SupplyChainMatches is the machine readable result of the match command
+type SupplyChainMatches struct {
+	Workload string `json:"workload"`
+	// Selected is the name of the supply chain selected for the workload, empty when no supply
+	// chain or more than one supply chain would be selected
+	Selected string `json:"selected"`
+	// Reason is the reason the workload would report when no single supply chain is selected
+	Reason       string             `json:"reason"`
+	SupplyChains []SupplyChainMatch `json:"supplyChains"`
+}
+
+// SupplyChainMatch is the result of evaluating the selectors of a cluster supply chain
+// against a workload
+type SupplyChainMatch struct {
+	Name string `json:"name"`
+	// Specificity is the number of selector terms of the supply chain, when several supply chains
+	// match the workload only the most specific one is selected
+	Specificity int  `json:"specificity"`
+	Matched     bool `json:"matched"`
+	Selected    bool `json:"selected"`
+	// FailedSelectors are the selector terms the workload does not satisfy
+	FailedSelectors []string `json:"failedSelectors"`
+}
+
+func (opts *ClusterSupplyChainMatchOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	if opts.Name != "" {
+		errs = errs.Also(validation.K8sName(opts.Name, cli.NameArgumentName))
+	}
+	errs = errs.Also(validation.DeletableKeyValues(opts.Labels, flags.LabelFlagName))
+	errs = errs.Also(validation.DeletableKeyValues(opts.Annotations, flags.AnnotationFlagName))
+	errs = errs.Also(validation.DeletableKeyValues(opts.Params, flags.ParamFlagName))
+
+	if opts.Output != "" {
+		errs = errs.Also(validation.OutputFormat(opts.Output, flags.OutputFlagName, printer.ObjectOutputFormats))
+	}
+
+	return errs
+}
+
+func (opts *ClusterSupplyChainMatchOptions) Exec(ctx context.Context, c *cli.Config) error {
+	workload := &cartov1alpha1.Workload{}
+	if opts.FilePath != "" {
+		if err := opts.WorkloadOptions.LoadInputWorkload(c.Stdin, workload); err != nil {
+			return err
+		}
+
+		if opts.Name == "" {
+			opts.Name = workload.Name
+		}
+		if workload.Namespace != "" && !cli.CommandFromContext(ctx).Flags().Changed(cli.StripDash(flags.NamespaceFlagName)) {
+			opts.Namespace = workload.Namespace
+		}
+	}
+
+	if opts.Name != "" {
+		// flags are layered on top of the workload in the cluster, as apply would
+		_, _, resolved, err := opts.resolveWorkload(ctx, c, workload, mergeUpdateStrategy)
+		if err != nil {
+			return err
+		}
+		workload = resolved
+	} else {
+		opts.ApplyOptionsToWorkload(ctx, nil, workload)
+	}
+
+	supplyChains := &cartov1alpha1.ClusterSupplyChainList{}
+	if err := c.List(ctx, supplyChains); err != nil {
+		return err
+	}
+	result := matchSupplyChains(workload, supplyChains.Items)
+	result.Workload = workload.Name
+
+	if opts.Output != "" {
+		export, err := printer.OutputObject(result, printer.OutputFormat(opts.Output))
+		if err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Failed to output supply chain matches:"), err)
+			return cli.SilenceError(err)
+		}
+		c.Printf("%s\n", export)
+		return nil
+	}
+
+	if len(result.SupplyChains) == 0 {
+		c.Infof("No cluster supply chains found.\n")
+		return nil
+	}
+
+	if result.Selected != "" {
+		c.Successf("Cluster supply chain %q would be selected\n", result.Selected)
+	} else {
+		c.Emoji(cli.Exclamation, cliprinter.Sinfof("WARNING: %s\n", supplyChainSelectionWarning(result)))
+	}
+	c.Printf("\n")
+
+	matchTable := &metav1beta1.Table{
+		ColumnDefinitions: []metav1beta1.TableColumnDefinition{
+			{Name: "Name", Type: "string"},
+			{Name: "Specificity", Type: "integer"},
+			{Name: "Match", Type: "string"},
+			{Name: "Failed Selectors", Type: "string"},
+		},
+	}
+	for _, match := range result.SupplyChains {
+		status := cliprinter.Serrorf("no")
+		if match.Selected {
+			status = cliprinter.Ssuccessf("selected")
+		} else if match.Matched {
+			status = cliprinter.Swarnf("less specific")
+		}
+		failed := []string{cliprinter.Sfaintf("<none>")}
+		if len(match.FailedSelectors) != 0 {
+			failed = match.FailedSelectors
+		}
+		for i, selector := range failed {
+			if i == 0 {
+				matchTable.Rows = append(matchTable.Rows, metav1beta1.TableRow{Cells: []interface{}{match.Name, match.Specificity, status, selector}})
+				continue
+			}
+			matchTable.Rows = append(matchTable.Rows, metav1beta1.TableRow{Cells: []interface{}{"", "", "", selector}})
+		}
+	}
+	return table.NewTablePrinter(table.PrintOptions{}).PrintObj(matchTable, c.Stdout)
+}
+
+// matchSupplyChains evaluates the selectors of each supply chain against the workload the same
+// way Cartographer does: a supply chain matches when the workload satisfies all its selector
+// terms, and among the matches the ones with the most terms are selected. The supply chains
+// are sorted with the selected and matching ones first, followed by the closest misses.
+func matchSupplyChains(workload *cartov1alpha1.Workload, supplyChains []cartov1alpha1.ClusterSupplyChain) SupplyChainMatches {
+	result := SupplyChainMatches{SupplyChains: []SupplyChainMatch{}}
+	fields, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(workload)
+
+	specificity := 0
+	for i := range supplyChains {
+		spec := supplyChains[i].Spec
+		match := SupplyChainMatch{
+			Name:            supplyChains[i].Name,
+			Specificity:     len(spec.Selector) + len(spec.SelectorMatchExpressions) + len(spec.SelectorMatchFields),
+			FailedSelectors: []string{},
+		}
+		if match.Specificity == 0 {
+			match.FailedSelectors = append(match.FailedSelectors, "no selectors defined")
+		}
+
+		keys := make([]string, 0, len(spec.Selector))
+		for key := range spec.Selector {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if value, ok := workload.Labels[key]; !ok {
+				match.FailedSelectors = append(match.FailedSelectors, fmt.Sprintf("labels %s=%s (not set)", key, spec.Selector[key]))
+			} else if value != spec.Selector[key] {
+				match.FailedSelectors = append(match.FailedSelectors, fmt.Sprintf("labels %s=%s (is %q)", key, spec.Selector[key], value))
+			}
+		}
+		for _, expression := range spec.SelectorMatchExpressions {
+			selector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{expression}})
+			if err != nil || !selector.Matches(labels.Set(workload.Labels)) {
+				match.FailedSelectors = append(match.FailedSelectors, supplyChainSelectorTerm("expressions", expression.Key, string(expression.Operator), expression.Values))
+			}
+		}
+		for _, field := range spec.SelectorMatchFields {
+			if !workloadFieldMatches(fields, field) {
+				match.FailedSelectors = append(match.FailedSelectors, supplyChainSelectorTerm("fields", field.Key, string(field.Operator), field.Values))
+			}
+		}
+
+		match.Matched = len(match.FailedSelectors) == 0
+		if match.Matched && match.Specificity > specificity {
+			specificity = match.Specificity
+		}
+		result.SupplyChains = append(result.SupplyChains, match)
+	}
+
+	selected := []string{}
+	for i := range result.SupplyChains {
+		if result.SupplyChains[i].Matched && result.SupplyChains[i].Specificity == specificity {
+			result.SupplyChains[i].Selected = true
+			selected = append(selected, result.SupplyChains[i].Name)
+		}
+	}
+	switch len(selected) {
+	case 0:
+		result.Reason = cartov1alpha1.NotFoundSupplyChainReadyReason
+	case 1:
+		result.Selected = selected[0]
+	default:
+		result.Reason = cartov1alpha1.MultipleMatchesSupplyChainReadyReason
+	}
+
+	sort.SliceStable(result.SupplyChains, func(i, j int) bool {
+		a, b := result.SupplyChains[i], result.SupplyChains[j]
+		if a.Selected != b.Selected {
+			return a.Selected
+		}
+		if a.Matched != b.Matched {
+			return a.Matched
+		}
+		if a.Matched {
+			if a.Specificity != b.Specificity {
+				return a.Specificity > b.Specificity
+			}
+		} else if len(a.FailedSelectors) != len(b.FailedSelectors) {
+			return len(a.FailedSelectors) < len(b.FailedSelectors)
+		}
+		return a.Name < b.Name
+	})
+
+	return result
+}
+
+// supplyChainSelectionWarning explains why no single supply chain would be selected
+func supplyChainSelectionWarning(result SupplyChainMatches) string {
+	if result.Reason == cartov1alpha1.MultipleMatchesSupplyChainReadyReason {
+		names := []string{}
+		for _, match := range result.SupplyChains {
+			if match.Selected {
+				names = append(names, match.Name)
+			}
+		}
+		return fmt.Sprintf("Workload matches several cluster supply chains with the same specificity (%s): %s", result.Reason, strings.Join(names, ", "))
+	}
+	return fmt.Sprintf("Workload does not match any cluster supply chain (%s)", result.Reason)
+}
+
+// warnSupplyChainSelection warns when no cluster supply chain, or more than one, would select the
+// workload, and returns the supply chain selected otherwise. Nothing is reported when the supply
+// chains cannot be listed or none is installed. The warning is written to stderr when shouldPrint
+// is false
+func warnSupplyChainSelection(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload, shouldPrint bool) *cartov1alpha1.ClusterSupplyChain {
+	supplyChains := &cartov1alpha1.ClusterSupplyChainList{}
+	if err := c.List(ctx, supplyChains); err != nil || len(supplyChains.Items) == 0 {
+		return nil
+	}
+	result := matchSupplyChains(workload, supplyChains.Items)
+	if result.Selected == "" {
+		printWarning(c, shouldPrint, "%s\n", supplyChainSelectionWarning(result))
+		hint := c.Infof
+		if !shouldPrint {
+			hint = c.Einfof
+		}
+		hint("To see the selectors of each cluster supply chain: \"tanzu apps cluster-supply-chain match %s%s\"\n", workload.Name, namespaceFlag(c, workload.Namespace))
+		return nil
+	}
+	for i := range supplyChains.Items {
+		if supplyChains.Items[i].Name == result.Selected {
+			return &supplyChains.Items[i]
+		}
+	}
+	return nil
+}
+
+func supplyChainSelectorTerm(kind, key, operator string, values []string) string {
+	if len(values) == 0 {
+		return fmt.Sprintf("%s %s %s", kind, key, operator)
+	}
+	return fmt.Sprintf("%s %s %s [%s]", kind, key, operator, strings.Join(values, ", "))
+}
+
+// workloadFieldMatches evaluates a field selector requirement against the unstructured workload,
+// the key is a path to the field like "spec.source.image"
+func workloadFieldMatches(fields map[string]interface{}, requirement cartov1alpha1.FieldSelectorRequirement) bool {
+	var values []string
+	path := jsonpath.New("").AllowMissingKeys(true)
+	if err := path.Parse(fmt.Sprintf("{.%s}", strings.TrimPrefix(requirement.Key, "."))); err != nil {
+		return false
+	}
+	results, err := path.FindResults(fields)
+	if err != nil {
+		return false
+	}
+	for _, result := range results {
+		for _, value := range result {
+			if value.IsValid() && value.CanInterface() && value.Interface() != nil {
+				values = append(values, fmt.Sprint(value.Interface()))
+			}
+		}
+	}
+
+	switch requirement.Operator {
+	case "Exists":
+		return len(values) != 0
+	case "DoesNotExist":
+		return len(values) == 0
+	case "In":
+		for _, value := range values {
+			for _, allowed := range requirement.Values {
+				if value == allowed {
+					return true
+				}
+			}
+		}
+		return false
+	case "NotIn":
+		for _, value := range values {
+			for _, denied := range requirement.Values {
+				if value == denied {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func NewClusterSupplyChainMatchCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &ClusterSupplyChainMatchOptions{}
+	opts.LoadDefaults(c)
+
+	cmd := &cobra.Command{
+		Use:   "match",
+		Short: "Preview the cluster supply chain a workload would select",
+		Long: strings.TrimSpace(`
+Evaluate the selectors of all the cluster supply chains against a workload, without creating
+or updating it. The workload is described by a file, flags or both, layered the same way as in
+apply, on top of the workload in the cluster when a name is provided and the workload exists.
+
+A supply chain matches when the workload satisfies all its label, expression and field
+selectors. When several supply chains match, the one with the most selectors is selected,
+supply chains with the same number of selectors are ambiguous and none of them is selected.
+For the supply chains that do not match, the selectors the workload does not satisfy are shown.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s cluster-supply-chain match %s worker", c.Name, flags.TypeFlagName),
+			fmt.Sprintf("%s cluster-supply-chain match %s workload.yaml", c.Name, flags.FilePathFlagName),
+			fmt.Sprintf("%s cluster-supply-chain match my-workload %s apps.tanzu.vmware.com/has-tests=true", c.Name, flags.LabelFlagName),
+		}, "\n"),
+		PreRunE:           cli.ValidateE(ctx, opts),
+		RunE:              cli.ExecE(ctx, c, opts),
+		ValidArgsFunction: completion.SuggestWorkloadNames(ctx, c),
+	}
+
+	cli.Args(cmd,
+		cli.OptionalNameArg(&opts.Name),
+	)
+
+	opts.DefineSpecFlags(ctx, c, cmd)
+	cmd.Flags().StringVarP(&opts.FilePath, cli.StripDash(flags.FilePathFlagName), "f", "", "`file path` containing the description of a single workload, other flags are layered on top of this resource. Use value \"-\" to read from stdin")
+	cmd.MarkFlagFilename(cli.StripDash(flags.FilePathFlagName), ".yaml", ".yml")
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the supply chain matches formatted. Supported formats: \"json\", \"yaml\", \"yml\", \"custom-columns=<columns>\", \"jsonpath=<expression>\", \"go-template=<template>\", \"go-template-file=<path>\"")
+
+	return cmd
+}
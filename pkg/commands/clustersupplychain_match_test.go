@@ -0,0 +1,300 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"testing"
+
+	diecorev1 "dies.dev/apis/core/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+func TestClusterSupplyChainMatchOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:           "empty",
+			Validatable:    &commands.ClusterSupplyChainMatchOptions{},
+			ShouldValidate: true,
+		},
+		{
+			Name: "valid",
+			Validatable: &commands.ClusterSupplyChainMatchOptions{
+				WorkloadOptions: commands.WorkloadOptions{
+					Namespace: "default",
+					Name:      "my-workload",
+					Labels:    []string{"apps.tanzu.vmware.com/has-tests=true"},
+					Output:    "json",
+				},
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid name",
+			Validatable: &commands.ClusterSupplyChainMatchOptions{
+				WorkloadOptions: commands.WorkloadOptions{
+					Name: "my-",
+				},
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("my-", cli.NameArgumentName),
+		},
+		{
+			Name: "invalid label",
+			Validatable: &commands.ClusterSupplyChainMatchOptions{
+				WorkloadOptions: commands.WorkloadOptions{
+					Labels: []string{"=true"},
+				},
+			},
+			ExpectFieldErrors: validation.ErrInvalidArrayValue("=true", flags.LabelFlagName, 0),
+		},
+		{
+			Name: "invalid output format",
+			Validatable: &commands.ClusterSupplyChainMatchOptions{
+				WorkloadOptions: commands.WorkloadOptions{
+					Output: "wide",
+				},
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("wide", flags.OutputFlagName, printer.ObjectOutputFormats),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestClusterSupplyChainMatchCommand(t *testing.T) {
+	defaultNamespace := "default"
+	workloadName := "my-workload"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	namespace := diecorev1.NamespaceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(defaultNamespace)
+		})
+	sourceToURL := diecartov1alpha1.ClusterSupplyChainBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("source-to-url")
+		}).
+		SpecDie(func(d *diecartov1alpha1.SupplyChainSpecDie) {
+			d.SelectorMatchExpressions(metav1.LabelSelectorRequirement{
+				Key:      apis.WorkloadTypeLabelName,
+				Operator: metav1.LabelSelectorOpIn,
+				Values:   []string{"web", "server", "worker"},
+			})
+		})
+	sourceTest := diecartov1alpha1.ClusterSupplyChainBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("source-test-to-url")
+		}).
+		SpecDie(func(d *diecartov1alpha1.SupplyChainSpecDie) {
+			d.Selector(map[string]string{"apps.tanzu.vmware.com/has-tests": "true"})
+			d.SelectorMatchExpressions(metav1.LabelSelectorRequirement{
+				Key:      apis.WorkloadTypeLabelName,
+				Operator: metav1.LabelSelectorOpIn,
+				Values:   []string{"web", "server", "worker"},
+			})
+		})
+	basicImage := diecartov1alpha1.ClusterSupplyChainBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("basic-image-to-url")
+		}).
+		SpecDie(func(d *diecartov1alpha1.SupplyChainSpecDie) {
+			d.SelectorMatchExpressions(metav1.LabelSelectorRequirement{
+				Key:      apis.WorkloadTypeLabelName,
+				Operator: metav1.LabelSelectorOpIn,
+				Values:   []string{"web", "server", "worker"},
+			})
+			d.SelectorMatchFields(cartov1alpha1.FieldSelectorRequirement{
+				Key:      "spec.image",
+				Operator: "Exists",
+			})
+		})
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name: "no supply chains",
+			Args: []string{},
+			ExpectOutput: `
+No cluster supply chains found.
+`,
+		},
+		{
+			Name:         "selects the supply chain for the default type",
+			Args:         []string{},
+			GivenObjects: []client.Object{sourceToURL, sourceTest, basicImage},
+			ExpectOutput: `
+Cluster supply chain "source-to-url" would be selected
+
+NAME                 SPECIFICITY   MATCH      FAILED SELECTORS
+source-to-url        1             selected   <none>
+basic-image-to-url   2             no         fields spec.image Exists
+source-test-to-url   2             no         labels apps.tanzu.vmware.com/has-tests=true (not set)
+`,
+		},
+		{
+			Name:         "selects the most specific supply chain",
+			Args:         []string{flags.LabelFlagName, "apps.tanzu.vmware.com/has-tests=true"},
+			GivenObjects: []client.Object{sourceToURL, sourceTest, basicImage},
+			ExpectOutput: `
+Cluster supply chain "source-test-to-url" would be selected
+
+NAME                 SPECIFICITY   MATCH           FAILED SELECTORS
+source-test-to-url   2             selected        <none>
+source-to-url        1             less specific   <none>
+basic-image-to-url   2             no              fields spec.image Exists
+`,
+		},
+		{
+			Name:         "selects the supply chain with field selectors",
+			Args:         []string{flags.ImageFlagName, "registry.example.com/my-workload:latest"},
+			GivenObjects: []client.Object{sourceToURL, sourceTest, basicImage},
+			ExpectOutput: `
+Cluster supply chain "basic-image-to-url" would be selected
+
+NAME                 SPECIFICITY   MATCH           FAILED SELECTORS
+basic-image-to-url   2             selected        <none>
+source-to-url        1             less specific   <none>
+source-test-to-url   2             no              labels apps.tanzu.vmware.com/has-tests=true (not set)
+`,
+		},
+		{
+			Name:         "no supply chain matches",
+			Args:         []string{flags.TypeFlagName, "function"},
+			GivenObjects: []client.Object{sourceToURL, sourceTest, basicImage},
+			ExpectOutput: `
+❗ WARNING: Workload does not match any cluster supply chain (SupplyChainNotFound)
+
+NAME                 SPECIFICITY   MATCH   FAILED SELECTORS
+source-to-url        1             no      expressions apps.tanzu.vmware.com/workload-type In [web, server, worker]
+basic-image-to-url   2             no      expressions apps.tanzu.vmware.com/workload-type In [web, server, worker]
+                                           fields spec.image Exists
+source-test-to-url   2             no      labels apps.tanzu.vmware.com/has-tests=true (not set)
+                                           expressions apps.tanzu.vmware.com/workload-type In [web, server, worker]
+`,
+		},
+		{
+			Name: "multiple supply chains with the same specificity",
+			Args: []string{},
+			GivenObjects: []client.Object{
+				sourceToURL,
+				sourceToURL.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("other-source-to-url")
+					}),
+			},
+			ExpectOutput: `
+❗ WARNING: Workload matches several cluster supply chains with the same specificity (MultipleSupplyChainMatches): other-source-to-url, source-to-url
+
+NAME                  SPECIFICITY   MATCH      FAILED SELECTORS
+other-source-to-url   1             selected   <none>
+source-to-url         1             selected   <none>
+`,
+		},
+		{
+			Name: "layers flags on the workload in the cluster",
+			Args: []string{workloadName, flags.LabelFlagName, "apps.tanzu.vmware.com/has-tests=true"},
+			GivenObjects: []client.Object{
+				sourceToURL,
+				sourceTest,
+				diecartov1alpha1.WorkloadBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(workloadName)
+						d.Namespace(defaultNamespace)
+						d.AddLabel(apis.WorkloadTypeLabelName, "worker")
+					}),
+			},
+			ExpectOutput: `
+Cluster supply chain "source-test-to-url" would be selected
+
+NAME                 SPECIFICITY   MATCH           FAILED SELECTORS
+source-test-to-url   2             selected        <none>
+source-to-url        1             less specific   <none>
+`,
+		},
+		{
+			Name:         "workload file",
+			Args:         []string{flags.FilePathFlagName, "./testdata/workload.yaml"},
+			GivenObjects: []client.Object{namespace, sourceToURL, sourceTest, basicImage},
+			ExpectOutput: `
+Cluster supply chain "source-to-url" would be selected
+
+NAME                 SPECIFICITY   MATCH      FAILED SELECTORS
+source-to-url        1             selected   <none>
+basic-image-to-url   2             no         fields spec.image Exists
+source-test-to-url   2             no         labels apps.tanzu.vmware.com/has-tests=true (not set)
+`,
+		},
+		{
+			Name:         "output in json format",
+			Args:         []string{workloadName, flags.TypeFlagName, "function", flags.OutputFlagName, printer.OutputFormatJson},
+			GivenObjects: []client.Object{namespace, sourceToURL, sourceTest},
+			ExpectOutput: `
+{
+	"workload": "my-workload",
+	"selected": "",
+	"reason": "SupplyChainNotFound",
+	"supplyChains": [
+		{
+			"name": "source-to-url",
+			"specificity": 1,
+			"matched": false,
+			"selected": false,
+			"failedSelectors": [
+				"expressions apps.tanzu.vmware.com/workload-type In [web, server, worker]"
+			]
+		},
+		{
+			"name": "source-test-to-url",
+			"specificity": 2,
+			"matched": false,
+			"selected": false,
+			"failedSelectors": [
+				"labels apps.tanzu.vmware.com/has-tests=true (not set)",
+				"expressions apps.tanzu.vmware.com/workload-type In [web, server, worker]"
+			]
+		}
+	]
+}
+`,
+		},
+		{
+			Name: "list error",
+			Args: []string{},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("list", "ClusterSupplyChainList"),
+			},
+			ShouldError: true,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewClusterSupplyChainMatchCommand)
+}
@@ -0,0 +1,245 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	cliprinter "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+type ClusterSupplyChainParamsOptions struct {
+	Name   string
+	Output string
+}
+
+var (
+	_ validation.Validatable = (*ClusterSupplyChainParamsOptions)(nil)
+	_ cli.Executable         = (*ClusterSupplyChainParamsOptions)(nil)
+)
+
+// SupplyChainParam is a param declared by a cluster supply chain, either for all its resources
+// or for a single step
+type SupplyChainParam struct {
+	Name string `json:"name"`
+	// Step is the name of the resource the param is declared for, empty when the param is
+	// declared for all the resources of the supply chain
+	Step    string                `json:"step,omitempty"`
+	Value   *apiextensionsv1.JSON `json:"value,omitempty"`
+	Default *apiextensionsv1.JSON `json:"default,omitempty"`
+	// Overridable is false when the supply chain fixes the value, a value set by the workload
+	// is ignored
+	Overridable bool `json:"overridable"`
+}
+
+func (opts *ClusterSupplyChainParamsOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+	if opts.Name == "" {
+		errs = errs.Also(validation.ErrMissingField(cli.NameArgumentName))
+	}
+
+	if opts.Output != "" {
+		errs = errs.Also(validation.OutputFormat(opts.Output, flags.OutputFlagName, printer.ObjectOutputFormats))
+	}
+
+	return errs
+}
+
+func (opts *ClusterSupplyChainParamsOptions) Exec(ctx context.Context, c *cli.Config) error {
+	supplyChain := &cartov1alpha1.ClusterSupplyChain{}
+	if err := c.Get(ctx, client.ObjectKey{Name: opts.Name}, supplyChain); err != nil {
+		if apierrs.IsNotFound(err) {
+			c.Errorf("Cluster Supply chain %q not found\n", opts.Name)
+			return cli.SilenceError(err)
+		}
+		return err
+	}
+	params := supplyChainParams(supplyChain)
+
+	if opts.Output != "" {
+		export, err := printer.OutputObject(params, printer.OutputFormat(opts.Output))
+		if err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Failed to output supply chain params:"), err)
+			return cli.SilenceError(err)
+		}
+		c.Printf("%s\n", export)
+		return nil
+	}
+
+	if len(params) == 0 {
+		c.Infof("No params declared by cluster supply chain %q.\n", supplyChain.Name)
+		return nil
+	}
+
+	paramsTable := &metav1beta1.Table{
+		ColumnDefinitions: []metav1beta1.TableColumnDefinition{
+			{Name: "Name", Type: "string"},
+			{Name: "Step", Type: "string"},
+			{Name: "Default", Type: "string"},
+			{Name: "Overridable", Type: "string"},
+		},
+	}
+	for _, param := range params {
+		step := cliprinter.Sfaintf("<all>")
+		if param.Step != "" {
+			step = param.Step
+		}
+		value := param.Default
+		overridable := cliprinter.Ssuccessf("yes")
+		if !param.Overridable {
+			value = param.Value
+			overridable = cliprinter.Swarnf("no")
+		}
+		defaultValue := cliprinter.Sfaintf("<none>")
+		if value != nil && len(value.Raw) != 0 {
+			defaultValue = string(value.Raw)
+		}
+		paramsTable.Rows = append(paramsTable.Rows, metav1beta1.TableRow{Cells: []interface{}{param.Name, step, defaultValue, overridable}})
+	}
+	return table.NewTablePrinter(table.PrintOptions{}).PrintObj(paramsTable, c.Stdout)
+}
+
+// supplyChainParams collects the params declared by the supply chain and by each of its
+// resources, sorted by name with the params declared for all the resources first
+func supplyChainParams(supplyChain *cartov1alpha1.ClusterSupplyChain) []SupplyChainParam {
+	params := []SupplyChainParam{}
+	add := func(step string, declared []cartov1alpha1.DelegatableParam) {
+		for _, p := range declared {
+			params = append(params, SupplyChainParam{
+				Name:        p.Name,
+				Step:        step,
+				Value:       p.Value,
+				Default:     p.DefaultValue,
+				Overridable: p.Value == nil,
+			})
+		}
+	}
+	add("", supplyChain.Spec.Params)
+	for _, resource := range supplyChain.Spec.Resources {
+		add(resource.Name, resource.Params)
+	}
+
+	sort.SliceStable(params, func(i, j int) bool {
+		if params[i].Name != params[j].Name {
+			return params[i].Name < params[j].Name
+		}
+		return params[i].Step == "" && params[j].Step != ""
+	})
+	return params
+}
+
+// supplyChainParamProblems reports the keys the workload sets that the supply chain does not
+// declare, or that it declares with a fixed value
+func supplyChainParamProblems(supplyChain *cartov1alpha1.ClusterSupplyChain, keys []string) []string {
+	params := supplyChainParams(supplyChain)
+	problems := []string{}
+	for _, key := range keys {
+		declared := false
+		for _, param := range params {
+			if param.Name != key {
+				continue
+			}
+			declared = true
+			if param.Overridable {
+				continue
+			}
+			if param.Step == "" {
+				problems = append(problems, fmt.Sprintf("param %q has a fixed value in cluster supply chain %q, the workload value is ignored", key, supplyChain.Name))
+			} else {
+				problems = append(problems, fmt.Sprintf("param %q has a fixed value for step %q of cluster supply chain %q, the workload value is ignored there", key, param.Step, supplyChain.Name))
+			}
+		}
+		if !declared {
+			problems = append(problems, fmt.Sprintf("param %q is not declared by cluster supply chain %q", key, supplyChain.Name))
+		}
+	}
+	return problems
+}
+
+// suggestSupplyChainParams suggests the params that workloads may set, across all the cluster
+// supply chains
+func suggestSupplyChainParams(ctx context.Context, c *cli.Config) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		suggestions := []string{}
+		if strings.Contains(toComplete, "=") {
+			return suggestions, cobra.ShellCompDirectiveNoFileComp
+		}
+		supplyChainList := &cartov1alpha1.ClusterSupplyChainList{}
+		if err := c.List(ctx, supplyChainList); err != nil {
+			return suggestions, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		seen := map[string]bool{}
+		for i := range supplyChainList.Items {
+			for _, param := range supplyChainParams(&supplyChainList.Items[i]) {
+				if param.Overridable && !seen[param.Name] {
+					seen[param.Name] = true
+					suggestions = append(suggestions, param.Name+"=")
+				}
+			}
+		}
+		sort.Strings(suggestions)
+
+		return suggestions, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+	}
+}
+
+func NewClusterSupplyChainParamsCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &ClusterSupplyChainParamsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "List the params declared by a cluster supply chain",
+		Long: strings.TrimSpace(`
+List the params a cluster supply chain declares, for all its resources or for a single step,
+along with their default value.
+
+Params with a value fixed by the supply chain are not overridable, the value set by a workload
+with ` + flags.ParamFlagName + ` or ` + flags.ParamYamlFlagName + ` is ignored.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s cluster-supply-chain params source-to-url", c.Name),
+			fmt.Sprintf("%s cluster-supply-chain params source-to-url %s json", c.Name, flags.OutputFlagName),
+		}, "\n"),
+		PreRunE:           cli.ValidateE(ctx, opts),
+		RunE:              cli.ExecE(ctx, c, opts),
+		ValidArgsFunction: completion.SuggestClusterSupplyChainNames(ctx, c),
+	}
+	cli.Args(cmd,
+		cli.NameArg(&opts.Name),
+	)
+
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the supply chain params formatted. Supported formats: \"json\", \"yaml\", \"yml\", \"custom-columns=<columns>\", \"jsonpath=<expression>\", \"go-template=<template>\", \"go-template-file=<path>\"")
+
+	return cmd
+}
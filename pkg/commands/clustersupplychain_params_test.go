@@ -0,0 +1,199 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"testing"
+
+	diemetav1 "dies.dev/apis/meta/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+func TestClusterSupplyChainParamsOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:        "invalid empty",
+			Validatable: &commands.ClusterSupplyChainParamsOptions{},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMissingField(cli.NameArgumentName),
+			),
+		},
+		{
+			Name: "valid",
+			Validatable: &commands.ClusterSupplyChainParamsOptions{
+				Name:   "my-csc",
+				Output: printer.OutputFormatYaml,
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid output format",
+			Validatable: &commands.ClusterSupplyChainParamsOptions{
+				Name:   "my-csc",
+				Output: "wide",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("wide", flags.OutputFlagName, printer.ObjectOutputFormats),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestClusterSupplyChainParamsCommand(t *testing.T) {
+	supplyChainName := "test-supply-chain"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+
+	parent := diecartov1alpha1.ClusterSupplyChainBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(supplyChainName)
+		})
+	withParams := parent.
+		SpecDie(func(d *diecartov1alpha1.SupplyChainSpecDie) {
+			d.Params(
+				cartov1alpha1.DelegatableParam{Name: "maven_repository_url", Value: &apiextensionsv1.JSON{Raw: []byte(`"https://repo.example.com"`)}},
+				cartov1alpha1.DelegatableParam{Name: "gitops_branch", DefaultValue: &apiextensionsv1.JSON{Raw: []byte(`"main"`)}},
+			)
+			d.Resources(
+				cartov1alpha1.SupplyChainResource{
+					Name:        "source-provider",
+					TemplateRef: cartov1alpha1.SupplyChainTemplateReference{Kind: "ClusterSourceTemplate", Name: "source-template"},
+					Params: []cartov1alpha1.DelegatableParam{
+						{Name: "gitImplementation", DefaultValue: &apiextensionsv1.JSON{Raw: []byte(`"go-git"`)}},
+					},
+				},
+				cartov1alpha1.SupplyChainResource{
+					Name:        "image-builder",
+					TemplateRef: cartov1alpha1.SupplyChainTemplateReference{Kind: "ClusterImageTemplate", Name: "kpack-template"},
+					Params: []cartov1alpha1.DelegatableParam{
+						{Name: "clusterBuilder", DefaultValue: &apiextensionsv1.JSON{Raw: []byte(`"default"`)}},
+						{Name: "gitops_branch", Value: &apiextensionsv1.JSON{Raw: []byte(`"release"`)}},
+						{Name: "live-update"},
+					},
+				},
+			)
+		})
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "empty",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name:         "params",
+			Args:         []string{supplyChainName},
+			GivenObjects: []client.Object{withParams},
+			ExpectOutput: `
+NAME                   STEP              DEFAULT                      OVERRIDABLE
+clusterBuilder         image-builder     "default"                    yes
+gitImplementation      source-provider   "go-git"                     yes
+gitops_branch          <all>             "main"                       yes
+gitops_branch          image-builder     "release"                    no
+live-update            image-builder     <none>                       yes
+maven_repository_url   <all>             "https://repo.example.com"   no
+`,
+		},
+		{
+			Name:         "no params",
+			Args:         []string{supplyChainName},
+			GivenObjects: []client.Object{parent},
+			ExpectOutput: `
+No params declared by cluster supply chain "test-supply-chain".
+`,
+		},
+		{
+			Name:         "output in json format",
+			Args:         []string{supplyChainName, flags.OutputFlagName, printer.OutputFormatJson},
+			GivenObjects: []client.Object{withParams},
+			ExpectOutput: `
+[
+	{
+		"name": "clusterBuilder",
+		"step": "image-builder",
+		"default": "default",
+		"overridable": true
+	},
+	{
+		"name": "gitImplementation",
+		"step": "source-provider",
+		"default": "go-git",
+		"overridable": true
+	},
+	{
+		"name": "gitops_branch",
+		"default": "main",
+		"overridable": true
+	},
+	{
+		"name": "gitops_branch",
+		"step": "image-builder",
+		"value": "release",
+		"overridable": false
+	},
+	{
+		"name": "live-update",
+		"step": "image-builder",
+		"overridable": true
+	},
+	{
+		"name": "maven_repository_url",
+		"value": "https://repo.example.com",
+		"overridable": false
+	}
+]
+`,
+		},
+		{
+			Name: "not found",
+			Args: []string{supplyChainName},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "ClusterSupplyChain", clitesting.InduceFailureOpts{
+					Error: apierrors.NewNotFound(cartov1alpha1.Resource("ClusterSupplyChain"), supplyChainName),
+				}),
+			},
+			ExpectOutput: `
+Cluster Supply chain "test-supply-chain" not found
+`,
+			ShouldError: true,
+		},
+		{
+			Name:         "get error",
+			Args:         []string{supplyChainName},
+			GivenObjects: []client.Object{parent},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "ClusterSupplyChain"),
+			},
+			ShouldError: true,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewClusterSupplyChainParamsCommand)
+}
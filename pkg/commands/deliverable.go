@@ -0,0 +1,50 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+)
+
+func NewDeliverableCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "deliverable",
+		Short:   "Deliverables delivered to a cluster by a cluster delivery",
+		Aliases: []string{"deliverables", "dlv"},
+	}
+
+	cmd.AddCommand(NewDeliverableListCommand(ctx, c))
+	cmd.AddCommand(NewDeliverableGetCommand(ctx, c))
+	cmd.AddCommand(NewDeliverableTailCommand(ctx, c))
+
+	return cmd
+}
+
+// deliverableWorkloadName returns the name of the workload the deliverable was stamped for, the
+// pods and Knative services delivered are labeled with it. Deliverables created by hand are
+// expected to carry the name of the deliverable instead
+func deliverableWorkloadName(deliverable *cartov1alpha1.Deliverable) string {
+	if name := deliverable.Labels[cartov1alpha1.WorkloadLabelName]; name != "" {
+		return name
+	}
+	return deliverable.Name
+}
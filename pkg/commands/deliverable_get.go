@@ -0,0 +1,206 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	cliprinter "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/source"
+)
+
+type DeliverableGetOptions struct {
+	Namespace string
+	Name      string
+
+	Output string
+}
+
+var (
+	_ validation.Validatable = (*DeliverableGetOptions)(nil)
+	_ cli.Executable         = (*DeliverableGetOptions)(nil)
+)
+
+func (opts *DeliverableGetOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	if opts.Namespace == "" {
+		errs = errs.Also(validation.ErrMissingField(flags.NamespaceFlagName))
+	}
+
+	if opts.Name == "" {
+		errs = errs.Also(validation.ErrMissingField(cli.NameArgumentName))
+	}
+
+	if opts.Output != "" {
+		errs = errs.Also(validation.OutputFormat(opts.Output, flags.OutputFlagName, printer.TableOutputFormats))
+	}
+
+	return errs
+}
+
+func (opts *DeliverableGetOptions) Exec(ctx context.Context, c *cli.Config) error {
+	deliverable := &cartov1alpha1.Deliverable{}
+	err := c.Get(ctx, client.ObjectKey{Namespace: opts.Namespace, Name: opts.Name}, deliverable)
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			nsGet := &corev1.Namespace{}
+			if getErr := c.Get(ctx, types.NamespacedName{Name: opts.Namespace}, nsGet); getErr != nil && apierrs.IsNotFound(getErr) {
+				c.Eprintf("%s %s\n", printer.Serrorf("Error:"), fmt.Sprintf("namespace %q not found, it may not exist or user does not have permissions to read it.", opts.Namespace))
+				return cli.SilenceError(getErr)
+			}
+			c.Errorf("Deliverable %q not found\n", fmt.Sprintf("%s/%s", opts.Namespace, opts.Name))
+			return cli.SilenceError(err)
+		}
+
+		return err
+	}
+
+	if opts.Output == printer.OutputFormatWide {
+		listOpts := &DeliverableListOptions{Namespace: opts.Namespace, Output: opts.Output}
+		return listOpts.printTable(c, &cartov1alpha1.DeliverableList{Items: []cartov1alpha1.Deliverable{*deliverable}})
+	}
+
+	if opts.Output != "" {
+		export, err := printer.OutputResource(deliverable, printer.OutputFormat(opts.Output), c.Scheme)
+		if err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Failed to output deliverable:"), err)
+			return cli.SilenceError(err)
+		}
+
+		c.Printf("%s\n", export)
+		return nil
+	}
+
+	return opts.printDetails(ctx, c, deliverable)
+}
+
+// printDetails prints the human readable view of the deliverable
+func (opts *DeliverableGetOptions) printDetails(ctx context.Context, c *cli.Config, deliverable *cartov1alpha1.Deliverable) error {
+	c.Emoji(cli.Antenna, cliprinter.Sboldf("Overview\n"))
+	if err := printer.DeliverableOverviewPrinter(c.Stdout, deliverable); err != nil {
+		return err
+	}
+
+	// Print deliverable delivery and resources
+	c.Printf("\n")
+	if deliverable.Status.DeliveryRef == (cartov1alpha1.ObjectReference{}) && len(deliverable.Status.Conditions) == 0 {
+		c.Infof("Delivery reference not found.\n")
+	} else {
+		c.Emoji(cli.Delivery, cliprinter.Sboldf("Delivery\n"))
+		if err := printer.DeliveryInfoPrinter(c.Stdout, deliverable); err != nil {
+			return err
+		}
+	}
+
+	c.Printf("\n")
+	if len(deliverable.Status.Resources) == 0 {
+		c.Infof(printer.AddPaddingStart("Delivery resources not found.\n"))
+	} else if err := printer.DeliverableResourcesPrinter(c.Stdout, deliverable); err != nil {
+		return err
+	}
+
+	// Print deliverable issues
+	c.Printf("\n")
+	c.Emoji(cli.SpeechBalloon, cliprinter.Sboldf("Messages\n"))
+	if areAllResourcesReady(printer.FindCondition(deliverable.Status.Conditions, cartov1alpha1.ConditionReady)) {
+		c.Infof(printer.AddPaddingStart("No messages found.\n"))
+	} else if err := printer.DeliverableIssuesPrinter(c.Stdout, deliverable); err != nil {
+		return err
+	}
+
+	workloadName := deliverableWorkloadName(deliverable)
+	labelSelectorParams := fmt.Sprintf("%s%s%s", cartov1alpha1.WorkloadLabelName, "=", workloadName)
+	if tableResult, err := source.FetchResourceObjects(c.NewBuilder(), deliverable.Namespace, labelSelectorParams, []string{"pods.v1."}); err != nil {
+		c.Eprintf("\n")
+		c.Eerrorf("Failed to list pods:\n")
+		c.Eprintf("  %s\n", err)
+	} else {
+		c.Printf("\n")
+		if tableResult != nil {
+			c.Emoji(cli.Canoe, cliprinter.Sboldf("Pods\n"))
+			printer.PodTablePrinter(c, tableResult)
+		} else {
+			c.Infof("No pods found for deliverable.\n")
+		}
+	}
+
+	ksvcs := listKnativeServices(ctx, c, deliverable.Namespace, workloadName)
+	if len(ksvcs.Items) > 0 {
+		c.Printf("\n")
+		if err := printKnativeServices(ctx, c, ksvcs); err != nil {
+			return err
+		}
+	}
+
+	c.Printf("\n")
+	if deliverable.Namespace != c.Client.DefaultNamespace() {
+		c.Infof("To see logs: \"tanzu apps deliverable tail %s %s %s %s %s 1h\"\n", deliverable.Name, flags.NamespaceFlagName, deliverable.Namespace, flags.TimestampFlagName, flags.SinceFlagName)
+	} else {
+		c.Infof("To see logs: \"tanzu apps deliverable tail %s %s %s 1h\"\n", deliverable.Name, flags.TimestampFlagName, flags.SinceFlagName)
+	}
+	c.Printf("\n")
+
+	return nil
+}
+
+func NewDeliverableGetCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &DeliverableGetOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Get details from a deliverable",
+		Long: strings.TrimSpace(`
+Get details from a deliverable: the cluster delivery that selected it, the resources stamped for
+it, the messages reported by its conditions and the pods and Knative services delivered.
+
+Pods and Knative services are found by the workload the deliverable was stamped for, or by the
+name of the deliverable when it is not labeled with a workload.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s deliverable get my-deliverable", c.Name),
+			fmt.Sprintf("%s deliverable get my-deliverable %s wide", c.Name, flags.OutputFlagName),
+			fmt.Sprintf("%s deliverable get my-deliverable %s yaml", c.Name, flags.OutputFlagName),
+		}, "\n"),
+		PreRunE:           cli.ValidateE(ctx, opts),
+		RunE:              cli.ExecE(ctx, c, opts),
+		ValidArgsFunction: completion.SuggestDeliverableNames(ctx, c),
+	}
+
+	cli.Args(cmd,
+		cli.NameArg(&opts.Name),
+	)
+
+	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the deliverable formatted. Supported formats: \"json\", \"yaml\", \"yml\", \"wide\", \"name\", \"custom-columns=<columns>\", \"jsonpath=<expression>\", \"go-template=<template>\", \"go-template-file=<path>\"")
+
+	return cmd
+}
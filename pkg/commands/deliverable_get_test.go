@@ -0,0 +1,341 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	diecorev1 "dies.dev/apis/core/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	knativeservingv1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/knative/serving/v1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	diev1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/knative/serving/v1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+func TestDeliverableGetOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:        "invalid empty",
+			Validatable: &commands.DeliverableGetOptions{},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMissingField(flags.NamespaceFlagName),
+				validation.ErrMissingField(cli.NameArgumentName),
+			),
+		},
+		{
+			Name: "valid",
+			Validatable: &commands.DeliverableGetOptions{
+				Namespace: "default",
+				Name:      "my-deliverable",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "output",
+			Validatable: &commands.DeliverableGetOptions{
+				Namespace: "default",
+				Name:      "my-deliverable",
+				Output:    "yaml",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid output",
+			Validatable: &commands.DeliverableGetOptions{
+				Namespace: "default",
+				Name:      "my-deliverable",
+				Output:    "dot",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("dot", flags.OutputFlagName, []string{"json", "yaml", "yml", "wide", "name", "custom-columns", "jsonpath", "go-template", "go-template-file"}),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestDeliverableGetCommand(t *testing.T) {
+	defaultNamespace := "default"
+	deliverableName := "my-deliverable"
+	workloadName := "my-workload"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	_ = knativeservingv1.AddToScheme(scheme)
+	objTimeStamp := metav1.NewTime(time.Now().AddDate(-2, 0, 0))
+
+	parent := diecartov1alpha1.DeliverableBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(deliverableName)
+			d.Namespace(defaultNamespace)
+			d.CreationTimestamp(objTimeStamp)
+		})
+	delivered := parent.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.AddLabel(cartov1alpha1.WorkloadLabelName, workloadName)
+		}).
+		StatusDie(func(d *diecartov1alpha1.DeliverableStatusDie) {
+			d.DeliveryRef(cartov1alpha1.ObjectReference{Name: "delivery-basic"})
+			d.ConditionsDie(
+				diecartov1alpha1.WorkloadConditionReadyBlank.
+					Status(metav1.ConditionTrue).
+					Reason("Ready"),
+			)
+			d.Resources(
+				diecartov1alpha1.RealizedResourceBlank.
+					Name("deployer").
+					StampedRef(&cartov1alpha1.StampedRef{
+						ObjectReference: &corev1.ObjectReference{Kind: "App", Namespace: defaultNamespace, Name: deliverableName},
+						Resource:        "apps.kappctrl.k14s.io",
+					}).
+					ConditionsResourceHealthyReadyTrueDie().
+					DieRelease(),
+			)
+		})
+	podDie := diecorev1.PodBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("pod1")
+			d.Namespace(defaultNamespace)
+			d.AddLabel(cartov1alpha1.WorkloadLabelName, workloadName)
+			d.CreationTimestamp(objTimeStamp)
+		}).Kind("pod")
+	ksvcDie := diev1.ServiceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("ksvc1")
+			d.Namespace(defaultNamespace)
+			d.AddLabel(cartov1alpha1.WorkloadLabelName, workloadName)
+		}).
+		StatusDie(func(d *diev1.ServiceStatusDie) {
+			d.Conditions(
+				metav1.Condition{
+					Status: metav1.ConditionTrue,
+					Type:   knativeservingv1.ServiceConditionReady,
+				},
+			)
+			d.URL("https://example.com")
+		})
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "invalid args",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name: "not delivered yet",
+			Args: []string{deliverableName},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ExpectOutput: `
+📡 Overview
+   name:        my-deliverable
+   type:        <empty>
+   namespace:   default
+   workload:    <empty>
+
+Delivery reference not found.
+
+   Delivery resources not found.
+
+💬 Messages
+   No messages found.
+
+No pods found for deliverable.
+
+To see logs: "tanzu apps deliverable tail my-deliverable --timestamp --since 1h"
+
+`,
+		},
+		{
+			Name: "show delivery, resources, pods and knative services",
+			Args: []string{deliverableName},
+			GivenObjects: []client.Object{
+				delivered,
+				podDie,
+				ksvcDie,
+			},
+			BuilderObjects: []client.Object{podDie},
+			ExpectOutput: `
+📡 Overview
+   name:        my-deliverable
+   type:        <empty>
+   namespace:   default
+   workload:    my-workload
+
+🚚 Delivery
+   name:   delivery-basic
+
+   NAME       READY   HEALTHY   UPDATED     RESOURCE
+   deployer   True    True      <unknown>   apps.kappctrl.k14s.io/my-deliverable
+
+💬 Messages
+   No messages found.
+
+🛶 Pods
+   NAME   READY   STATUS   RESTARTS   AGE
+   pod1   0/0              0          <unknown>
+
+🚢 Knative Services
+   NAME    READY   URL
+   ksvc1   Ready   https://example.com
+
+To see logs: "tanzu apps deliverable tail my-deliverable --timestamp --since 1h"
+
+`,
+		},
+		{
+			Name: "show messages",
+			Args: []string{deliverableName, flags.NamespaceFlagName, defaultNamespace},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.DeliverableStatusDie) {
+						d.DeliveryRef(cartov1alpha1.ObjectReference{Name: "delivery-basic"})
+						d.ConditionsDie(
+							diecartov1alpha1.WorkloadConditionReadyBlank.
+								Status(metav1.ConditionFalse).
+								Reason("TemplateRejectedByAPIServer").
+								Message("unable to apply object [default/my-deliverable] for resource [deployer] in delivery [delivery-basic]"),
+						)
+					}),
+			},
+			ExpectOutput: `
+📡 Overview
+   name:        my-deliverable
+   type:        <empty>
+   namespace:   default
+   workload:    <empty>
+
+🚚 Delivery
+   name:   delivery-basic
+
+   Delivery resources not found.
+
+💬 Messages
+   Deliverable [TemplateRejectedByAPIServer]:   unable to apply object [default/my-deliverable] for resource [deployer] in delivery [delivery-basic]
+
+No pods found for deliverable.
+
+To see logs: "tanzu apps deliverable tail my-deliverable --timestamp --since 1h"
+
+`,
+		},
+		{
+			Name: "yaml output",
+			Args: []string{deliverableName, flags.OutputFlagName, "yaml"},
+			GivenObjects: []client.Object{
+				delivered.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.CreationTimestamp(metav1.Time{})
+				}),
+			},
+			ExpectOutput: `
+---
+apiVersion: carto.run/v1alpha1
+kind: Deliverable
+metadata:
+  creationTimestamp: "1970-01-01T00:00:01Z"
+  labels:
+    carto.run/workload-name: my-workload
+  name: my-deliverable
+  namespace: default
+  resourceVersion: "999"
+spec: {}
+status:
+  conditions:
+  - lastTransitionTime: null
+    message: ""
+    reason: Ready
+    status: "True"
+    type: Ready
+  deliveryRef:
+    name: delivery-basic
+  resources:
+  - conditions:
+    - lastTransitionTime: null
+      message: ""
+      reason: ""
+      status: "True"
+      type: Ready
+    - lastTransitionTime: null
+      message: ""
+      reason: ""
+      status: "True"
+      type: Healthy
+    name: deployer
+    stampedRef:
+      kind: App
+      name: my-deliverable
+      namespace: default
+      resource: apps.kappctrl.k14s.io
+`,
+		},
+		{
+			Name: "wide output",
+			Args: []string{deliverableName, flags.OutputFlagName, "wide"},
+			GivenObjects: []client.Object{
+				delivered,
+			},
+			ExpectOutput: `
+NAME             DELIVERY         READY   AGE   SOURCE    REASON
+my-deliverable   delivery-basic   Ready   2y    <empty>   Ready
+`,
+		},
+		{
+			Name: "not found",
+			Args: []string{deliverableName},
+			GivenObjects: []client.Object{
+				diecorev1.NamespaceBlank.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.Name(defaultNamespace)
+				}),
+			},
+			ExpectOutput: `
+Deliverable "default/my-deliverable" not found
+`,
+			ShouldError: true,
+		},
+		{
+			Name: "namespace not found",
+			Args: []string{deliverableName, flags.NamespaceFlagName, "foo"},
+			ExpectOutput: `
+Error: namespace "foo" not found, it may not exist or user does not have permissions to read it.
+`,
+			ShouldError: true,
+		},
+		{
+			Name: "get error",
+			Args: []string{deliverableName},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "Deliverable"),
+			},
+			ShouldError: true,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewDeliverableGetCommand)
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+type DeliverableListOptions struct {
+	Namespace     string
+	AllNamespaces bool
+	Output        string
+}
+
+var (
+	_ validation.Validatable = (*DeliverableListOptions)(nil)
+	_ cli.Executable         = (*DeliverableListOptions)(nil)
+)
+
+func (opts *DeliverableListOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	if opts.Namespace == "" && !opts.AllNamespaces {
+		errs = errs.Also(validation.ErrMissingOneOf(flags.NamespaceFlagName, flags.AllNamespacesFlagName))
+	}
+	if opts.Namespace != "" && opts.AllNamespaces {
+		errs = errs.Also(validation.ErrMultipleOneOf(flags.NamespaceFlagName, flags.AllNamespacesFlagName))
+	}
+
+	if opts.Output != "" {
+		errs = errs.Also(validation.OutputFormat(opts.Output, flags.OutputFlagName, printer.TableOutputFormats))
+	}
+
+	return errs
+}
+
+func (opts *DeliverableListOptions) Exec(ctx context.Context, c *cli.Config) error {
+	deliverables := &cartov1alpha1.DeliverableList{}
+	if err := c.List(ctx, deliverables, client.InNamespace(opts.Namespace)); err != nil {
+		return err
+	}
+
+	deliverables = deliverables.DeepCopy()
+	printer.SortByNamespaceAndName(deliverables.Items)
+
+	if opts.Output != "" && opts.Output != printer.OutputFormatWide {
+		var list []printer.Object
+		for i := range deliverables.Items {
+			list = append(list, &deliverables.Items[i])
+		}
+		export, err := printer.OutputResources(list, printer.OutputFormat(opts.Output), c.Scheme)
+		if err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Failed to output deliverables:"), err)
+			return cli.SilenceError(err)
+		}
+
+		c.Printf("%s\n", export)
+		return nil
+	}
+
+	if len(deliverables.Items) == 0 {
+		nsGet := &corev1.Namespace{}
+		if getErr := c.Get(ctx, types.NamespacedName{Name: opts.Namespace}, nsGet); getErr != nil && apierrors.IsNotFound(getErr) {
+			c.Eprintf("%s %s\n", printer.Serrorf("Error:"), fmt.Sprintf("namespace %q not found, it may not exist or user does not have permissions to read it.", opts.Namespace))
+			return cli.SilenceError(getErr)
+		}
+		c.Infof("No deliverables found.\n")
+		return nil
+	}
+
+	return opts.printTable(c, deliverables)
+}
+
+// printTable renders the deliverables as a table, the wide output adds the source and the ready
+// reason of each deliverable
+func (opts *DeliverableListOptions) printTable(c *cli.Config, deliverables *cartov1alpha1.DeliverableList) error {
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{
+		WithNamespace: opts.AllNamespaces,
+	}).With(func(h table.PrintHandler) {
+		columns := opts.printColumns()
+		h.TableHandler(columns, opts.printList)
+		h.TableHandler(columns, opts.print)
+	})
+
+	return tablePrinter.PrintObj(deliverables, c.Stdout)
+}
+
+func NewDeliverableListCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &DeliverableListOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "Table listing of deliverables",
+		Long: strings.TrimSpace(`
+List deliverables in a namespace or across all namespaces, along with the cluster delivery that
+selected each of them.
+
+The wide output adds the source and ready reason of each deliverable.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s deliverable list", c.Name),
+			fmt.Sprintf("%s deliverable list %s", c.Name, flags.AllNamespacesFlagName),
+			fmt.Sprintf("%s deliverable list %s wide", c.Name, flags.OutputFlagName),
+			fmt.Sprintf("%s deliverable list %s yaml", c.Name, flags.OutputFlagName),
+		}, "\n"),
+		PreRunE: cli.ValidateE(ctx, opts),
+		RunE:    cli.ExecE(ctx, c, opts),
+	}
+
+	cli.AllNamespacesFlag(ctx, cmd, c, &opts.Namespace, &opts.AllNamespaces)
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the deliverables formatted. Supported formats: \"json\", \"yaml\", \"yml\", \"wide\", \"name\", \"custom-columns=<columns>\", \"jsonpath=<expression>\", \"go-template=<template>\", \"go-template-file=<path>\"")
+
+	return cmd
+}
+
+func (opts *DeliverableListOptions) printList(deliverables *cartov1alpha1.DeliverableList, printOpts table.PrintOptions) ([]metav1beta1.TableRow, error) {
+	rows := make([]metav1beta1.TableRow, 0, len(deliverables.Items))
+	for i := range deliverables.Items {
+		r, err := opts.print(&deliverables.Items[i], printOpts)
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, r...)
+	}
+	return rows, nil
+}
+
+func (opts *DeliverableListOptions) print(deliverable *cartov1alpha1.Deliverable, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+	now := time.Now()
+	row := metav1beta1.TableRow{
+		Object: runtime.RawExtension{Object: deliverable},
+	}
+	ready := printer.FindCondition(deliverable.Status.Conditions, cartov1alpha1.ConditionReady)
+	row.Cells = append(row.Cells,
+		deliverable.Name,
+		printer.EmptyString(deliverable.Status.DeliveryRef.Name),
+		printer.ConditionStatus(ready),
+		printer.TimestampSince(deliverable.CreationTimestamp, now),
+	)
+	if opts.Output == printer.OutputFormatWide {
+		reason := ""
+		if ready != nil {
+			reason = ready.Reason
+		}
+		row.Cells = append(row.Cells,
+			printer.EmptyString(specSource(deliverable.Spec.Source)),
+			printer.EmptyString(reason),
+		)
+	}
+	return []metav1beta1.TableRow{row}, nil
+}
+
+func (opts *DeliverableListOptions) printColumns() []metav1beta1.TableColumnDefinition {
+	columns := []metav1beta1.TableColumnDefinition{
+		{Name: "Name", Type: "string"},
+		{Name: "Delivery", Type: "string"},
+		{Name: "Ready", Type: "string"},
+		{Name: "Age", Type: "string"},
+	}
+	if opts.Output == printer.OutputFormatWide {
+		columns = append(columns,
+			metav1beta1.TableColumnDefinition{Name: "Source", Type: "string"},
+			metav1beta1.TableColumnDefinition{Name: "Reason", Type: "string"},
+		)
+	}
+	return columns
+}
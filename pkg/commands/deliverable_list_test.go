@@ -0,0 +1,235 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	diecorev1 "dies.dev/apis/core/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+func TestDeliverableListOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:        "invalid empty",
+			Validatable: &commands.DeliverableListOptions{},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMissingOneOf(flags.NamespaceFlagName, flags.AllNamespacesFlagName),
+			),
+		},
+		{
+			Name: "valid namespace",
+			Validatable: &commands.DeliverableListOptions{
+				Namespace: "default",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "valid all namespaces",
+			Validatable: &commands.DeliverableListOptions{
+				AllNamespaces: true,
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "namespace and all namespaces",
+			Validatable: &commands.DeliverableListOptions{
+				Namespace:     "default",
+				AllNamespaces: true,
+			},
+			ExpectFieldErrors: validation.ErrMultipleOneOf(flags.NamespaceFlagName, flags.AllNamespacesFlagName),
+		},
+		{
+			Name: "invalid output",
+			Validatable: &commands.DeliverableListOptions{
+				Namespace: "default",
+				Output:    "dot",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("dot", flags.OutputFlagName, []string{"json", "yaml", "yml", "wide", "name", "custom-columns", "jsonpath", "go-template", "go-template-file"}),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestDeliverableListCommand(t *testing.T) {
+	defaultNamespace := "default"
+	otherNamespace := "other-namespace"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	deliverable := diecartov1alpha1.DeliverableBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("petclinic")
+			d.Namespace(defaultNamespace)
+			d.CreationTimestamp(metav1.NewTime(time.Now().AddDate(-2, 0, 0)))
+		}).
+		SpecDie(func(d *diecartov1alpha1.DeliverableSpecDie) {
+			d.Source(&cartov1alpha1.Source{Image: "registry.example/petclinic-bundle:latest"})
+		}).
+		StatusDie(func(d *diecartov1alpha1.DeliverableStatusDie) {
+			d.DeliveryRef(cartov1alpha1.ObjectReference{Name: "delivery-basic"})
+			d.ConditionsDie(
+				diecartov1alpha1.WorkloadConditionReadyBlank.
+					Status(metav1.ConditionFalse).
+					Reason("TemplateRejectedByAPIServer"),
+			)
+		})
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name: "empty",
+			GivenObjects: []client.Object{
+				diecorev1.NamespaceBlank.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.Name(defaultNamespace)
+				}),
+			},
+			ExpectOutput: `
+No deliverables found.
+`,
+		},
+		{
+			Name: "namespace not found",
+			Args: []string{flags.NamespaceFlagName, "foo"},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "Namespace", clitesting.InduceFailureOpts{
+					Error: apierrors.NewNotFound(corev1.Resource("Namespace"), "foo"),
+				}),
+			},
+			ShouldError: true,
+			ExpectOutput: `
+Error: namespace "foo" not found, it may not exist or user does not have permissions to read it.
+`,
+		},
+		{
+			Name: "lists an item",
+			GivenObjects: []client.Object{
+				deliverable,
+			},
+			ExpectOutput: `
+NAME        DELIVERY         READY                         AGE
+petclinic   delivery-basic   TemplateRejectedByAPIServer   2y
+`,
+		},
+		{
+			Name: "lists items in wide format",
+			Args: []string{flags.OutputFlagName, "wide"},
+			GivenObjects: []client.Object{
+				deliverable,
+			},
+			ExpectOutput: `
+NAME        DELIVERY         READY                         AGE   SOURCE                                     REASON
+petclinic   delivery-basic   TemplateRejectedByAPIServer   2y    registry.example/petclinic-bundle:latest   TemplateRejectedByAPIServer
+`,
+		},
+		{
+			Name: "lists items in all namespaces",
+			Args: []string{flags.AllNamespacesFlagName},
+			GivenObjects: []client.Object{
+				deliverable,
+				deliverable.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.Namespace(otherNamespace)
+				}),
+			},
+			ExpectOutput: `
+NAMESPACE         NAME        DELIVERY         READY                         AGE
+default           petclinic   delivery-basic   TemplateRejectedByAPIServer   2y
+other-namespace   petclinic   delivery-basic   TemplateRejectedByAPIServer   2y
+`,
+		},
+		{
+			Name: "filters by namespace",
+			Args: []string{flags.NamespaceFlagName, otherNamespace},
+			GivenObjects: []client.Object{
+				deliverable,
+				diecorev1.NamespaceBlank.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.Name(otherNamespace)
+				}),
+			},
+			ExpectOutput: `
+No deliverables found.
+`,
+		},
+		{
+			Name: "lists items in json format",
+			Args: []string{flags.OutputFlagName, "json"},
+			GivenObjects: []client.Object{
+				deliverable.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+					d.CreationTimestamp(metav1.Time{})
+				}),
+			},
+			ExpectOutput: `
+[
+	{
+		"kind": "Deliverable",
+		"apiVersion": "carto.run/v1alpha1",
+		"metadata": {
+			"name": "petclinic",
+			"namespace": "default",
+			"resourceVersion": "999",
+			"creationTimestamp": "1970-01-01T00:00:01Z"
+		},
+		"spec": {
+			"source": {
+				"image": "registry.example/petclinic-bundle:latest"
+			}
+		},
+		"status": {
+			"conditions": [
+				{
+					"type": "Ready",
+					"status": "False",
+					"lastTransitionTime": null,
+					"reason": "TemplateRejectedByAPIServer",
+					"message": ""
+				}
+			],
+			"deliveryRef": {
+				"name": "delivery-basic"
+			}
+		}
+	}
+]
+`,
+		},
+		{
+			Name: "list error",
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("list", "DeliverableList"),
+			},
+			ShouldError: true,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewDeliverableListCommand)
+}
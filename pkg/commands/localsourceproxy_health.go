@@ -42,7 +42,7 @@ var (
 func (opts *LocalSourceProxyHealthOptions) Validate(ctx context.Context) validation.FieldErrors {
 	errs := validation.FieldErrors{}
 	if opts.Output != "" {
-		errs = errs.Also(validation.Enum(opts.Output, flags.OutputFlagName, []string{printer.OutputFormatJson, printer.OutputFormatYaml, printer.OutputFormatYml}))
+		errs = errs.Also(validation.OutputFormat(opts.Output, flags.OutputFlagName, printer.ObjectOutputFormats))
 	}
 	return errs
 }
@@ -50,8 +50,9 @@ func (opts *LocalSourceProxyHealthOptions) Validate(ctx context.Context) validat
 func (opts *LocalSourceProxyHealthOptions) Exec(ctx context.Context, c *cli.Config) error {
 	if s, err := lsp.GetStatus(ctx, c); err != nil {
 		return err
-	} else {
-		printer.PrintLocalSourceProxyStatus(c.Stdout, opts.Output, s)
+	} else if err := printer.PrintLocalSourceProxyStatus(c.Stdout, opts.Output, s); err != nil {
+		c.Eprintf("%s %s\n", printer.Serrorf("Failed to output Local Source Proxy status:"), err)
+		return cli.SilenceError(err)
 	}
 	return nil
 }
@@ -65,12 +66,13 @@ func NewLocalSourceProxyHealthCommand(ctx context.Context, c *cli.Config) *cobra
 		Long:  strings.TrimSpace(`View status and health details for Local Source Proxy`),
 		Example: strings.Join([]string{
 			fmt.Sprintf("%s local-source-proxy health --output json", c.Name),
+			fmt.Sprintf("%s local-source-proxy health --output jsonpath='{.overall_health}'", c.Name),
 		}, "\n"),
 		PreRunE: cli.ValidateE(ctx, opts),
 		RunE:    cli.ExecE(ctx, c, opts),
 	}
 
-	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the Local Source Proxy status formatted. Supported formats: \"json\", \"yaml\", \"yml\"")
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the Local Source Proxy status formatted. Supported formats: \"json\", \"yaml\", \"yml\", \"custom-columns=<columns>\", \"jsonpath=<expression>\", \"go-template=<template>\", \"go-template-file=<path>\"")
 
 	return cmd
 }
@@ -47,7 +47,7 @@ func TestLocalSourceProxyHealthOptions_Validate(t *testing.T) {
 			Validatable: &commands.LocalSourceProxyHealthOptions{
 				Output: "text",
 			},
-			ExpectFieldErrors: validation.EnumInvalidValue("text", flags.OutputFlagName, []string{"json", "yaml", "yml"}),
+			ExpectFieldErrors: validation.EnumInvalidValue("text", flags.OutputFlagName, []string{"json", "yaml", "yml", "custom-columns", "jsonpath", "go-template", "go-template-file"}),
 		},
 		{
 			Name:           "no input",
@@ -119,6 +119,15 @@ message: All health checks passed
   "overall_health": true,
   "message": "All health checks passed"
 }`,
+		},
+		{
+			Name:                "output custom columns",
+			Args:                []string{flags.OutputFlagName, "custom-columns=REACHABLE:.reachable,HEALTHY:.overall_health,MESSAGE:.message"},
+			KubeConfigTransport: clitesting.NewFakeTransportFromResponse(respCreator(http.StatusOK, `{"statuscode": "200", "message": "any ignored message"}`)),
+			ExpectOutput: `
+REACHABLE   HEALTHY   MESSAGE
+true        true      All health checks passed
+`,
 		},
 		{
 			Name:        "no transport error",
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -74,6 +75,8 @@ const (
 	waitErrorForReadyCondition = "Error waiting for ready condition"
 )
 
+const multipleWorkloadsErrorDetail = "not supported when processing multiple workloads"
+
 func NewWorkloadCommand(ctx context.Context, c *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "workload",
@@ -93,8 +96,21 @@ Workload configuration includes:
 	cmd.AddCommand(NewWorkloadListCommand(ctx, c))
 	cmd.AddCommand(NewWorkloadGetCommand(ctx, c))
 	cmd.AddCommand(NewWorkloadTailCommand(ctx, c))
+	cmd.AddCommand(NewWorkloadEventsCommand(ctx, c))
+	cmd.AddCommand(NewWorkloadRunsCommand(ctx, c))
+	cmd.AddCommand(NewWorkloadScanResultsCommand(ctx, c))
+	cmd.AddCommand(NewWorkloadURLsCommand(ctx, c))
+	cmd.AddCommand(NewWorkloadTreeCommand(ctx, c))
+	cmd.AddCommand(NewWorkloadDiagnoseCommand(ctx, c))
+	cmd.AddCommand(NewWorkloadWaitCommand(ctx, c))
 	cmd.AddCommand(NewWorkloadCreateCommand(ctx, c))
 	cmd.AddCommand(NewWorkloadApplyCommand(ctx, c))
+	cmd.AddCommand(NewWorkloadDiffCommand(ctx, c))
+	cmd.AddCommand(NewWorkloadEditCommand(ctx, c))
+	cmd.AddCommand(NewWorkloadExportDeliverableCommand(ctx, c))
+	cmd.AddCommand(NewWorkloadHistoryCommand(ctx, c))
+	cmd.AddCommand(NewWorkloadRollbackCommand(ctx, c))
+	cmd.AddCommand(NewWorkloadRebuildCommand(ctx, c))
 	cmd.AddCommand(NewWorkloadDeleteCommand(ctx, c))
 
 	return cmd
@@ -114,6 +130,7 @@ type WorkloadOptions struct {
 	LiveUpdate  bool
 
 	FilePath        string
+	Recursive       bool
 	GitRepo         string
 	GitCommit       string
 	GitBranch       string
@@ -151,6 +168,7 @@ type WorkloadOptions struct {
 	Tail           bool
 	TailTimestamps bool
 	DryRun         bool
+	Strict         bool
 	Yes            bool
 	Output         string
 }
@@ -164,6 +182,12 @@ func (opts *WorkloadOptions) Validate(ctx context.Context) validation.FieldError
 	if opts.FilePath == "" {
 		errs = errs.Also(validation.K8sName(opts.Name, cli.NameArgumentName))
 	}
+	if opts.Recursive && opts.FilePath == "" {
+		errs = errs.Also(validation.ErrMissingField(flags.FilePathFlagName))
+	}
+	if isDirectory(opts.FilePath) {
+		errs = errs.Also(opts.validateMultipleWorkloads())
+	}
 	errs = errs.Also(validation.DeletableKeyValues(opts.Labels, flags.LabelFlagName))
 	errs = errs.Also(validation.DeletableKeyValues(opts.Annotations, flags.AnnotationFlagName))
 	errs = errs.Also(validation.DeletableKeyValues(opts.Params, flags.ParamFlagName))
@@ -756,6 +780,7 @@ func (opts *WorkloadOptions) Update(ctx context.Context, c *cli.Config, currentW
 	}
 
 	c.Emoji(cli.ThumbsUp, cliprinter.Ssuccessf("Updated workload %q\n", workload.Name))
+	recordWorkloadRevision(ctx, c, currentWorkload, workload, true)
 	return okToUpdate, nil
 }
 
@@ -801,9 +826,59 @@ func (opts *WorkloadOptions) Create(ctx context.Context, c *cli.Config, workload
 	}
 
 	c.Emoji(cli.ThumbsUp, cliprinter.Ssuccessf("Created workload %q\n", workload.Name))
+	recordWorkloadRevision(ctx, c, nil, workload, true)
 	return okToCreate, nil
 }
 
+// printWarning displays a warning to the user, on stderr when shouldPrint is false so that the
+// document requested with the output flag is the only content written to stdout
+func printWarning(c *cli.Config, shouldPrint bool, format string, a ...interface{}) {
+	if shouldPrint {
+		c.Emoji(cli.Exclamation, cliprinter.Sinfof("WARNING: "+format, a...))
+		return
+	}
+	c.Einfof("WARNING: "+format, a...)
+}
+
+// checkSupplyChainParams warns when no single cluster supply chain would select the workload, and
+// when the params set with flags are not declared by the selected one or have a value fixed by
+// it. With --strict those params fail the command instead. It runs before anything is published or
+// applied, with the warnings written to stderr when shouldPrint is false
+func (opts *WorkloadOptions) checkSupplyChainParams(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload, shouldPrint bool) error {
+	supplyChain := warnSupplyChainSelection(ctx, c, workload, shouldPrint)
+	if supplyChain == nil {
+		return nil
+	}
+
+	keys := []string{}
+	for _, p := range append(append([]string{}, opts.Params...), opts.ParamsYaml...) {
+		if key := strings.SplitN(p, "=", 2); len(key) == 2 {
+			keys = append(keys, key[0])
+		}
+	}
+	problems := supplyChainParamProblems(supplyChain, keys)
+	if len(problems) == 0 {
+		return nil
+	}
+
+	for _, msg := range problems {
+		if opts.Strict {
+			c.Eprintf("%s %s\n", printer.Serrorf("Error:"), msg)
+		} else {
+			printWarning(c, shouldPrint, "%s\n", msg)
+		}
+	}
+	hint := c.Infof
+	if !shouldPrint {
+		hint = c.Einfof
+	}
+	hint("To see the params of the cluster supply chain: \"tanzu apps cluster-supply-chain params %s\"\n", supplyChain.Name)
+	if opts.Strict {
+		return cli.SilenceError(fmt.Errorf("params not accepted by cluster supply chain %q", supplyChain.Name))
+	}
+	return nil
+}
+
 func (opts *WorkloadOptions) LoadInputWorkload(input io.Reader, workload *cartov1alpha1.Workload) error {
 	var in io.Reader
 
@@ -834,6 +909,255 @@ func (opts *WorkloadOptions) LoadInputWorkload(input io.Reader, workload *cartov
 	return nil
 }
 
+// LoadInputWorkloads loads every workload described in the file path, which can be a url,
+// stdin, a file with one or more documents or a directory containing workload files
+func (opts *WorkloadOptions) LoadInputWorkloads(input io.Reader) ([]*cartov1alpha1.Workload, error) {
+	isURL, err := isUrl(opts.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to check if filepath %q is a valid url: %w", opts.FilePath, err)
+	}
+
+	if isURL {
+		in, err := opts.getUrlFileContent()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read from url %q: %w", opts.FilePath, err)
+		}
+		workloads, err := cartov1alpha1.LoadWorkloads(in)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load file %q: %w", opts.FilePath, err)
+		}
+		return workloads, nil
+	}
+
+	return loadInputWorkloads(input, opts.FilePath, opts.Recursive)
+}
+
+func loadInputWorkloads(input io.Reader, path string, recursive bool) ([]*cartov1alpha1.Workload, error) {
+	if path == "-" {
+		workloads, err := cartov1alpha1.LoadWorkloads(input)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load file %q: %w", path, err)
+		}
+		return workloads, nil
+	}
+
+	if !isDirectory(path) {
+		return loadWorkloadsFile(path)
+	}
+
+	workloads := []*cartov1alpha1.Workload{}
+	err := filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if p != path && !recursive {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		switch filepath.Ext(p) {
+		case ".yaml", ".yml", ".json":
+			fileWorkloads, err := loadWorkloadsFile(p)
+			if err != nil {
+				return err
+			}
+			workloads = append(workloads, fileWorkloads...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(workloads) == 0 {
+		return nil, fmt.Errorf("no workload files found in directory %q", path)
+	}
+	return workloads, nil
+}
+
+func loadWorkloadsFile(path string) ([]*cartov1alpha1.Workload, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open file %q: %w", path, err)
+	}
+	defer f.Close()
+
+	workloads, err := cartov1alpha1.LoadWorkloads(f)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load file %q: %w", path, err)
+	}
+	return workloads, nil
+}
+
+func isDirectory(path string) bool {
+	if path == "" || path == "-" {
+		return false
+	}
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+// isMultipleWorkloads returns true when the loaded file path describes more than one workload
+func (opts *WorkloadOptions) isMultipleWorkloads(fileWorkloads []*cartov1alpha1.Workload) bool {
+	return len(fileWorkloads) > 1 || isDirectory(opts.FilePath)
+}
+
+// validateMultipleWorkloads rejects the options that only make sense for a single workload
+func (opts *WorkloadOptions) validateMultipleWorkloads() validation.FieldErrors {
+	errs := validation.FieldErrors{}
+	if opts.Name != "" {
+		errs = errs.Also(validation.ErrForbiddenFieldWithDetail(cli.NameArgumentName, multipleWorkloadsErrorDetail))
+	}
+	if opts.LocalPath != "" {
+		errs = errs.Also(validation.ErrForbiddenFieldWithDetail(flags.LocalPathFlagName, multipleWorkloadsErrorDetail))
+	}
+	if opts.Tail {
+		errs = errs.Also(validation.ErrForbiddenFieldWithDetail(flags.TailFlagName, multipleWorkloadsErrorDetail))
+	}
+	if opts.TailTimestamps {
+		errs = errs.Also(validation.ErrForbiddenFieldWithDetail(flags.TailTimestampFlagName, multipleWorkloadsErrorDetail))
+	}
+	if opts.Output != "" {
+		errs = errs.Also(validation.ErrForbiddenFieldWithDetail(flags.OutputFlagName, multipleWorkloadsErrorDetail))
+	}
+	return errs
+}
+
+// workloadProcessor creates or updates a single workload when processing multiple workloads.
+// It returns the result to display in the summary, the workload as it was in the cluster
+// before being processed, if any, and the processed workload
+type workloadProcessor func(ctx context.Context, c *cli.Config, opts *WorkloadOptions, fileWorkload *cartov1alpha1.Workload) (string, *cartov1alpha1.Workload, *cartov1alpha1.Workload, error)
+
+// processWorkloads runs process over every workload loaded from a file with multiple documents
+// or from a directory and waits for the workloads if requested. It returns the processed workloads
+// along with the result for each one
+func (opts *WorkloadOptions) processWorkloads(ctx context.Context, c *cli.Config, fileWorkloads []*cartov1alpha1.Workload, process workloadProcessor) (*cartov1alpha1.WorkloadList, map[types.NamespacedName]string, error) {
+	if err := opts.validateMultipleWorkloads().ToAggregate(); err != nil {
+		return nil, nil, err
+	}
+
+	type processedWorkload struct {
+		current  *cartov1alpha1.Workload
+		workload *cartov1alpha1.Workload
+	}
+
+	workloads := &cartov1alpha1.WorkloadList{}
+	results := map[types.NamespacedName]string{}
+	toWait := []processedWorkload{}
+	for i, fileWorkload := range fileWorkloads {
+		// each workload is processed with a copy of the options, so name and namespace
+		// resolved from one file workload do not leak into the next one
+		workloadOpts := *opts
+		workloadOpts.Name = fileWorkload.Name
+		if fileWorkload.Namespace != "" && !cli.CommandFromContext(ctx).Flags().Changed(cli.StripDash(flags.NamespaceFlagName)) {
+			workloadOpts.Namespace = fileWorkload.Namespace
+		}
+
+		if i != 0 && !opts.DryRun {
+			c.Printf("\n")
+		}
+
+		var result string
+		var current, workload *cartov1alpha1.Workload
+		var err error
+		if workloadOpts.Name == "" {
+			err = validation.ErrMissingField(cli.NameArgumentName).ToAggregate()
+		} else {
+			result, current, workload, err = process(ctx, c, &workloadOpts, fileWorkload)
+		}
+		if err != nil {
+			if !errors.Is(err, cli.SilentError) {
+				c.Eprintf("%s %s\n", printer.Serrorf("Error:"), err)
+			}
+			result = printer.WorkloadResultFailed
+		}
+
+		workloads.Items = append(workloads.Items, cartov1alpha1.Workload{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: workloadOpts.Namespace,
+				Name:      workloadOpts.Name,
+			},
+		})
+		results[types.NamespacedName{Namespace: workloadOpts.Namespace, Name: workloadOpts.Name}] = result
+		if opts.Wait && (result == printer.WorkloadResultCreated || result == printer.WorkloadResultUpdated) {
+			toWait = append(toWait, processedWorkload{current: current, workload: workload})
+		}
+	}
+
+	// errors on each workload are already reported, the usage is not relevant anymore
+	cli.CommandFromContext(ctx).SilenceUsage = true
+
+	if !opts.DryRun {
+		for _, w := range toWait {
+			key := types.NamespacedName{Namespace: w.workload.Namespace, Name: w.workload.Name}
+			c.Infof("\nWaiting for workload %q to become ready...\n", w.workload.Name)
+			if w.current != nil {
+				if err := raceWithTimeout(ctx, c, w.workload, opts.WaitTimeout, true, waitErrorForStatusChange, []wait.Worker{getStatusChangeWorker(c, w.current)}); err != nil {
+					results[key] = printer.WorkloadResultFailed
+					continue
+				}
+			}
+			if err := raceWithTimeout(ctx, c, w.workload, opts.WaitTimeout, true, waitErrorForReadyCondition, []wait.Worker{getReadyConditionWorker(c, w.workload, opts.DelayTime)}); err != nil {
+				results[key] = printer.WorkloadResultFailed
+				continue
+			}
+			c.Infof("Workload %q is ready\n", w.workload.Name)
+		}
+
+	}
+
+	return workloads, results, nil
+}
+
+// summarizeWorkloadResults prints a summary table with the result for each workload, unless it
+// is a dry run, and returns an error when the operation failed for any of them
+func summarizeWorkloadResults(c *cli.Config, workloads *cartov1alpha1.WorkloadList, results map[types.NamespacedName]string, dryRun bool) error {
+	if !dryRun {
+		c.Printf("\n")
+		c.Emoji(cli.Inbox, cliprinter.Sboldf("Summary\n"))
+		if err := printer.WorkloadResultsPrinter(c.Stdout, workloads, results); err != nil {
+			return err
+		}
+	}
+
+	failed := 0
+	for _, result := range results {
+		if result == printer.WorkloadResultFailed {
+			failed++
+		}
+	}
+	if failed != 0 {
+		return cli.SilenceError(fmt.Errorf("%d of %d workloads failed", failed, len(workloads.Items)))
+	}
+	return nil
+}
+
+// getWorkload gets the workload with the given name, an error is displayed when
+// the workload or its namespace are not found
+func getWorkload(ctx context.Context, c *cli.Config, namespace, name string) (*cartov1alpha1.Workload, error) {
+	workload := &cartov1alpha1.Workload{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, workload); err != nil {
+		if apierrs.IsNotFound(err) {
+			if nsErr := validateNamespace(ctx, c, namespace); nsErr != nil {
+				return nil, nsErr
+			}
+			c.Errorf("Workload %q not found\n", fmt.Sprintf("%s/%s", namespace, name))
+			return nil, cli.SilenceError(err)
+		}
+		return nil, err
+	}
+	return workload, nil
+}
+
+// namespaceFlag returns the namespace flag to add to the commands suggested for a workload, empty
+// when the workload is in the default namespace
+func namespaceFlag(c *cli.Config, namespace string) string {
+	if namespace == c.Client.DefaultNamespace() {
+		return ""
+	}
+	return fmt.Sprintf(" %s %s", flags.NamespaceFlagName, namespace)
+}
+
 func (opts *WorkloadOptions) getUrlFileContent() (io.Reader, error) {
 	resp, err := http.Get(opts.FilePath)
 	if err != nil {
@@ -960,9 +1284,33 @@ func checkLSPHealth(ctx context.Context, c *cli.Config) error {
 	return nil
 }
 
+// DefineFlags defines the flags shared by the commands that create or update workloads
 func (opts *WorkloadOptions) DefineFlags(ctx context.Context, c *cli.Config, cmd *cobra.Command) {
+	opts.DefineSpecFlags(ctx, c, cmd)
+	cmd.Flags().StringVarP(&opts.FilePath, cli.StripDash(flags.FilePathFlagName), "f", "", "`file path` containing the description of one or more workloads, or a directory containing workload files, other flags are layered on top of these resources. Use value \"-\" to read from stdin")
+	cmd.MarkFlagFilename(cli.StripDash(flags.FilePathFlagName), ".yaml", ".yml")
+	cmd.Flags().BoolVarP(&opts.Recursive, cli.StripDash(flags.RecursiveFlagName), "R", false, "process the directory used in "+flags.FilePathFlagName+" recursively")
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the Workload formatted. Supported formats: \"json\", \"yaml\", \"yml\"")
+	cmd.Flags().StringArrayVar(&opts.CACertPaths, cli.StripDash(flags.RegistryCertFlagName), []string{}, "file path to CA certificate used to authenticate with registry, flag can be used multiple times")
+	cmd.Flags().StringVar(&opts.RegistryPassword, cli.StripDash(flags.RegistryPasswordFlagName), "", "username for authenticating with registry")
+	cmd.Flags().StringVar(&opts.RegistryUsername, cli.StripDash(flags.RegistryUsernameFlagName), "", "password for authenticating with registry")
+	cmd.Flags().StringVar(&opts.RegistryToken, cli.StripDash(flags.RegistryTokenFlagName), "", "token for authenticating with registry")
+	cmd.Flags().BoolVar(&opts.Wait, cli.StripDash(flags.WaitFlagName), false, "waits for workload to become ready")
+	cmd.Flags().DurationVar(&opts.WaitTimeout, cli.StripDash(flags.WaitTimeoutFlagName), 10*time.Minute, "timeout for workload to become ready when waiting")
+	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.WaitTimeoutFlagName), completion.SuggestDurationUnits(ctx, completion.CommonDurationUnits))
+	cmd.Flags().BoolVar(&opts.Tail, cli.StripDash(flags.TailFlagName), false, "show logs while waiting for workload to become ready")
+	cmd.Flags().BoolVar(&opts.TailTimestamps, cli.StripDash(flags.TailTimestampFlagName), false, "show logs and add timestamp to each log line while waiting for workload to become ready")
+	cmd.Flags().BoolVar(&opts.DryRun, cli.StripDash(flags.DryRunFlagName), false, "print kubernetes resources to stdout rather than apply them to the cluster, messages normally on stdout will be sent to stderr")
+	cmd.Flags().BoolVar(&opts.Strict, cli.StripDash(flags.StrictFlagName), false, "fail instead of warning when a param set with "+flags.ParamFlagName+" or "+flags.ParamYamlFlagName+" is not declared by the cluster supply chain, or has a value fixed by it")
+	cmd.Flags().BoolVarP(&opts.Yes, cli.StripDash(flags.YesFlagName), "y", false, "accept all prompts")
+	cmd.Flags().DurationVar(&opts.DelayTime, cli.StripDash(flags.DelayTimeFlagName), 30*time.Second, "delay set to prevent premature exit before supply chain step completion when waiting/tailing")
+	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.DelayTimeFlagName), completion.SuggestDurationUnits(ctx, completion.CommonDurationUnits))
+}
+
+// DefineSpecFlags defines the flags that are layered on top of the workload, without
+// the flags that control how it is loaded and written to the cluster
+func (opts *WorkloadOptions) DefineSpecFlags(ctx context.Context, c *cli.Config, cmd *cobra.Command) {
 	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
-	cmd.Flags().StringVarP(&opts.FilePath, cli.StripDash(flags.FilePathFlagName), "f", "", "`file path` containing the description of a single workload, other flags are layered on top of this resource. Use value \"-\" to read from stdin")
 	cmd.Flags().StringVarP(&opts.App, cli.StripDash(flags.AppFlagName), "a", "", "application `name` the workload is a part of")
 	cmd.Flags().StringVarP(&opts.Type, cli.StripDash(flags.TypeFlagName), "t", WebTypeReservedKey, "distinguish workload `type`")
 	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.TypeFlagName), func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -983,6 +1331,8 @@ func (opts *WorkloadOptions) DefineFlags(ctx context.Context, c *cli.Config, cmd
 	cmd.Flags().StringSliceVar(&opts.Annotations, cli.StripDash(flags.AnnotationFlagName), []string{}, "annotation is represented as a `\"key=value\" pair` (\"key-\" to remove, flag can be used multiple times)")
 	cmd.Flags().StringArrayVarP(&opts.Params, cli.StripDash(flags.ParamFlagName), "p", []string{}, "additional parameters represented as a `\"key=value\" pair` (\"key-\" to remove, flag can be used multiple times)")
 	cmd.Flags().StringArrayVar(&opts.ParamsYaml, cli.StripDash(flags.ParamYamlFlagName), []string{}, "specify nested parameters using YAML or JSON formatted values represented as a `\"key=value\" pair` (\"key-\" to remove, flag can be used multiple times)")
+	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.ParamFlagName), suggestSupplyChainParams(ctx, c))
+	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.ParamYamlFlagName), suggestSupplyChainParams(ctx, c))
 	cmd.Flags().BoolVar(&opts.Debug, cli.StripDash(flags.DebugFlagName), false, "put the workload in debug mode ("+flags.DebugFlagName+"=false to deactivate)")
 	cmd.Flags().BoolVar(&opts.LiveUpdate, cli.StripDash(flags.LiveUpdateFlagName), false, "put the workload in live update mode ("+flags.LiveUpdateFlagName+"=false to deactivate)")
 	cmd.Flags().StringVar(&opts.GitRepo, cli.StripDash(flags.GitRepoFlagName), "", "git `url` to remote source code (to unset, pass empty string \"\")")
@@ -1004,23 +1354,8 @@ func (opts *WorkloadOptions) DefineFlags(ctx context.Context, c *cli.Config, cmd
 	cmd.Flags().StringVar(&opts.MavenGroup, cli.StripDash(flags.MavenGroupFlagName), "", "maven project to pull artifact from")
 	cmd.Flags().StringVar(&opts.MavenVersion, cli.StripDash(flags.MavenVersionFlagName), "", "version number of maven artifact")
 	cmd.Flags().StringVar(&opts.MavenType, cli.StripDash(flags.MavenTypeFlagName), "", "maven packaging type, defaults to jar")
-	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the Workload formatted. Supported formats: \"json\", \"yaml\", \"yml\"")
-	cmd.Flags().StringArrayVar(&opts.CACertPaths, cli.StripDash(flags.RegistryCertFlagName), []string{}, "file path to CA certificate used to authenticate with registry, flag can be used multiple times")
-	cmd.Flags().StringVar(&opts.RegistryPassword, cli.StripDash(flags.RegistryPasswordFlagName), "", "username for authenticating with registry")
-	cmd.Flags().StringVar(&opts.RegistryUsername, cli.StripDash(flags.RegistryUsernameFlagName), "", "password for authenticating with registry")
-	cmd.Flags().StringVar(&opts.RegistryToken, cli.StripDash(flags.RegistryTokenFlagName), "", "token for authenticating with registry")
 	cmd.Flags().StringVar(&opts.RequestCPU, cli.StripDash(flags.RequestCPUFlagName), "", "the minimum amount of cpu required, in CPU `cores` (500m = .5 cores)")
 	cmd.Flags().StringVar(&opts.RequestMemory, cli.StripDash(flags.RequestMemoryFlagName), "", "the minimum amount of memory required, in `bytes` (500Mi = 500MiB = 500 * 1024 * 1024)")
-	cmd.Flags().BoolVar(&opts.Wait, cli.StripDash(flags.WaitFlagName), false, "waits for workload to become ready")
-	cmd.Flags().DurationVar(&opts.WaitTimeout, cli.StripDash(flags.WaitTimeoutFlagName), 10*time.Minute, "timeout for workload to become ready when waiting")
-	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.WaitTimeoutFlagName), completion.SuggestDurationUnits(ctx, completion.CommonDurationUnits))
-	cmd.Flags().BoolVar(&opts.Tail, cli.StripDash(flags.TailFlagName), false, "show logs while waiting for workload to become ready")
-	cmd.Flags().BoolVar(&opts.TailTimestamps, cli.StripDash(flags.TailTimestampFlagName), false, "show logs and add timestamp to each log line while waiting for workload to become ready")
-	cmd.MarkFlagFilename(cli.StripDash(flags.FilePathFlagName), ".yaml", ".yml")
-	cmd.Flags().BoolVar(&opts.DryRun, cli.StripDash(flags.DryRunFlagName), false, "print kubernetes resources to stdout rather than apply them to the cluster, messages normally on stdout will be sent to stderr")
-	cmd.Flags().BoolVarP(&opts.Yes, cli.StripDash(flags.YesFlagName), "y", false, "accept all prompts")
-	cmd.Flags().DurationVar(&opts.DelayTime, cli.StripDash(flags.DelayTimeFlagName), 30*time.Second, "delay set to prevent premature exit before supply chain step completion when waiting/tailing")
-	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.DelayTimeFlagName), completion.SuggestDurationUnits(ctx, completion.CommonDurationUnits))
 }
 
 func (opts *WorkloadOptions) DefineEnvVars(ctx context.Context, c *cli.Config, cmd *cobra.Command) {
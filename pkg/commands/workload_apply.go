@@ -24,19 +24,28 @@ import (
 
 	"github.com/spf13/cobra"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
 	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
 	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	cliprinter "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/wait"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
 )
 
 type WorkloadApplyOptions struct {
 	WorkloadOptions
 	UpdateStrategy string
+
+	Prune    bool
+	Selector string
 }
 
 var (
@@ -63,6 +72,30 @@ func (opts *WorkloadApplyOptions) Validate(ctx context.Context) validation.Field
 		errs = errs.Also(validation.Enum(opts.UpdateStrategy, flags.UpdateStrategyFlagName, []string{mergeUpdateStrategy, replaceUpdateStrategy}))
 	}
 
+	if opts.Prune {
+		if opts.FilePath == "" {
+			errs = errs.Also(validation.ErrMissingField(flags.FilePathFlagName))
+		}
+		if opts.App == "" && opts.Selector == "" {
+			errs = errs.Also(validation.ErrMissingOneOf(flags.AppFlagName, flags.SelectorFlagName))
+		}
+		if opts.App != "" && opts.Selector != "" {
+			errs = errs.Also(validation.ErrMultipleOneOf(flags.AppFlagName, flags.SelectorFlagName))
+		}
+		// directories are already validated as multiple workloads by the workload options
+		if !isDirectory(opts.FilePath) {
+			errs = errs.Also(opts.validateMultipleWorkloads())
+		}
+	} else if opts.Selector != "" {
+		errs = errs.Also(validation.ErrMissingField(flags.PruneFlagName))
+	}
+
+	if opts.Selector != "" {
+		if _, err := labels.Parse(opts.Selector); err != nil {
+			errs = errs.Also(validation.ErrInvalidValue(opts.Selector, flags.SelectorFlagName))
+		}
+	}
+
 	return errs
 }
 
@@ -73,9 +106,23 @@ func (opts *WorkloadApplyOptions) Exec(ctx context.Context, c *cli.Config) error
 	fileWorkload := &cartov1alpha1.Workload{}
 	if opts.FilePath != "" {
 		cli.PrintPromptWithEmoji(shouldPrint, c.Emoji, cli.Exclamation, fmt.Sprintf("WARNING: Configuration file update strategy is changing. By default, provided configuration files will replace rather than merge existing configuration. The change will take place in the January 2024 TAP release (use %q to control strategy explicitly).\n\n", flags.UpdateStrategyFlagName))
-		if err := opts.WorkloadOptions.LoadInputWorkload(c.Stdin, fileWorkload); err != nil {
+		fileWorkloads, err := opts.WorkloadOptions.LoadInputWorkloads(c.Stdin)
+		if err != nil {
 			return err
 		}
+		if opts.isMultipleWorkloads(fileWorkloads) || opts.Prune {
+			workloads, results, err := opts.processWorkloads(ctx, c, fileWorkloads, opts.applyWorkload)
+			if err != nil {
+				return err
+			}
+			if opts.Prune {
+				if err := opts.pruneWorkloads(ctx, c, workloads, results); err != nil {
+					return err
+				}
+			}
+			return summarizeWorkloadResults(c, workloads, results, opts.DryRun)
+		}
+		fileWorkload = fileWorkloads[0]
 
 		if opts.Name == "" {
 			opts.Name = fileWorkload.Name
@@ -97,63 +144,21 @@ func (opts *WorkloadApplyOptions) Exec(ctx context.Context, c *cli.Config) error
 		return err
 	}
 
-	workload := &cartov1alpha1.Workload{}
-	var currentWorkload *cartov1alpha1.Workload
-	err := c.Get(ctx, client.ObjectKey{Namespace: opts.Namespace, Name: opts.Name}, workload)
-	if err == nil {
-		currentWorkload = workload.DeepCopy()
-	} else {
-		if !apierrs.IsNotFound(err) {
-			return err
-		}
-		if apierrs.IsNotFound(err) {
-			if nsErr := validateNamespace(ctx, c, opts.Namespace); nsErr != nil {
-				return nsErr
-			}
-		}
-	}
-
-	if opts.UpdateStrategy == mergeUpdateStrategy {
-		if opts.FilePath != "" {
-			var serviceAccountCopy string
-			// avoid passing a nil pointer to MergeServiceAccountName func
-			if fileWorkload.Spec.ServiceAccountName != nil {
-				serviceAccountCopy = *fileWorkload.Spec.ServiceAccountName
-			}
-
-			workload.Spec.MergeServiceAccountName(serviceAccountCopy)
-		}
-		workload.Merge(fileWorkload)
-	}
-
-	if opts.UpdateStrategy == replaceUpdateStrategy {
-		// assign all the file workload fields to the workload in the cluster
-		workload = fileWorkload
-
-		// if there is a workload in the cluster with all metadata populated
-		// re assign the system populated fields so we won't find an error because of some missing fields
-		workload.ReplaceMetadata(currentWorkload)
-	}
-
-	workload.Name = opts.Name
-	workload.Namespace = opts.Namespace
-	workloadExists := currentWorkload != nil
-
-	ctx = opts.ApplyOptionsToWorkload(ctx, currentWorkload, workload)
-
-	// validate complex flag interactions with existing state
-	errs = workload.Validate()
-	if err := errs.ToAggregate(); err != nil {
-		// show command usage before error
-		cli.CommandFromContext(ctx).SilenceUsage = false
+	ctx, currentWorkload, workload, err := opts.resolveWorkload(ctx, c, fileWorkload, opts.UpdateStrategy)
+	if err != nil {
 		return err
 	}
+	workloadExists := currentWorkload != nil
 
 	if opts.DryRun {
 		cli.DryRunResource(ctx, workload, workload.GetGroupVersionKind())
 		return nil
 	}
 
+	if err := opts.checkSupplyChainParams(ctx, c, workload, shouldPrint); err != nil {
+		return err
+	}
+
 	if opts.useLSP(currentWorkload) {
 		if err := checkLSPHealth(ctx, c); err != nil {
 			return err
@@ -201,6 +206,7 @@ func (opts *WorkloadApplyOptions) Exec(ctx context.Context, c *cli.Config) error
 				return err
 			}
 		}
+		recordWorkloadRevision(ctx, c, currentWorkload, workload, shouldPrint)
 	}
 
 	if okToApply {
@@ -257,6 +263,195 @@ func (opts *WorkloadApplyOptions) Exec(ctx context.Context, c *cli.Config) error
 	return nil
 }
 
+// resolveWorkload builds the workload that results from layering the file workload and the
+// flags on top of the workload in the cluster, following the given update strategy. The
+// returned current workload is nil when the workload does not exist yet.
+func (opts *WorkloadOptions) resolveWorkload(ctx context.Context, c *cli.Config, fileWorkload *cartov1alpha1.Workload, updateStrategy string) (context.Context, *cartov1alpha1.Workload, *cartov1alpha1.Workload, error) {
+	workload := &cartov1alpha1.Workload{}
+	var currentWorkload *cartov1alpha1.Workload
+	err := c.Get(ctx, client.ObjectKey{Namespace: opts.Namespace, Name: opts.Name}, workload)
+	if err == nil {
+		currentWorkload = workload.DeepCopy()
+	} else {
+		if !apierrs.IsNotFound(err) {
+			return ctx, nil, nil, err
+		}
+		if apierrs.IsNotFound(err) {
+			if nsErr := validateNamespace(ctx, c, opts.Namespace); nsErr != nil {
+				return ctx, nil, nil, nsErr
+			}
+		}
+	}
+
+	if updateStrategy == mergeUpdateStrategy {
+		if opts.FilePath != "" {
+			var serviceAccountCopy string
+			// avoid passing a nil pointer to MergeServiceAccountName func
+			if fileWorkload.Spec.ServiceAccountName != nil {
+				serviceAccountCopy = *fileWorkload.Spec.ServiceAccountName
+			}
+
+			workload.Spec.MergeServiceAccountName(serviceAccountCopy)
+		}
+		workload.Merge(fileWorkload)
+	}
+
+	if updateStrategy == replaceUpdateStrategy {
+		// assign all the file workload fields to the workload in the cluster
+		workload = fileWorkload
+
+		// if there is a workload in the cluster with all metadata populated
+		// re assign the system populated fields so we won't find an error because of some missing fields
+		workload.ReplaceMetadata(currentWorkload)
+	}
+
+	workload.Name = opts.Name
+	workload.Namespace = opts.Namespace
+
+	ctx = opts.ApplyOptionsToWorkload(ctx, currentWorkload, workload)
+
+	// validate complex flag interactions with existing state
+	errs := workload.Validate()
+	if err := errs.ToAggregate(); err != nil {
+		// show command usage before error
+		cli.CommandFromContext(ctx).SilenceUsage = false
+		return ctx, nil, nil, err
+	}
+
+	return ctx, currentWorkload, workload, nil
+}
+
+// applyWorkload creates or updates one of the workloads loaded from a file with multiple
+// documents or from a directory
+func (opts *WorkloadApplyOptions) applyWorkload(ctx context.Context, c *cli.Config, workloadOpts *WorkloadOptions, fileWorkload *cartov1alpha1.Workload) (string, *cartov1alpha1.Workload, *cartov1alpha1.Workload, error) {
+	ctx, currentWorkload, workload, err := workloadOpts.resolveWorkload(ctx, c, fileWorkload, opts.UpdateStrategy)
+	if err != nil {
+		return "", nil, nil, err
+	}
+
+	if workloadOpts.DryRun {
+		cli.DryRunResource(ctx, workload, workload.GetGroupVersionKind())
+		return "", currentWorkload, workload, nil
+	}
+
+	if err := workloadOpts.checkSupplyChainParams(ctx, c, workload, true); err != nil {
+		return "", currentWorkload, workload, err
+	}
+	workloadOpts.ManageLocalSourceProxyAnnotation(fileWorkload, currentWorkload, workload)
+
+	if currentWorkload == nil {
+		okToCreate, err := workloadOpts.Create(ctx, c, workload)
+		if err != nil || !okToCreate {
+			return printer.WorkloadResultSkipped, nil, workload, err
+		}
+		return printer.WorkloadResultCreated, nil, workload, nil
+	}
+
+	_, noChange, err := printer.ResourceDiff(currentWorkload, workload, c.Scheme)
+	if err != nil {
+		return "", currentWorkload, workload, err
+	}
+	okToUpdate, err := workloadOpts.Update(ctx, c, currentWorkload, workload)
+	switch {
+	case err != nil:
+		return "", currentWorkload, workload, err
+	case noChange:
+		return printer.WorkloadResultUnchanged, currentWorkload, workload, nil
+	case !okToUpdate:
+		return printer.WorkloadResultSkipped, currentWorkload, workload, nil
+	}
+	return printer.WorkloadResultUpdated, currentWorkload, workload, nil
+}
+
+// pruneWorkloads deletes the workloads matching the prune selector that are not part of the
+// applied workloads, in the namespaces the workloads were applied to
+func (opts *WorkloadApplyOptions) pruneWorkloads(ctx context.Context, c *cli.Config, workloads *cartov1alpha1.WorkloadList, results map[types.NamespacedName]string) error {
+	for _, result := range results {
+		if result == printer.WorkloadResultFailed {
+			c.Infof("\nSkipping prune, not all workloads were applied\n")
+			return nil
+		}
+	}
+
+	selector := labels.SelectorFromSet(labels.Set{apis.AppPartOfLabelName: opts.App})
+	if opts.Selector != "" {
+		var err error
+		if selector, err = labels.Parse(opts.Selector); err != nil {
+			return err
+		}
+	}
+
+	namespaces := []string{}
+	seen := map[string]bool{}
+	for _, workload := range workloads.Items {
+		if !seen[workload.Namespace] {
+			seen[workload.Namespace] = true
+			namespaces = append(namespaces, workload.Namespace)
+		}
+	}
+
+	toPrune := []cartov1alpha1.Workload{}
+	for _, namespace := range namespaces {
+		list := &cartov1alpha1.WorkloadList{}
+		if err := c.List(ctx, list, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return err
+		}
+		for _, workload := range list.Items {
+			if _, ok := results[types.NamespacedName{Namespace: workload.Namespace, Name: workload.Name}]; !ok {
+				toPrune = append(toPrune, workload)
+			}
+		}
+	}
+
+	c.Printf("\n")
+	if len(toPrune) == 0 {
+		c.Infof("No workloads matching %q to prune\n", selector.String())
+		return nil
+	}
+
+	c.Emoji(cli.Exclamation, fmt.Sprintf("Workloads matching %q not found in %q:\n", selector.String(), opts.FilePath))
+	for _, workload := range toPrune {
+		c.Printf("   %s/%s\n", workload.Namespace, workload.Name)
+	}
+
+	if opts.DryRun {
+		return nil
+	}
+
+	if !opts.Yes {
+		if opts.FilePath == "-" {
+			c.Errorf("Skipping prune, cannot confirm intent. Run command with %s flag to confirm intent when providing input from stdin\n", flags.YesFlagName)
+			return nil
+		}
+		okToPrune := false
+		err := cli.NewConfirmSurvey(c, "Really delete the %d workloads listed above?", len(toPrune)).Resolve(&okToPrune)
+		if err != nil || !okToPrune {
+			c.Infof("Skipping prune\n")
+			return nil
+		}
+	}
+
+	for i := range toPrune {
+		workload := &toPrune[i]
+		key := types.NamespacedName{Namespace: workload.Namespace, Name: workload.Name}
+		workloads.Items = append(workloads.Items, cartov1alpha1.Workload{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: workload.Namespace,
+				Name:      workload.Name,
+			},
+		})
+		if err := c.Delete(ctx, workload); err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Error:"), err)
+			results[key] = printer.WorkloadResultFailed
+			continue
+		}
+		c.Emoji(cli.ThumbsUp, cliprinter.Ssuccessf("Pruned workload %q\n", workload.Name))
+		results[key] = printer.WorkloadResultPruned
+	}
+
+	return nil
+}
+
 func (opts *WorkloadApplyOptions) IsDryRun() bool {
 	return opts.DryRun
 }
@@ -276,9 +471,18 @@ Workload configuration options include:
 - runtime resource limits
 - environment variables
 - services to bind
+
+When the file contains several workloads, or a directory is provided, every workload
+is applied and a summary with the result for each one is displayed at the end.
+
+With --prune, workloads labeled as part of the app set with --app, or matching the
+label selector set with --selector, that are not in the applied files are deleted
+from the namespaces the workloads were applied to.
 `),
 		Example: strings.Join([]string{
 			fmt.Sprintf("%s workload apply %s workload.yaml", c.Name, flags.FilePathFlagName),
+			fmt.Sprintf("%s workload apply %s workloads/ %s", c.Name, flags.FilePathFlagName, flags.RecursiveFlagName),
+			fmt.Sprintf("%s workload apply %s workloads/ %s %s my-app", c.Name, flags.FilePathFlagName, flags.PruneFlagName, flags.AppFlagName),
 		}, "\n"),
 		PreRunE:           cli.ValidateE(ctx, opts),
 		RunE:              cli.ExecE(ctx, c, opts),
@@ -295,6 +499,8 @@ Workload configuration options include:
 	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.UpdateStrategyFlagName), func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		return []string{replaceUpdateStrategy, mergeUpdateStrategy}, cobra.ShellCompDirectiveNoFileComp
 	})
+	cmd.Flags().BoolVar(&opts.Prune, cli.StripDash(flags.PruneFlagName), false, "delete workloads matching "+flags.AppFlagName+" or "+flags.SelectorFlagName+" that are not in the applied "+flags.FilePathFlagName)
+	cmd.Flags().StringVar(&opts.Selector, cli.StripDash(flags.SelectorFlagName), "", "label `selector` scoping the workloads to prune, used with "+flags.PruneFlagName)
 
 	// Bind flags to environment variables
 	opts.DefineEnvVars(ctx, c, cmd)
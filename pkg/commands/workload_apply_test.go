@@ -32,6 +32,7 @@ import (
 	"github.com/Netflix/go-expect"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/mock"
+	rtesting "github.com/vmware-labs/reconciler-runtime/testing"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
@@ -40,6 +41,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -162,6 +164,105 @@ func TestWorkloadApplyOptionsValidate(t *testing.T) {
 			},
 			ExpectFieldErrors: validation.ErrMultipleSources(commands.MavenFlagWildcard, commands.LocalPathAndSource, flags.ImageFlagName, flags.GitFlagWildcard),
 		},
+		{
+			Name: "prune with app",
+			Validatable: &commands.WorkloadApplyOptions{
+				WorkloadOptions: commands.WorkloadOptions{
+					Namespace: "default",
+					FilePath:  "testdata/multiple-workloads",
+					App:       "my-app",
+				},
+				Prune: true,
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "prune with selector",
+			Validatable: &commands.WorkloadApplyOptions{
+				WorkloadOptions: commands.WorkloadOptions{
+					Namespace: "default",
+					FilePath:  "testdata/multiple-workloads",
+				},
+				Prune:    true,
+				Selector: "app.kubernetes.io/part-of in (my-app, my-other-app)",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "prune without scope",
+			Validatable: &commands.WorkloadApplyOptions{
+				WorkloadOptions: commands.WorkloadOptions{
+					Namespace: "default",
+					FilePath:  "testdata/multiple-workloads",
+				},
+				Prune: true,
+			},
+			ExpectFieldErrors: validation.ErrMissingOneOf(flags.AppFlagName, flags.SelectorFlagName),
+		},
+		{
+			Name: "prune with app and selector",
+			Validatable: &commands.WorkloadApplyOptions{
+				WorkloadOptions: commands.WorkloadOptions{
+					Namespace: "default",
+					FilePath:  "testdata/multiple-workloads",
+					App:       "my-app",
+				},
+				Prune:    true,
+				Selector: "team=my-team",
+			},
+			ExpectFieldErrors: validation.ErrMultipleOneOf(flags.AppFlagName, flags.SelectorFlagName),
+		},
+		{
+			Name: "prune without filepath",
+			Validatable: &commands.WorkloadApplyOptions{
+				WorkloadOptions: commands.WorkloadOptions{
+					Namespace: "default",
+					Name:      "my-resource",
+					App:       "my-app",
+				},
+				Prune: true,
+			},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMissingField(flags.FilePathFlagName),
+				validation.ErrForbiddenFieldWithDetail(cli.NameArgumentName, "not supported when processing multiple workloads"),
+			),
+		},
+		{
+			Name: "prune directory with workload name",
+			Validatable: &commands.WorkloadApplyOptions{
+				WorkloadOptions: commands.WorkloadOptions{
+					Namespace: "default",
+					Name:      "my-resource",
+					FilePath:  "testdata/multiple-workloads",
+					App:       "my-app",
+				},
+				Prune: true,
+			},
+			ExpectFieldErrors: validation.ErrForbiddenFieldWithDetail(cli.NameArgumentName, "not supported when processing multiple workloads"),
+		},
+		{
+			Name: "selector without prune",
+			Validatable: &commands.WorkloadApplyOptions{
+				WorkloadOptions: commands.WorkloadOptions{
+					Namespace: "default",
+					FilePath:  "testdata/multiple-workloads",
+				},
+				Selector: "team=my-team",
+			},
+			ExpectFieldErrors: validation.ErrMissingField(flags.PruneFlagName),
+		},
+		{
+			Name: "prune with invalid selector",
+			Validatable: &commands.WorkloadApplyOptions{
+				WorkloadOptions: commands.WorkloadOptions{
+					Namespace: "default",
+					FilePath:  "testdata/multiple-workloads",
+				},
+				Prune:    true,
+				Selector: "team in (",
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("team in (", flags.SelectorFlagName),
+		},
 	}
 
 	table.Run(t)
@@ -212,6 +313,53 @@ func TestWorkloadApplyCommand(t *testing.T) {
 		}
 	}
 
+	historyTimestamp := metav1.NewTime(time.Date(2023, time.May, 4, 10, 30, 0, 0, time.UTC))
+	statusTimestamp := metav1.NewTime(time.Date(2023, time.May, 4, 10, 31, 0, 0, time.UTC))
+	multipleWorkloadsDir := "testdata/multiple-workloads"
+	petclinicWorkload := diecartov1alpha1.WorkloadBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("spring-petclinic")
+			d.Namespace(defaultNamespace)
+			d.Labels(map[string]string{
+				apis.AppPartOfLabelName:    "spring-petclinic",
+				apis.WorkloadTypeLabelName: "web",
+			})
+		}).
+		SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+			d.Source(&cartov1alpha1.Source{
+				Git: &cartov1alpha1.GitSource{
+					URL: "https://github.com/spring-projects/spring-petclinic.git",
+					Ref: cartov1alpha1.GitRef{
+						Branch: "main",
+					},
+				},
+			})
+		})
+	samplesWorkload := func(name string) *diecartov1alpha1.WorkloadDie {
+		return diecartov1alpha1.WorkloadBlank.
+			MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+				d.Name(name)
+				d.Namespace(defaultNamespace)
+				d.Labels(map[string]string{
+					apis.AppPartOfLabelName:    name,
+					apis.WorkloadTypeLabelName: "web",
+				})
+			}).
+			SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+				d.Source(&cartov1alpha1.Source{
+					Git: &cartov1alpha1.GitSource{
+						URL: "https://github.com/vmware-tanzu/application-accelerator-samples.git",
+						Ref: cartov1alpha1.GitRef{
+							Branch: "main",
+						},
+					},
+					Subpath: name,
+				})
+			})
+	}
+	javaWebAppWorkload := samplesWorkload("tanzu-java-web-app")
+	nodeExpressWorkload := samplesWorkload("node-express")
+
 	table := clitesting.CommandTestSuite{
 		{
 			Name:        "invalid args",
@@ -9198,6 +9346,739 @@ To get status: "tanzu apps workload get my-workload"
 				}
 			},
 		},
+		{
+			Name: "multiple workloads from directory",
+			Args: []string{flags.FilePathFlagName, multipleWorkloadsDir, flags.YesFlagName},
+			GivenObjects: []client.Object{
+				givenNamespaceDefault[0],
+				petclinicWorkload.
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Source(&cartov1alpha1.Source{
+							Git: &cartov1alpha1.GitSource{
+								URL: "https://github.com/spring-projects/spring-petclinic.git",
+								Ref: cartov1alpha1.GitRef{
+									Branch: "dev",
+								},
+							},
+						})
+					}),
+			},
+			ExpectUpdates: []client.Object{
+				petclinicWorkload,
+			},
+			ExpectCreates: []client.Object{
+				javaWebAppWorkload,
+			},
+			ExpectOutput: `
+❗ WARNING: Configuration file update strategy is changing. By default, provided configuration files will replace rather than merge existing configuration. The change will take place in the January 2024 TAP release (use "--update-strategy" to control strategy explicitly).
+
+🔎 Update workload:
+...
+ 10, 10   |spec:
+ 11, 11   |  source:
+ 12, 12   |    git:
+ 13, 13   |      ref:
+ 14     - |        branch: dev
+     14 + |        branch: main
+ 15, 15   |      url: https://github.com/spring-projects/spring-petclinic.git
+👍 Updated workload "spring-petclinic"
+
+🔎 Create workload:
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  labels:
+      6 + |    app.kubernetes.io/part-of: tanzu-java-web-app
+      7 + |    apps.tanzu.vmware.com/workload-type: web
+      8 + |  name: tanzu-java-web-app
+      9 + |  namespace: default
+     10 + |spec:
+     11 + |  source:
+     12 + |    git:
+     13 + |      ref:
+     14 + |        branch: main
+     15 + |      url: https://github.com/vmware-tanzu/application-accelerator-samples.git
+     16 + |    subPath: tanzu-java-web-app
+👍 Created workload "tanzu-java-web-app"
+
+📥 Summary
+   NAME                 NAMESPACE   RESULT
+   spring-petclinic     default     updated
+   tanzu-java-web-app   default     created
+`,
+		},
+		{
+			Name: "multiple workloads from directory recursively with failure",
+			Args: []string{flags.FilePathFlagName, multipleWorkloadsDir, flags.RecursiveFlagName, flags.YesFlagName},
+			GivenObjects: []client.Object{
+				givenNamespaceDefault[0],
+				petclinicWorkload,
+			},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("create", "Workload", clitesting.InduceFailureOpts{
+					Error: fmt.Errorf("admission webhook denied the request"),
+					Name:  "node-express",
+				}),
+			},
+			ExpectCreates: []client.Object{
+				nodeExpressWorkload,
+				javaWebAppWorkload,
+			},
+			ShouldError: true,
+			ExpectOutput: `
+❗ WARNING: Configuration file update strategy is changing. By default, provided configuration files will replace rather than merge existing configuration. The change will take place in the January 2024 TAP release (use "--update-strategy" to control strategy explicitly).
+
+🔎 Create workload:
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  labels:
+      6 + |    app.kubernetes.io/part-of: node-express
+      7 + |    apps.tanzu.vmware.com/workload-type: web
+      8 + |  name: node-express
+      9 + |  namespace: default
+     10 + |spec:
+     11 + |  source:
+     12 + |    git:
+     13 + |      ref:
+     14 + |        branch: main
+     15 + |      url: https://github.com/vmware-tanzu/application-accelerator-samples.git
+     16 + |    subPath: node-express
+Error: admission webhook denied the request
+
+Workload is unchanged, skipping update
+
+🔎 Create workload:
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  labels:
+      6 + |    app.kubernetes.io/part-of: tanzu-java-web-app
+      7 + |    apps.tanzu.vmware.com/workload-type: web
+      8 + |  name: tanzu-java-web-app
+      9 + |  namespace: default
+     10 + |spec:
+     11 + |  source:
+     12 + |    git:
+     13 + |      ref:
+     14 + |        branch: main
+     15 + |      url: https://github.com/vmware-tanzu/application-accelerator-samples.git
+     16 + |    subPath: tanzu-java-web-app
+👍 Created workload "tanzu-java-web-app"
+
+📥 Summary
+   NAME                 NAMESPACE   RESULT
+   node-express         default     failed
+   spring-petclinic     default     unchanged
+   tanzu-java-web-app   default     created
+`,
+		},
+		{
+			Name:         "multiple workloads from file dry run",
+			Args:         []string{flags.FilePathFlagName, multipleWorkloadsDir + "/workloads.yaml", flags.DryRunFlagName},
+			GivenObjects: givenNamespaceDefault,
+			ExpectOutput: `
+❗ WARNING: Configuration file update strategy is changing. By default, provided configuration files will replace rather than merge existing configuration. The change will take place in the January 2024 TAP release (use "--update-strategy" to control strategy explicitly).
+
+---
+apiVersion: carto.run/v1alpha1
+kind: Workload
+metadata:
+  creationTimestamp: null
+  labels:
+    app.kubernetes.io/part-of: spring-petclinic
+    apps.tanzu.vmware.com/workload-type: web
+  name: spring-petclinic
+  namespace: default
+spec:
+  source:
+    git:
+      ref:
+        branch: main
+      url: https://github.com/spring-projects/spring-petclinic.git
+status:
+  supplyChainRef: {}
+---
+apiVersion: carto.run/v1alpha1
+kind: Workload
+metadata:
+  creationTimestamp: null
+  labels:
+    app.kubernetes.io/part-of: tanzu-java-web-app
+    apps.tanzu.vmware.com/workload-type: web
+  name: tanzu-java-web-app
+  namespace: default
+spec:
+  source:
+    git:
+      ref:
+        branch: main
+      url: https://github.com/vmware-tanzu/application-accelerator-samples.git
+    subPath: tanzu-java-web-app
+status:
+  supplyChainRef: {}
+`,
+		},
+		{
+			Name:        "multiple workloads with single workload flags",
+			Args:        []string{flags.FilePathFlagName, multipleWorkloadsDir + "/workloads.yaml", flags.LocalPathFlagName, ".", flags.SourceImageFlagName, "repo.example/image:tag", flags.YesFlagName},
+			ShouldError: true,
+			Verify: func(t *testing.T, output string, err error) {
+				msg := flags.LocalPathFlagName + ": Forbidden: not supported when processing multiple workloads"
+				if err.Error() != msg {
+					t.Errorf("Expected error to be %q but got %q", msg, err.Error())
+				}
+			},
+		},
+		{
+			Name: "prune workloads of the app not in directory",
+			Args: []string{flags.FilePathFlagName, multipleWorkloadsDir, flags.PruneFlagName, flags.AppFlagName, "my-app", flags.YesFlagName},
+			GivenObjects: []client.Object{
+				givenNamespaceDefault[0],
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("removed-workload")
+						d.AddLabel(apis.AppPartOfLabelName, "my-app")
+					}),
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("other-app-workload")
+						d.AddLabel(apis.AppPartOfLabelName, "my-other-app")
+					}),
+			},
+			ExpectCreates: []client.Object{
+				petclinicWorkload.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(apis.AppPartOfLabelName, "my-app")
+					}),
+				javaWebAppWorkload.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(apis.AppPartOfLabelName, "my-app")
+					}),
+			},
+			ExpectDeletes: []rtesting.DeleteRef{{
+				Group:     "carto.run",
+				Kind:      "Workload",
+				Namespace: defaultNamespace,
+				Name:      "removed-workload",
+			}},
+			ExpectOutput: `
+❗ WARNING: Configuration file update strategy is changing. By default, provided configuration files will replace rather than merge existing configuration. The change will take place in the January 2024 TAP release (use "--update-strategy" to control strategy explicitly).
+
+🔎 Create workload:
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  labels:
+      6 + |    app.kubernetes.io/part-of: my-app
+      7 + |    apps.tanzu.vmware.com/workload-type: web
+      8 + |  name: spring-petclinic
+      9 + |  namespace: default
+     10 + |spec:
+     11 + |  source:
+     12 + |    git:
+     13 + |      ref:
+     14 + |        branch: main
+     15 + |      url: https://github.com/spring-projects/spring-petclinic.git
+👍 Created workload "spring-petclinic"
+
+🔎 Create workload:
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  labels:
+      6 + |    app.kubernetes.io/part-of: my-app
+      7 + |    apps.tanzu.vmware.com/workload-type: web
+      8 + |  name: tanzu-java-web-app
+      9 + |  namespace: default
+     10 + |spec:
+     11 + |  source:
+     12 + |    git:
+     13 + |      ref:
+     14 + |        branch: main
+     15 + |      url: https://github.com/vmware-tanzu/application-accelerator-samples.git
+     16 + |    subPath: tanzu-java-web-app
+👍 Created workload "tanzu-java-web-app"
+
+❗ Workloads matching "app.kubernetes.io/part-of=my-app" not found in "testdata/multiple-workloads":
+   default/removed-workload
+👍 Pruned workload "removed-workload"
+
+📥 Summary
+   NAME                 NAMESPACE   RESULT
+   spring-petclinic     default     created
+   tanzu-java-web-app   default     created
+   removed-workload     default     pruned
+`,
+		},
+		{
+			Name: "prune workloads matching selector dry run",
+			Args: []string{flags.FilePathFlagName, multipleWorkloadsDir + "/workloads.yaml", flags.PruneFlagName, flags.SelectorFlagName, "app.kubernetes.io/part-of in (spring-petclinic, node-express)", flags.DryRunFlagName},
+			GivenObjects: []client.Object{
+				givenNamespaceDefault[0],
+				nodeExpressWorkload,
+			},
+			ExpectOutput: `
+❗ WARNING: Configuration file update strategy is changing. By default, provided configuration files will replace rather than merge existing configuration. The change will take place in the January 2024 TAP release (use "--update-strategy" to control strategy explicitly).
+
+---
+apiVersion: carto.run/v1alpha1
+kind: Workload
+metadata:
+  creationTimestamp: null
+  labels:
+    app.kubernetes.io/part-of: spring-petclinic
+    apps.tanzu.vmware.com/workload-type: web
+  name: spring-petclinic
+  namespace: default
+spec:
+  source:
+    git:
+      ref:
+        branch: main
+      url: https://github.com/spring-projects/spring-petclinic.git
+status:
+  supplyChainRef: {}
+---
+apiVersion: carto.run/v1alpha1
+kind: Workload
+metadata:
+  creationTimestamp: null
+  labels:
+    app.kubernetes.io/part-of: tanzu-java-web-app
+    apps.tanzu.vmware.com/workload-type: web
+  name: tanzu-java-web-app
+  namespace: default
+spec:
+  source:
+    git:
+      ref:
+        branch: main
+      url: https://github.com/vmware-tanzu/application-accelerator-samples.git
+    subPath: tanzu-java-web-app
+status:
+  supplyChainRef: {}
+
+❗ Workloads matching "app.kubernetes.io/part-of in (node-express,spring-petclinic)" not found in "testdata/multiple-workloads/workloads.yaml":
+   default/node-express
+`,
+		},
+		{
+			Name: "prune skipped when apply failed",
+			Args: []string{flags.FilePathFlagName, multipleWorkloadsDir, flags.PruneFlagName, flags.AppFlagName, "my-app", flags.YesFlagName},
+			GivenObjects: []client.Object{
+				givenNamespaceDefault[0],
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("removed-workload")
+						d.AddLabel(apis.AppPartOfLabelName, "my-app")
+					}),
+			},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("create", "Workload", clitesting.InduceFailureOpts{
+					Name: "tanzu-java-web-app",
+				}),
+			},
+			ExpectCreates: []client.Object{
+				petclinicWorkload.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(apis.AppPartOfLabelName, "my-app")
+					}),
+				javaWebAppWorkload.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(apis.AppPartOfLabelName, "my-app")
+					}),
+			},
+			ShouldError: true,
+			ExpectOutput: `
+❗ WARNING: Configuration file update strategy is changing. By default, provided configuration files will replace rather than merge existing configuration. The change will take place in the January 2024 TAP release (use "--update-strategy" to control strategy explicitly).
+
+🔎 Create workload:
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  labels:
+      6 + |    app.kubernetes.io/part-of: my-app
+      7 + |    apps.tanzu.vmware.com/workload-type: web
+      8 + |  name: spring-petclinic
+      9 + |  namespace: default
+     10 + |spec:
+     11 + |  source:
+     12 + |    git:
+     13 + |      ref:
+     14 + |        branch: main
+     15 + |      url: https://github.com/spring-projects/spring-petclinic.git
+👍 Created workload "spring-petclinic"
+
+🔎 Create workload:
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  labels:
+      6 + |    app.kubernetes.io/part-of: my-app
+      7 + |    apps.tanzu.vmware.com/workload-type: web
+      8 + |  name: tanzu-java-web-app
+      9 + |  namespace: default
+     10 + |spec:
+     11 + |  source:
+     12 + |    git:
+     13 + |      ref:
+     14 + |        branch: main
+     15 + |      url: https://github.com/vmware-tanzu/application-accelerator-samples.git
+     16 + |    subPath: tanzu-java-web-app
+Error: inducing failure for create Workload
+
+Skipping prune, not all workloads were applied
+
+📥 Summary
+   NAME                 NAMESPACE   RESULT
+   spring-petclinic     default     created
+   tanzu-java-web-app   default     failed
+`,
+		},
+		{
+			Name: "update records revision history",
+			Args: []string{workloadName, flags.EnvFlagName, "FOO=bar", flags.YesFlagName},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.UID(types.UID("my-workload-uid"))
+						d.ManagedFields(metav1.ManagedFieldsEntry{
+							Manager:   "tanzu",
+							Operation: metav1.ManagedFieldsOperationUpdate,
+							Time:      &historyTimestamp,
+							FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:spec":{}}`)},
+						})
+					}),
+			},
+			ExpectUpdates: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.UID(types.UID("my-workload-uid"))
+						d.ManagedFields(metav1.ManagedFieldsEntry{
+							Manager:   "tanzu",
+							Operation: metav1.ManagedFieldsOperationUpdate,
+							Time:      &historyTimestamp,
+							FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:spec":{}}`)},
+						})
+					}).
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Env(corev1.EnvVar{Name: "FOO", Value: "bar"})
+					}),
+			},
+			ExpectCreates: []client.Object{
+				workloadHistory(
+					parent.
+						MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.UID(types.UID("my-workload-uid"))
+						}).
+						DieReleasePtr(),
+					commands.WorkloadRevision{
+						Revision:  1,
+						Timestamp: historyTimestamp,
+						Manager:   "tanzu",
+					},
+					commands.WorkloadRevision{
+						Revision:      2,
+						Timestamp:     historyTimestamp,
+						Manager:       "tanzu",
+						ChangedFields: []string{"spec.env"},
+						Spec: cartov1alpha1.WorkloadSpec{
+							Env: []corev1.EnvVar{{Name: "FOO", Value: "bar"}},
+						},
+					},
+				),
+			},
+			ExpectOutput: `
+🔎 Update workload:
+...
+  5,  5   |  labels:
+  6,  6   |    apps.tanzu.vmware.com/workload-type: web
+  7,  7   |  name: my-workload
+  8,  8   |  namespace: default
+  9     - |spec: {}
+      9 + |spec:
+     10 + |  env:
+     11 + |  - name: FOO
+     12 + |    value: bar
+❗ NOTICE: no source code or image has been specified for this workload.
+👍 Updated workload "my-workload"
+
+To see logs:   "tanzu apps workload tail my-workload --timestamp --since 1h"
+To get status: "tanzu apps workload get my-workload"
+
+`,
+		},
+		{
+			Name: "update after a rebuild records only the fields changed by the user",
+			Args: []string{workloadName, flags.EnvFlagName, "FOO=bar", flags.YesFlagName},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.UID(types.UID("my-workload-uid"))
+					}).
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Params(cartov1alpha1.Param{Name: cartov1alpha1.WorkloadRebuildParam, Value: apiextensionsv1.JSON{Raw: []byte(`"1"`)}})
+					}),
+				workloadHistory(
+					parent.
+						MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.UID(types.UID("my-workload-uid"))
+						}).
+						DieReleasePtr(),
+					commands.WorkloadRevision{
+						Revision: 1,
+					},
+				),
+			},
+			ExpectUpdates: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.UID(types.UID("my-workload-uid"))
+					}).
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Params(cartov1alpha1.Param{Name: cartov1alpha1.WorkloadRebuildParam, Value: apiextensionsv1.JSON{Raw: []byte(`"1"`)}})
+						d.Env(corev1.EnvVar{Name: "FOO", Value: "bar"})
+					}),
+				workloadHistory(
+					parent.
+						MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.UID(types.UID("my-workload-uid"))
+						}).
+						DieReleasePtr(),
+					commands.WorkloadRevision{
+						Revision: 1,
+					},
+					commands.WorkloadRevision{
+						Revision:      2,
+						ChangedFields: []string{"spec.env"},
+						Spec: cartov1alpha1.WorkloadSpec{
+							Params: []cartov1alpha1.Param{{Name: cartov1alpha1.WorkloadRebuildParam, Value: apiextensionsv1.JSON{Raw: []byte(`"1"`)}}},
+							Env:    []corev1.EnvVar{{Name: "FOO", Value: "bar"}},
+						},
+					},
+				),
+			},
+			ExpectOutput: `
+🔎 Update workload:
+...
+  6,  6   |    apps.tanzu.vmware.com/workload-type: web
+  7,  7   |  name: my-workload
+  8,  8   |  namespace: default
+  9,  9   |spec:
+     10 + |  env:
+     11 + |  - name: FOO
+     12 + |    value: bar
+ 10, 13   |  params:
+ 11, 14   |  - name: rebuild
+ 12, 15   |    value: "1"
+❗ NOTICE: no source code or image has been specified for this workload.
+👍 Updated workload "my-workload"
+
+To see logs:   "tanzu apps workload tail my-workload --timestamp --since 1h"
+To get status: "tanzu apps workload get my-workload"
+
+`,
+		},
+		{
+			Name: "update records revision history with the manager of the spec",
+			Args: []string{workloadName, flags.EnvFlagName, "FOO=bar", flags.YesFlagName},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.UID(types.UID("my-workload-uid"))
+						d.ManagedFields(metav1.ManagedFieldsEntry{
+							Manager:   "tanzu",
+							Operation: metav1.ManagedFieldsOperationUpdate,
+							Time:      &historyTimestamp,
+							FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:spec":{}}`)},
+						}, metav1.ManagedFieldsEntry{
+							Manager:     "cartographer",
+							Operation:   metav1.ManagedFieldsOperationUpdate,
+							Time:        &statusTimestamp,
+							Subresource: "status",
+							FieldsV1:    &metav1.FieldsV1{Raw: []byte(`{"f:status":{}}`)},
+						}, metav1.ManagedFieldsEntry{
+							Manager:   "kubectl-label",
+							Operation: metav1.ManagedFieldsOperationUpdate,
+							Time:      &statusTimestamp,
+							FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{}}}`)},
+						})
+					}),
+			},
+			ExpectUpdates: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.UID(types.UID("my-workload-uid"))
+						d.ManagedFields(metav1.ManagedFieldsEntry{
+							Manager:   "tanzu",
+							Operation: metav1.ManagedFieldsOperationUpdate,
+							Time:      &historyTimestamp,
+							FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:spec":{}}`)},
+						}, metav1.ManagedFieldsEntry{
+							Manager:     "cartographer",
+							Operation:   metav1.ManagedFieldsOperationUpdate,
+							Time:        &statusTimestamp,
+							Subresource: "status",
+							FieldsV1:    &metav1.FieldsV1{Raw: []byte(`{"f:status":{}}`)},
+						}, metav1.ManagedFieldsEntry{
+							Manager:   "kubectl-label",
+							Operation: metav1.ManagedFieldsOperationUpdate,
+							Time:      &statusTimestamp,
+							FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{}}}`)},
+						})
+					}).
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Env(corev1.EnvVar{Name: "FOO", Value: "bar"})
+					}),
+			},
+			ExpectCreates: []client.Object{
+				workloadHistory(
+					parent.
+						MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.UID(types.UID("my-workload-uid"))
+						}).
+						DieReleasePtr(),
+					commands.WorkloadRevision{
+						Revision:  1,
+						Timestamp: historyTimestamp,
+						Manager:   "tanzu",
+					},
+					commands.WorkloadRevision{
+						Revision:      2,
+						Timestamp:     historyTimestamp,
+						Manager:       "tanzu",
+						ChangedFields: []string{"spec.env"},
+						Spec: cartov1alpha1.WorkloadSpec{
+							Env: []corev1.EnvVar{{Name: "FOO", Value: "bar"}},
+						},
+					},
+				),
+			},
+			ExpectOutput: `
+🔎 Update workload:
+...
+  5,  5   |  labels:
+  6,  6   |    apps.tanzu.vmware.com/workload-type: web
+  7,  7   |  name: my-workload
+  8,  8   |  namespace: default
+  9     - |spec: {}
+      9 + |spec:
+     10 + |  env:
+     11 + |  - name: FOO
+     12 + |    value: bar
+❗ NOTICE: no source code or image has been specified for this workload.
+👍 Updated workload "my-workload"
+
+To see logs:   "tanzu apps workload tail my-workload --timestamp --since 1h"
+To get status: "tanzu apps workload get my-workload"
+
+`,
+		},
+		{
+			Name: "update with output warns on stderr when the revision can not be recorded",
+			Args: []string{workloadName, flags.EnvFlagName, "FOO=bar", flags.OutputFlagName, printer.OutputFormatJson, flags.YesFlagName},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.UID(types.UID("my-workload-uid"))
+					}),
+			},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("create", "ConfigMap"),
+			},
+			ExpectUpdates: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.UID(types.UID("my-workload-uid"))
+					}).
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Env(corev1.EnvVar{Name: "FOO", Value: "bar"})
+					}),
+			},
+			ExpectCreates: []client.Object{
+				workloadHistory(
+					parent.
+						MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+							d.UID(types.UID("my-workload-uid"))
+						}).
+						DieReleasePtr(),
+					commands.WorkloadRevision{
+						Revision: 1,
+					},
+					commands.WorkloadRevision{
+						Revision:      2,
+						ChangedFields: []string{"spec.env"},
+						Spec: cartov1alpha1.WorkloadSpec{
+							Env: []corev1.EnvVar{{Name: "FOO", Value: "bar"}},
+						},
+					},
+				),
+			},
+			ExpectOutput: `
+{
+	"apiVersion": "carto.run/v1alpha1",
+	"kind": "Workload",
+	"metadata": {
+		"creationTimestamp": "1970-01-01T00:00:01Z",
+		"labels": {
+			"apps.tanzu.vmware.com/workload-type": "web"
+		},
+		"name": "my-workload",
+		"namespace": "default",
+		"resourceVersion": "1000",
+		"uid": "my-workload-uid"
+	},
+	"spec": {
+		"env": [
+			{
+				"name": "FOO",
+				"value": "bar"
+			}
+		]
+	},
+	"status": {
+		"supplyChainRef": {}
+	}
+}
+`,
+			ExpectStderr: `
+WARNING: unable to record workload revision: inducing failure for create ConfigMap
+`,
+		},
+		{
+			Name:        "update with output fails in strict mode for params not accepted by the supply chain",
+			Args:        []string{workloadName, flags.ParamFlagName, "port=8080", flags.StrictFlagName, flags.OutputFlagName, printer.OutputFormatJson, flags.YesFlagName},
+			ShouldError: true,
+			GivenObjects: []client.Object{
+				parent,
+				diecartov1alpha1.ClusterSupplyChainBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("source-to-url")
+					}).
+					SpecDie(func(d *diecartov1alpha1.SupplyChainSpecDie) {
+						d.SelectorMatchExpressions(metav1.LabelSelectorRequirement{
+							Key:      apis.WorkloadTypeLabelName,
+							Operator: metav1.LabelSelectorOpIn,
+							Values:   []string{"web", "server", "worker"},
+						})
+						d.Params(
+							cartov1alpha1.DelegatableParam{Name: "gitops_branch", DefaultValue: &apiextensionsv1.JSON{Raw: []byte(`"main"`)}},
+						)
+					}),
+			},
+			ExpectStderr: `
+Error: param "port" is not declared by cluster supply chain "source-to-url"
+To see the params of the cluster supply chain: "tanzu apps cluster-supply-chain params source-to-url"
+`,
+		},
 	}
 
 	table.Run(t, scheme, func(ctx context.Context, c *cli.Config) *cobra.Command {
@@ -54,9 +54,18 @@ func (opts *WorkloadCreateOptions) Exec(ctx context.Context, c *cli.Config) erro
 	fileWorkload := &cartov1alpha1.Workload{}
 
 	if opts.FilePath != "" {
-		if err := opts.WorkloadOptions.LoadInputWorkload(c.Stdin, fileWorkload); err != nil {
+		fileWorkloads, err := opts.WorkloadOptions.LoadInputWorkloads(c.Stdin)
+		if err != nil {
 			return err
 		}
+		if opts.isMultipleWorkloads(fileWorkloads) {
+			workloads, results, err := opts.processWorkloads(ctx, c, fileWorkloads, opts.createWorkload)
+			if err != nil {
+				return err
+			}
+			return summarizeWorkloadResults(c, workloads, results, opts.DryRun)
+		}
+		fileWorkload = fileWorkloads[0]
 
 		workload = fileWorkload
 	}
@@ -105,6 +114,11 @@ func (opts *WorkloadCreateOptions) Exec(ctx context.Context, c *cli.Config) erro
 	}
 
 	var okToCreate bool
+	shouldPrint := opts.Output == "" || (opts.Output != "" && !opts.Yes)
+
+	if err := opts.checkSupplyChainParams(ctx, c, workload, shouldPrint); err != nil {
+		return err
+	}
 
 	if opts.useLSP(nil) {
 		if err := checkLSPHealth(ctx, c); err != nil {
@@ -112,8 +126,6 @@ func (opts *WorkloadCreateOptions) Exec(ctx context.Context, c *cli.Config) erro
 		}
 	}
 
-	shouldPrint := opts.Output == "" || (opts.Output != "" && !opts.Yes)
-
 	if err := opts.PublishLocalSource(ctx, c, nil, workload, shouldPrint); err != nil {
 		return err
 	}
@@ -137,6 +149,7 @@ func (opts *WorkloadCreateOptions) Exec(ctx context.Context, c *cli.Config) erro
 		if err := c.Create(ctx, workload); err != nil {
 			return err
 		}
+		recordWorkloadRevision(ctx, c, nil, workload, shouldPrint)
 	}
 
 	if okToCreate {
@@ -179,6 +192,42 @@ func (opts *WorkloadCreateOptions) Exec(ctx context.Context, c *cli.Config) erro
 	return nil
 }
 
+// createWorkload creates one of the workloads loaded from a file with multiple documents
+// or from a directory
+func (opts *WorkloadCreateOptions) createWorkload(ctx context.Context, c *cli.Config, workloadOpts *WorkloadOptions, fileWorkload *cartov1alpha1.Workload) (string, *cartov1alpha1.Workload, *cartov1alpha1.Workload, error) {
+	workload := fileWorkload
+	workload.Name = workloadOpts.Name
+	workload.Namespace = workloadOpts.Namespace
+
+	if err := c.Get(ctx, client.ObjectKey{Namespace: workload.Namespace, Name: workload.Name}, &cartov1alpha1.Workload{}); err == nil {
+		return "", nil, nil, fmt.Errorf("workload %q already exists", fmt.Sprintf("%s/%s", workload.Namespace, workload.Name))
+	} else if !apierrs.IsNotFound(err) {
+		return "", nil, nil, err
+	} else if nsErr := validateNamespace(ctx, c, workload.Namespace); nsErr != nil {
+		return "", nil, nil, nsErr
+	}
+
+	ctx = workloadOpts.ApplyOptionsToWorkload(ctx, nil, workload)
+	if err := workload.Validate().ToAggregate(); err != nil {
+		return "", nil, nil, err
+	}
+
+	if workloadOpts.DryRun {
+		cli.DryRunResource(ctx, workload, workload.GetGroupVersionKind())
+		return "", nil, workload, nil
+	}
+
+	if err := workloadOpts.checkSupplyChainParams(ctx, c, workload, true); err != nil {
+		return "", nil, workload, err
+	}
+	workloadOpts.ManageLocalSourceProxyAnnotation(fileWorkload, nil, workload)
+	okToCreate, err := workloadOpts.Create(ctx, c, workload)
+	if err != nil || !okToCreate {
+		return printer.WorkloadResultSkipped, nil, workload, err
+	}
+	return printer.WorkloadResultCreated, nil, workload, nil
+}
+
 func (opts *WorkloadCreateOptions) IsDryRun() bool {
 	return opts.DryRun
 }
@@ -198,11 +247,15 @@ Workload configuration options include:
 - runtime resource limits
 - environment variables
 - services to bind
+
+When the file contains several workloads, or a directory is provided, every workload
+is created and a summary with the result for each one is displayed at the end.
 `),
 		Example: strings.Join([]string{
 			fmt.Sprintf("%s workload create my-workload %s https://example.com/my-workload.git %s my-branch", c.Name, flags.GitRepoFlagName, flags.GitBranchFlagName),
 			fmt.Sprintf("%s workload create my-workload %s . %s registry.example/repository:tag", c.Name, flags.LocalPathFlagName, flags.SourceImageFlagName),
 			fmt.Sprintf("%s workload create %s workload.yaml", c.Name, flags.FilePathFlagName),
+			fmt.Sprintf("%s workload create %s workloads/ %s", c.Name, flags.FilePathFlagName, flags.RecursiveFlagName),
 		}, "\n"),
 		PreRunE: cli.ValidateE(ctx, opts),
 		RunE:    cli.ExecE(ctx, c, opts),
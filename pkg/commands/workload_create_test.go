@@ -1031,6 +1031,298 @@ spec:
 To see logs:   "tanzu apps workload tail spring-petclinic --timestamp --since 1h"
 To get status: "tanzu apps workload get spring-petclinic"
 
+`,
+		},
+		{
+			Name: "create warns when no supply chain selects the workload",
+			Args: []string{workloadName, flags.GitRepoFlagName, gitRepo, flags.GitBranchFlagName, gitBranch, flags.TypeFlagName, "function", flags.YesFlagName},
+			GivenObjects: []client.Object{
+				diecorev1.NamespaceBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(defaultNamespace)
+					}),
+				diecartov1alpha1.ClusterSupplyChainBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("source-to-url")
+					}).
+					SpecDie(func(d *diecartov1alpha1.SupplyChainSpecDie) {
+						d.SelectorMatchExpressions(metav1.LabelSelectorRequirement{
+							Key:      apis.WorkloadTypeLabelName,
+							Operator: metav1.LabelSelectorOpIn,
+							Values:   []string{"web", "server", "worker"},
+						})
+					}),
+			},
+			ExpectCreates: []client.Object{
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      workloadName,
+						Labels: map[string]string{
+							apis.WorkloadTypeLabelName: "function",
+						},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Source: &cartov1alpha1.Source{
+							Git: &cartov1alpha1.GitSource{
+								URL: gitRepo,
+								Ref: cartov1alpha1.GitRef{
+									Branch: gitBranch,
+								},
+							},
+						},
+					},
+				},
+			},
+			ExpectOutput: `
+❗ WARNING: Workload does not match any cluster supply chain (SupplyChainNotFound)
+To see the selectors of each cluster supply chain: "tanzu apps cluster-supply-chain match my-workload"
+🔎 Create workload:
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  labels:
+      6 + |    apps.tanzu.vmware.com/workload-type: function
+      7 + |  name: my-workload
+      8 + |  namespace: default
+      9 + |spec:
+     10 + |  source:
+     11 + |    git:
+     12 + |      ref:
+     13 + |        branch: main
+     14 + |      url: https://example.com/repo.git
+👍 Created workload "my-workload"
+
+To see logs:   "tanzu apps workload tail my-workload --timestamp --since 1h"
+To get status: "tanzu apps workload get my-workload"
+
+`,
+		},
+		{
+			Name: "create with output warns on stderr when no supply chain selects the workload",
+			Args: []string{workloadName, flags.GitRepoFlagName, gitRepo, flags.GitBranchFlagName, gitBranch, flags.TypeFlagName, "function", flags.OutputFlagName, printer.OutputFormatJson, flags.YesFlagName},
+			GivenObjects: []client.Object{
+				diecorev1.NamespaceBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(defaultNamespace)
+					}),
+				diecartov1alpha1.ClusterSupplyChainBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("source-to-url")
+					}).
+					SpecDie(func(d *diecartov1alpha1.SupplyChainSpecDie) {
+						d.SelectorMatchExpressions(metav1.LabelSelectorRequirement{
+							Key:      apis.WorkloadTypeLabelName,
+							Operator: metav1.LabelSelectorOpIn,
+							Values:   []string{"web", "server", "worker"},
+						})
+					}),
+			},
+			ExpectCreates: []client.Object{
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      workloadName,
+						Labels: map[string]string{
+							apis.WorkloadTypeLabelName: "function",
+						},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Source: &cartov1alpha1.Source{
+							Git: &cartov1alpha1.GitSource{
+								URL: gitRepo,
+								Ref: cartov1alpha1.GitRef{
+									Branch: gitBranch,
+								},
+							},
+						},
+					},
+				},
+			},
+			ExpectOutput: `
+{
+	"apiVersion": "carto.run/v1alpha1",
+	"kind": "Workload",
+	"metadata": {
+		"creationTimestamp": null,
+		"labels": {
+			"apps.tanzu.vmware.com/workload-type": "function"
+		},
+		"name": "my-workload",
+		"namespace": "default",
+		"resourceVersion": "1"
+	},
+	"spec": {
+		"source": {
+			"git": {
+				"ref": {
+					"branch": "main"
+				},
+				"url": "https://example.com/repo.git"
+			}
+		}
+	},
+	"status": {
+		"supplyChainRef": {}
+	}
+}
+`,
+			ExpectStderr: `
+WARNING: Workload does not match any cluster supply chain (SupplyChainNotFound)
+To see the selectors of each cluster supply chain: "tanzu apps cluster-supply-chain match my-workload"
+`,
+		},
+		{
+			Name: "create warns about params not accepted by the supply chain",
+			Args: []string{workloadName, flags.GitRepoFlagName, gitRepo, flags.GitBranchFlagName, gitBranch, flags.ParamFlagName, "gitops_branch=dev", flags.ParamFlagName, "port=8080", flags.ParamYamlFlagName, "maven_repository_url=https://other.example.com", flags.YesFlagName},
+			GivenObjects: []client.Object{
+				diecorev1.NamespaceBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(defaultNamespace)
+					}),
+				diecartov1alpha1.ClusterSupplyChainBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("source-to-url")
+					}).
+					SpecDie(func(d *diecartov1alpha1.SupplyChainSpecDie) {
+						d.SelectorMatchExpressions(metav1.LabelSelectorRequirement{
+							Key:      apis.WorkloadTypeLabelName,
+							Operator: metav1.LabelSelectorOpIn,
+							Values:   []string{"web", "server", "worker"},
+						})
+						d.Params(
+							cartov1alpha1.DelegatableParam{Name: "gitops_branch", DefaultValue: &apiextensionsv1.JSON{Raw: []byte(`"main"`)}},
+							cartov1alpha1.DelegatableParam{Name: "maven_repository_url", Value: &apiextensionsv1.JSON{Raw: []byte(`"https://repo.example.com"`)}},
+						)
+					}),
+			},
+			ExpectCreates: []client.Object{
+				&cartov1alpha1.Workload{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: defaultNamespace,
+						Name:      workloadName,
+						Labels: map[string]string{
+							apis.WorkloadTypeLabelName: "web",
+						},
+					},
+					Spec: cartov1alpha1.WorkloadSpec{
+						Params: []cartov1alpha1.Param{
+							{
+								Name:  "gitops_branch",
+								Value: apiextensionsv1.JSON{Raw: []byte(`"dev"`)},
+							},
+							{
+								Name:  "port",
+								Value: apiextensionsv1.JSON{Raw: []byte(`"8080"`)},
+							},
+							{
+								Name:  "maven_repository_url",
+								Value: apiextensionsv1.JSON{Raw: []byte(`"https://other.example.com"`)},
+							},
+						},
+						Source: &cartov1alpha1.Source{
+							Git: &cartov1alpha1.GitSource{
+								URL: gitRepo,
+								Ref: cartov1alpha1.GitRef{
+									Branch: gitBranch,
+								},
+							},
+						},
+					},
+				},
+			},
+			ExpectOutput: `
+❗ WARNING: param "port" is not declared by cluster supply chain "source-to-url"
+❗ WARNING: param "maven_repository_url" has a fixed value in cluster supply chain "source-to-url", the workload value is ignored
+To see the params of the cluster supply chain: "tanzu apps cluster-supply-chain params source-to-url"
+🔎 Create workload:
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  labels:
+      6 + |    apps.tanzu.vmware.com/workload-type: web
+      7 + |  name: my-workload
+      8 + |  namespace: default
+      9 + |spec:
+     10 + |  params:
+     11 + |  - name: gitops_branch
+     12 + |    value: dev
+     13 + |  - name: port
+     14 + |    value: "8080"
+     15 + |  - name: maven_repository_url
+     16 + |    value: https://other.example.com
+     17 + |  source:
+     18 + |    git:
+     19 + |      ref:
+     20 + |        branch: main
+     21 + |      url: https://example.com/repo.git
+👍 Created workload "my-workload"
+
+To see logs:   "tanzu apps workload tail my-workload --timestamp --since 1h"
+To get status: "tanzu apps workload get my-workload"
+
+`,
+		},
+		{
+			Name:        "create fails in strict mode for params not accepted by the supply chain",
+			Args:        []string{workloadName, flags.GitRepoFlagName, gitRepo, flags.GitBranchFlagName, gitBranch, flags.ParamFlagName, "port=8080", flags.StrictFlagName, flags.YesFlagName},
+			ShouldError: true,
+			GivenObjects: []client.Object{
+				diecorev1.NamespaceBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(defaultNamespace)
+					}),
+				diecartov1alpha1.ClusterSupplyChainBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("source-to-url")
+					}).
+					SpecDie(func(d *diecartov1alpha1.SupplyChainSpecDie) {
+						d.SelectorMatchExpressions(metav1.LabelSelectorRequirement{
+							Key:      apis.WorkloadTypeLabelName,
+							Operator: metav1.LabelSelectorOpIn,
+							Values:   []string{"web", "server", "worker"},
+						})
+						d.Params(
+							cartov1alpha1.DelegatableParam{Name: "gitops_branch", DefaultValue: &apiextensionsv1.JSON{Raw: []byte(`"main"`)}},
+							cartov1alpha1.DelegatableParam{Name: "maven_repository_url", Value: &apiextensionsv1.JSON{Raw: []byte(`"https://repo.example.com"`)}},
+						)
+					}),
+			},
+			ExpectOutput: `
+Error: param "port" is not declared by cluster supply chain "source-to-url"
+To see the params of the cluster supply chain: "tanzu apps cluster-supply-chain params source-to-url"
+`,
+		},
+		{
+			Name:        "create with output fails in strict mode for params not accepted by the supply chain",
+			Args:        []string{workloadName, flags.GitRepoFlagName, gitRepo, flags.GitBranchFlagName, gitBranch, flags.ParamFlagName, "port=8080", flags.StrictFlagName, flags.OutputFlagName, printer.OutputFormatJson, flags.YesFlagName},
+			ShouldError: true,
+			GivenObjects: []client.Object{
+				diecorev1.NamespaceBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(defaultNamespace)
+					}),
+				diecartov1alpha1.ClusterSupplyChainBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("source-to-url")
+					}).
+					SpecDie(func(d *diecartov1alpha1.SupplyChainSpecDie) {
+						d.SelectorMatchExpressions(metav1.LabelSelectorRequirement{
+							Key:      apis.WorkloadTypeLabelName,
+							Operator: metav1.LabelSelectorOpIn,
+							Values:   []string{"web", "server", "worker"},
+						})
+						d.Params(
+							cartov1alpha1.DelegatableParam{Name: "gitops_branch", DefaultValue: &apiextensionsv1.JSON{Raw: []byte(`"main"`)}},
+							cartov1alpha1.DelegatableParam{Name: "maven_repository_url", Value: &apiextensionsv1.JSON{Raw: []byte(`"https://repo.example.com"`)}},
+						)
+					}),
+			},
+			ExpectStderr: `
+Error: param "port" is not declared by cluster supply chain "source-to-url"
+To see the params of the cluster supply chain: "tanzu apps cluster-supply-chain params source-to-url"
 `,
 		},
 		{
@@ -2413,6 +2705,68 @@ To get status: "tanzu apps workload get my-workload"
 				}
 			},
 		},
+		{
+			Name: "create multiple workloads from directory",
+			Args: []string{flags.FilePathFlagName, "testdata/multiple-workloads", flags.YesFlagName},
+			GivenObjects: []client.Object{
+				givenNamespaceDefault[0],
+				diecartov1alpha1.WorkloadBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("spring-petclinic")
+						d.Namespace(defaultNamespace)
+					}),
+			},
+			ExpectCreates: []client.Object{
+				diecartov1alpha1.WorkloadBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("tanzu-java-web-app")
+						d.Namespace(defaultNamespace)
+						d.Labels(map[string]string{
+							apis.AppPartOfLabelName:    "tanzu-java-web-app",
+							apis.WorkloadTypeLabelName: "web",
+						})
+					}).
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Source(&cartov1alpha1.Source{
+							Git: &cartov1alpha1.GitSource{
+								URL: "https://github.com/vmware-tanzu/application-accelerator-samples.git",
+								Ref: cartov1alpha1.GitRef{
+									Branch: "main",
+								},
+							},
+							Subpath: "tanzu-java-web-app",
+						})
+					}),
+			},
+			ShouldError: true,
+			ExpectOutput: `
+Error: workload "default/spring-petclinic" already exists
+
+🔎 Create workload:
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  labels:
+      6 + |    app.kubernetes.io/part-of: tanzu-java-web-app
+      7 + |    apps.tanzu.vmware.com/workload-type: web
+      8 + |  name: tanzu-java-web-app
+      9 + |  namespace: default
+     10 + |spec:
+     11 + |  source:
+     12 + |    git:
+     13 + |      ref:
+     14 + |        branch: main
+     15 + |      url: https://github.com/vmware-tanzu/application-accelerator-samples.git
+     16 + |    subPath: tanzu-java-web-app
+👍 Created workload "tanzu-java-web-app"
+
+📥 Summary
+   NAME                 NAMESPACE   RESULT
+   spring-petclinic     default     failed
+   tanzu-java-web-app   default     created
+`,
+		},
 	}
 
 	table.Run(t, scheme, func(ctx context.Context, c *cli.Config) *cobra.Command {
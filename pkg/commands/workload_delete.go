@@ -18,14 +18,15 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"io"
-	"os"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
@@ -43,7 +44,8 @@ type WorkloadDeleteOptions struct {
 	Names     []string
 	All       bool
 
-	FilePath string
+	FilePath  string
+	Recursive bool
 
 	Wait        bool
 	WaitTimeout time.Duration
@@ -74,6 +76,14 @@ func (opts *WorkloadDeleteOptions) Validate(_ context.Context) validation.FieldE
 		errs = errs.Also(validation.ErrMissingOneOf(flags.AllFlagName, cli.NamesArgumentName, flags.FilePathFlagName))
 	}
 
+	if opts.Recursive && opts.FilePath == "" {
+		errs = errs.Also(validation.ErrMissingField(flags.FilePathFlagName))
+	}
+
+	if isDirectory(opts.FilePath) && len(opts.Names) != 0 {
+		errs = errs.Also(validation.ErrForbiddenFieldWithDetail(cli.NamesArgumentName, multipleWorkloadsErrorDetail))
+	}
+
 	return errs
 }
 
@@ -82,11 +92,17 @@ func (opts *WorkloadDeleteOptions) Exec(ctx context.Context, c *cli.Config) erro
 	names := opts.Names
 
 	if opts.FilePath != "" {
-		fileWorkload := &cartov1alpha1.Workload{}
-
-		if err := opts.loadInputWorkload(c.Stdin, fileWorkload); err != nil {
+		fileWorkloads, err := loadInputWorkloads(c.Stdin, opts.FilePath, opts.Recursive)
+		if err != nil {
 			return err
 		}
+		if len(fileWorkloads) > 1 || isDirectory(opts.FilePath) {
+			if len(opts.Names) != 0 {
+				return validation.ErrForbiddenFieldWithDetail(cli.NamesArgumentName, multipleWorkloadsErrorDetail).ToAggregate()
+			}
+			return opts.deleteWorkloads(ctx, c, fileWorkloads)
+		}
+		fileWorkload := fileWorkloads[0]
 
 		if fileWorkload.Namespace != "" && !cli.CommandFromContext(ctx).Flags().Changed(cli.StripDash(flags.NamespaceFlagName)) {
 			opts.Namespace = fileWorkload.Namespace
@@ -119,69 +135,107 @@ func (opts *WorkloadDeleteOptions) Exec(ctx context.Context, c *cli.Config) erro
 	}
 
 	for _, name := range names {
-		if err := c.Get(ctx, client.ObjectKey{Namespace: opts.Namespace, Name: name}, workload); err != nil {
-			if apierrs.IsNotFound(err) {
-				c.Infof("Workload %q does not exist\n", name)
-				continue
-			}
+		result, err := opts.deleteWorkload(ctx, c, opts.Namespace, name)
+		if err != nil {
 			return err
 		}
-		if !opts.Yes {
-			if opts.FilePath == "-" {
-				c.Errorf("Skipping workload, cannot confirm intent. Run command with %s flag to confirm intent when providing input from stdin\n", flags.YesFlagName)
-				return nil
-			} else {
-				okToDelete := false
-				err := cli.NewConfirmSurvey(c, "Really delete the workload %q?", name).Resolve(&okToDelete)
-				if err != nil || !okToDelete {
-					c.Infof("Skipping workload %q\n", name)
-					continue
-				}
-			}
+		if result == printer.WorkloadResultSkipped && opts.FilePath == "-" {
+			return nil
 		}
-		if err := c.Delete(ctx, workload); err != nil {
-			return err
+	}
+
+	return nil
+}
+
+// deleteWorkloads deletes every workload loaded from a file with multiple documents or from
+// a directory and prints a summary table with the result of each one
+func (opts *WorkloadDeleteOptions) deleteWorkloads(ctx context.Context, c *cli.Config, fileWorkloads []*cartov1alpha1.Workload) error {
+	if !opts.Yes && opts.FilePath == "-" {
+		c.Errorf("Skipping workloads, cannot confirm intent. Run command with %s flag to confirm intent when providing input from stdin\n", flags.YesFlagName)
+		return nil
+	}
+
+	workloads := &cartov1alpha1.WorkloadList{}
+	results := map[types.NamespacedName]string{}
+	for _, fileWorkload := range fileWorkloads {
+		namespace := opts.Namespace
+		if fileWorkload.Namespace != "" && !cli.CommandFromContext(ctx).Flags().Changed(cli.StripDash(flags.NamespaceFlagName)) {
+			namespace = fileWorkload.Namespace
 		}
-		c.Emoji(cli.ThumbsUp, cliprinter.Ssuccessf("Deleted workload %q\n", name))
-		if opts.Wait {
-			c.Infof("Waiting for workload %q to be deleted...\n", name)
-			workers := []wait.Worker{
-				func(ctx context.Context) error {
-					return wait.UntilDelete(ctx, c.Client, workload)
-				},
-			}
-			if err := wait.Race(ctx, opts.WaitTimeout, workers); err != nil {
-				if err == context.DeadlineExceeded {
-					c.Printf("%s timeout after %s waiting for %q to be deleted\n", printer.Serrorf("Error:"), opts.WaitTimeout, name)
-					c.Infof("To view status run: tanzu apps workload get %s %s %s\n", name, flags.NamespaceFlagName, opts.Namespace)
-					return cli.SilenceError(err)
-				}
+
+		var result string
+		var err error
+		if fileWorkload.Name == "" {
+			err = validation.ErrMissingField(cli.NameArgumentName).ToAggregate()
+		} else {
+			result, err = opts.deleteWorkload(ctx, c, namespace, fileWorkload.Name)
+		}
+		if err != nil {
+			if !errors.Is(err, cli.SilentError) {
 				c.Eprintf("%s %s\n", printer.Serrorf("Error:"), err)
-				return cli.SilenceError(err)
 			}
-			c.Infof("Workload %q was deleted\n", name)
+			result = printer.WorkloadResultFailed
 		}
+
+		workloads.Items = append(workloads.Items, cartov1alpha1.Workload{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      fileWorkload.Name,
+			},
+		})
+		results[types.NamespacedName{Namespace: namespace, Name: fileWorkload.Name}] = result
 	}
 
-	return nil
+	return summarizeWorkloadResults(c, workloads, results, false)
 }
 
-func (opts *WorkloadDeleteOptions) loadInputWorkload(input io.Reader, workload *cartov1alpha1.Workload) error {
-	var in io.Reader
-
-	f, err := os.Open(opts.FilePath)
-	in = f
-	if f == nil && opts.FilePath == "-" {
-		in = input
-	} else if err != nil {
-		return fmt.Errorf("unable to open file %q: %w", opts.FilePath, err)
+// deleteWorkload deletes a single workload after confirming the intent, and waits for it
+// to be deleted if requested
+func (opts *WorkloadDeleteOptions) deleteWorkload(ctx context.Context, c *cli.Config, namespace, name string) (string, error) {
+	workload := &cartov1alpha1.Workload{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, workload); err != nil {
+		if apierrs.IsNotFound(err) {
+			c.Infof("Workload %q does not exist\n", name)
+			return printer.WorkloadResultNotFound, nil
+		}
+		return "", err
 	}
-	defer f.Close()
-
-	if err := workload.Load(in); err != nil {
-		return fmt.Errorf("unable to load file %q: %w", opts.FilePath, err)
+	if !opts.Yes {
+		if opts.FilePath == "-" {
+			c.Errorf("Skipping workload, cannot confirm intent. Run command with %s flag to confirm intent when providing input from stdin\n", flags.YesFlagName)
+			return printer.WorkloadResultSkipped, nil
+		} else {
+			okToDelete := false
+			err := cli.NewConfirmSurvey(c, "Really delete the workload %q?", name).Resolve(&okToDelete)
+			if err != nil || !okToDelete {
+				c.Infof("Skipping workload %q\n", name)
+				return printer.WorkloadResultSkipped, nil
+			}
+		}
 	}
-	return nil
+	if err := c.Delete(ctx, workload); err != nil {
+		return "", err
+	}
+	c.Emoji(cli.ThumbsUp, cliprinter.Ssuccessf("Deleted workload %q\n", name))
+	if opts.Wait {
+		c.Infof("Waiting for workload %q to be deleted...\n", name)
+		workers := []wait.Worker{
+			func(ctx context.Context) error {
+				return wait.UntilDelete(ctx, c.Client, workload)
+			},
+		}
+		if err := wait.Race(ctx, opts.WaitTimeout, workers); err != nil {
+			if err == context.DeadlineExceeded {
+				c.Printf("%s timeout after %s waiting for %q to be deleted\n", printer.Serrorf("Error:"), opts.WaitTimeout, name)
+				c.Infof("To view status run: tanzu apps workload get %s %s %s\n", name, flags.NamespaceFlagName, namespace)
+				return "", cli.SilenceError(err)
+			}
+			c.Eprintf("%s %s\n", printer.Serrorf("Error:"), err)
+			return "", cli.SilenceError(err)
+		}
+		c.Infof("Workload %q was deleted\n", name)
+	}
+	return printer.WorkloadResultDeleted, nil
 }
 
 func NewWorkloadDeleteCommand(ctx context.Context, c *cli.Config) *cobra.Command {
@@ -191,7 +245,8 @@ func NewWorkloadDeleteCommand(ctx context.Context, c *cli.Config) *cobra.Command
 		Use:   "delete",
 		Short: "Delete workload(s)",
 		Long: strings.TrimSpace(`
-Delete one or more workloads by name or all workloads within a namespace.
+Delete one or more workloads by name, from a file or directory, or all workloads
+within a namespace.
 
 Deleting a workload prevents new builds while preserving built images in the
 registry.
@@ -199,6 +254,7 @@ registry.
 		Example: strings.Join([]string{
 			fmt.Sprintf("%s workload delete my-workload", c.Name),
 			fmt.Sprintf("%s workload delete %s", c.Name, flags.AllFlagName),
+			fmt.Sprintf("%s workload delete %s workloads/ %s", c.Name, flags.FilePathFlagName, flags.RecursiveFlagName),
 		}, "\n"),
 		PreRunE:           cli.ValidateE(ctx, opts),
 		RunE:              cli.ExecE(ctx, c, opts),
@@ -215,7 +271,8 @@ registry.
 	cmd.Flags().DurationVar(&opts.WaitTimeout, cli.StripDash(flags.WaitTimeoutFlagName), 1*time.Minute, "timeout for workload to be deleted when waiting")
 	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.WaitTimeoutFlagName), completion.SuggestDurationUnits(ctx, completion.CommonDurationUnits))
 	cmd.Flags().BoolVarP(&opts.Yes, cli.StripDash(flags.YesFlagName), "y", false, "accept all prompts")
-	cmd.Flags().StringVarP(&opts.FilePath, cli.StripDash(flags.FilePathFlagName), "f", "", "`file path` containing the description of a single workload, other flags are layered on top of this resource. Use value \"-\" to read from stdin")
+	cmd.Flags().StringVarP(&opts.FilePath, cli.StripDash(flags.FilePathFlagName), "f", "", "`file path` containing the description of one or more workloads, or a directory containing workload files. Use value \"-\" to read from stdin")
+	cmd.Flags().BoolVarP(&opts.Recursive, cli.StripDash(flags.RecursiveFlagName), "R", false, "process the directory used in "+flags.FilePathFlagName+" recursively")
 
 	return cmd
 }
@@ -102,6 +102,24 @@ func TestWorkloadDeleteOptionsValidate(t *testing.T) {
 			},
 			ExpectFieldErrors: validation.ErrMultipleOneOf(flags.AllFlagName, flags.FilePathFlagName),
 		},
+		{
+			Name: "recursive without file",
+			Validatable: &commands.WorkloadDeleteOptions{
+				Namespace: "default",
+				Names:     []string{"my-workload"},
+				Recursive: true,
+			},
+			ExpectFieldErrors: validation.ErrMissingField(flags.FilePathFlagName),
+		},
+		{
+			Name: "invalid name + directory file",
+			Validatable: &commands.WorkloadDeleteOptions{
+				Namespace: "default",
+				Names:     []string{"my-workload"},
+				FilePath:  "testdata/multiple-workloads",
+			},
+			ExpectFieldErrors: validation.ErrForbiddenFieldWithDetail(cli.NamesArgumentName, "not supported when processing multiple workloads"),
+		},
 		{
 			Name: "wait",
 			Validatable: &commands.WorkloadDeleteOptions{
@@ -693,6 +711,87 @@ Workload "test-workload" does not exist
 				c.ExpectString(clitesting.ToInteractOutput("Skipping workload %q", workloadName))
 			},
 		},
+		{
+			Name: "delete multiple workloads from directory recursively",
+			Args: []string{flags.FilePathFlagName, "testdata/multiple-workloads", flags.RecursiveFlagName, flags.YesFlagName},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("node-express")
+					}),
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("spring-petclinic")
+					}),
+			},
+			ExpectDeletes: []rtesting.DeleteRef{{
+				Group:     "carto.run",
+				Kind:      "Workload",
+				Namespace: defaultNamespace,
+				Name:      "node-express",
+			}, {
+				Group:     "carto.run",
+				Kind:      "Workload",
+				Namespace: defaultNamespace,
+				Name:      "spring-petclinic",
+			}},
+			ExpectOutput: `
+👍 Deleted workload "node-express"
+👍 Deleted workload "spring-petclinic"
+Workload "tanzu-java-web-app" does not exist
+
+📥 Summary
+   NAME                 NAMESPACE   RESULT
+   node-express         default     deleted
+   spring-petclinic     default     deleted
+   tanzu-java-web-app   default     not found
+`,
+		},
+		{
+			Name: "delete multiple workloads from file with failure",
+			Args: []string{flags.FilePathFlagName, "testdata/multiple-workloads/workloads.yaml", flags.YesFlagName},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("spring-petclinic")
+					}),
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("tanzu-java-web-app")
+					}),
+			},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("delete", "Workload", clitesting.InduceFailureOpts{
+					Name: "spring-petclinic",
+				}),
+			},
+			ExpectDeletes: []rtesting.DeleteRef{{
+				Group:     "carto.run",
+				Kind:      "Workload",
+				Namespace: defaultNamespace,
+				Name:      "spring-petclinic",
+			}, {
+				Group:     "carto.run",
+				Kind:      "Workload",
+				Namespace: defaultNamespace,
+				Name:      "tanzu-java-web-app",
+			}},
+			ShouldError: true,
+			ExpectOutput: `
+Error: inducing failure for delete Workload
+👍 Deleted workload "tanzu-java-web-app"
+
+📥 Summary
+   NAME                 NAMESPACE   RESULT
+   spring-petclinic     default     failed
+   tanzu-java-web-app   default     deleted
+`,
+		},
+		{
+			Name:        "delete multiple workloads from file with names",
+			Args:        []string{workloadName, flags.FilePathFlagName, "testdata/multiple-workloads/workloads.yaml", flags.YesFlagName},
+			ShouldError: true,
+		},
 	}
 	table.Run(t, scheme, commands.NewWorkloadDeleteCommand)
 }
@@ -0,0 +1,594 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	cliprinter "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+// workloadDiagnoseMaxEvents is the number of recent warning events reported as causes
+const workloadDiagnoseMaxEvents = 5
+
+type WorkloadDiagnoseOptions struct {
+	Namespace string
+	Name      string
+
+	Output string
+}
+
+var (
+	_ validation.Validatable = (*WorkloadDiagnoseOptions)(nil)
+	_ cli.Executable         = (*WorkloadDiagnoseOptions)(nil)
+)
+
+// WorkloadDiagnosis is the machine readable result of the diagnose command
+type WorkloadDiagnosis struct {
+	Name      string                   `json:"name"`
+	Namespace string                   `json:"namespace"`
+	Ready     bool                     `json:"ready"`
+	Causes    []WorkloadDiagnosisCause `json:"causes"`
+}
+
+// WorkloadDiagnosisCause is a likely reason for the workload not being ready, causes are
+// ranked starting from 1 for the most likely one
+type WorkloadDiagnosisCause struct {
+	Rank         int                   `json:"rank"`
+	Reason       string                `json:"reason"`
+	Object       string                `json:"object,omitempty"`
+	Message      string                `json:"message,omitempty"`
+	Remediations []WorkloadRemediation `json:"remediations,omitempty"`
+}
+
+// WorkloadRemediation is a command that may fix or give more details about a cause
+type WorkloadRemediation struct {
+	Description string `json:"description"`
+	Command     string `json:"command"`
+}
+
+func (opts *WorkloadDiagnoseOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	if opts.Namespace == "" {
+		errs = errs.Also(validation.ErrMissingField(flags.NamespaceFlagName))
+	}
+
+	if opts.Name == "" {
+		errs = errs.Also(validation.ErrMissingField(cli.NameArgumentName))
+	}
+
+	if opts.Output != "" {
+		errs = errs.Also(validation.Enum(opts.Output, flags.OutputFlagName, []string{printer.OutputFormatJson, printer.OutputFormatYaml, printer.OutputFormatYml}))
+	}
+
+	return errs
+}
+
+func (opts *WorkloadDiagnoseOptions) Exec(ctx context.Context, c *cli.Config) error {
+	workload, err := getWorkload(ctx, c, opts.Namespace, opts.Name)
+	if err != nil {
+		return err
+	}
+
+	diagnosis, err := opts.diagnose(ctx, c, workload)
+	if err != nil {
+		return err
+	}
+
+	if opts.Output != "" {
+		export, err := printer.OutputObject(diagnosis, printer.OutputFormat(opts.Output))
+		if err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Failed to output workload diagnosis:"), err)
+			return cli.SilenceError(err)
+		}
+		c.Printf("%s\n", export)
+		return nil
+	}
+
+	if diagnosis.Ready {
+		c.Successf("Workload %q is ready, no problems found\n", workload.Name)
+		return nil
+	}
+	c.Emoji(cli.Magnifying, "Workload %q is not ready, likely causes:\n", workload.Name)
+	for _, cause := range diagnosis.Causes {
+		c.Printf("\n")
+		if cause.Object != "" {
+			c.Printf("%s%d. %s %s\n", printer.AddPaddingStart(""), cause.Rank, printer.Serrorf(cause.Reason), cliprinter.Sfaintf("(%s)", cause.Object))
+		} else {
+			c.Printf("%s%d. %s\n", printer.AddPaddingStart(""), cause.Rank, printer.Serrorf(cause.Reason))
+		}
+		if cause.Message != "" {
+			c.Printf("%s%s\n", printer.AddPaddingStart("   "), cause.Message)
+		}
+		for _, remediation := range cause.Remediations {
+			c.Printf("%s%s:\n", printer.AddPaddingStart("   "), remediation.Description)
+			c.Infof("%s%s\n", printer.AddPaddingStart("     "), remediation.Command)
+		}
+	}
+	c.Printf("\n")
+
+	return nil
+}
+
+// diagnose collects the likely causes for the workload not being ready, ordered from the
+// supply chain selection, to the resources stamped by the supply chain, their pods and the
+// recent warning events
+func (opts *WorkloadDiagnoseOptions) diagnose(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload) (*WorkloadDiagnosis, error) {
+	diagnosis := &WorkloadDiagnosis{
+		Name:      workload.Name,
+		Namespace: workload.Namespace,
+		Causes:    []WorkloadDiagnosisCause{},
+	}
+	readyCond := cliprinter.FindCondition(workload.Status.Conditions, cartov1alpha1.ConditionReady)
+	if readyCond != nil && readyCond.Status == metav1.ConditionTrue {
+		diagnosis.Ready = true
+		return diagnosis, nil
+	}
+
+	causes := []WorkloadDiagnosisCause{}
+	if len(workload.Status.Conditions) == 0 {
+		causes = append(causes, WorkloadDiagnosisCause{
+			Reason:  "NotReconciled",
+			Object:  workloadDiagnosisObject(cartov1alpha1.WorkloadKind, workload.Name),
+			Message: "the workload has no status, the supply chain controller may not be running",
+			Remediations: []WorkloadRemediation{{
+				Description: "List the supply chains installed in the cluster",
+				Command:     "tanzu apps cluster-supply-chain list",
+			}},
+		})
+	}
+
+	supplyChainCauses, err := opts.diagnoseSupplyChain(ctx, c, workload)
+	if err != nil {
+		return nil, err
+	}
+	causes = append(causes, supplyChainCauses...)
+	causes = append(causes, opts.diagnoseResourcesSubmitted(c, workload)...)
+
+	resourceCauses, err := opts.diagnoseResources(ctx, c, workload)
+	if err != nil {
+		return nil, err
+	}
+	causes = append(causes, resourceCauses...)
+
+	eventCauses, err := opts.diagnoseEvents(ctx, c, workload)
+	if err != nil {
+		return nil, err
+	}
+	causes = append(causes, eventCauses...)
+
+	if len(causes) == 0 && readyCond != nil {
+		causes = append(causes, WorkloadDiagnosisCause{
+			Reason:  readyCond.Reason,
+			Object:  workloadDiagnosisObject(cartov1alpha1.WorkloadKind, workload.Name),
+			Message: readyCond.Message,
+		})
+	}
+
+	for i := range causes {
+		causes[i].Rank = i + 1
+	}
+	diagnosis.Causes = causes
+	return diagnosis, nil
+}
+
+// diagnoseSupplyChain explains why no supply chain, or more than one supply chain, was
+// selected for the workload
+func (opts *WorkloadDiagnoseOptions) diagnoseSupplyChain(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload) ([]WorkloadDiagnosisCause, error) {
+	cond := cliprinter.FindCondition(workload.Status.Conditions, cartov1alpha1.WorkloadSupplyChainReady)
+	if cond == nil || cond.Status == metav1.ConditionTrue {
+		return nil, nil
+	}
+	cause := WorkloadDiagnosisCause{
+		Reason:  cond.Reason,
+		Object:  workloadDiagnosisObject(cartov1alpha1.WorkloadKind, workload.Name),
+		Message: cond.Message,
+	}
+
+	switch cond.Reason {
+	case cartov1alpha1.WorkloadLabelsMissingSupplyChainReason, cartov1alpha1.NotFoundSupplyChainReadyReason:
+		supplyChains := &cartov1alpha1.ClusterSupplyChainList{}
+		if err := c.List(ctx, supplyChains); err != nil {
+			return nil, err
+		}
+		if len(supplyChains.Items) == 0 {
+			cause.Remediations = append(cause.Remediations, WorkloadRemediation{
+				Description: "No supply chains are installed in the cluster, check the installed supply chains",
+				Command:     "tanzu apps cluster-supply-chain list",
+			})
+			break
+		}
+		cause.Remediations = append(cause.Remediations, opts.supplyChainLabelRemediations(c, workload, supplyChains.Items)...)
+	case cartov1alpha1.MultipleMatchesSupplyChainReadyReason:
+		supplyChains := &cartov1alpha1.ClusterSupplyChainList{}
+		if err := c.List(ctx, supplyChains); err != nil {
+			return nil, err
+		}
+		for _, match := range matchSupplyChains(workload, supplyChains.Items).SupplyChains {
+			if match.Selected {
+				cause.Remediations = append(cause.Remediations, WorkloadRemediation{
+					Description: fmt.Sprintf("Supply chain %q matches the workload, compare its selector with the other matches", match.Name),
+					Command:     fmt.Sprintf("tanzu apps cluster-supply-chain get %s", match.Name),
+				})
+			}
+		}
+	}
+
+	return []WorkloadDiagnosisCause{cause}, nil
+}
+
+// supplyChainLabelRemediations returns the apply commands that set the labels selected by
+// each supply chain, the supply chains needing the fewest changes come first
+func (opts *WorkloadDiagnoseOptions) supplyChainLabelRemediations(c *cli.Config, workload *cartov1alpha1.Workload, supplyChains []cartov1alpha1.ClusterSupplyChain) []WorkloadRemediation {
+	type candidate struct {
+		name  string
+		flags []string
+	}
+	candidates := []candidate{}
+	for _, supplyChain := range supplyChains {
+		if len(supplyChain.Spec.Selector) == 0 {
+			continue
+		}
+		keys := []string{}
+		for key := range supplyChain.Spec.Selector {
+			keys = append(keys, key)
+		}
+		// the workload type is set with its own flag, listed before the other labels
+		sort.Slice(keys, func(i, j int) bool {
+			if (keys[i] == apis.WorkloadTypeLabelName) != (keys[j] == apis.WorkloadTypeLabelName) {
+				return keys[i] == apis.WorkloadTypeLabelName
+			}
+			return keys[i] < keys[j]
+		})
+		labelFlags := []string{}
+		for _, key := range keys {
+			value := supplyChain.Spec.Selector[key]
+			if current, ok := workload.Labels[key]; ok && current == value {
+				continue
+			}
+			if key == apis.WorkloadTypeLabelName {
+				labelFlags = append(labelFlags, fmt.Sprintf("%s %s", flags.TypeFlagName, value))
+			} else {
+				labelFlags = append(labelFlags, fmt.Sprintf("%s %s=%s", flags.LabelFlagName, key, value))
+			}
+		}
+		if len(labelFlags) == 0 {
+			continue
+		}
+		candidates = append(candidates, candidate{name: supplyChain.Name, flags: labelFlags})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if len(candidates[i].flags) != len(candidates[j].flags) {
+			return len(candidates[i].flags) < len(candidates[j].flags)
+		}
+		return candidates[i].name < candidates[j].name
+	})
+
+	remediations := []WorkloadRemediation{}
+	for _, candidate := range candidates {
+		remediations = append(remediations, WorkloadRemediation{
+			Description: fmt.Sprintf("Set the labels selected by the supply chain %q", candidate.name),
+			Command:     fmt.Sprintf("tanzu apps workload apply %s%s %s", workload.Name, namespaceFlag(c, workload.Namespace), strings.Join(candidate.flags, " ")),
+		})
+	}
+	return remediations
+}
+
+// diagnoseResourcesSubmitted explains why the supply chain could not stamp the workload resources
+func (opts *WorkloadDiagnoseOptions) diagnoseResourcesSubmitted(c *cli.Config, workload *cartov1alpha1.Workload) []WorkloadDiagnosisCause {
+	cond := cliprinter.FindCondition(workload.Status.Conditions, cartov1alpha1.WorkloadResourceSubmitted)
+	if cond == nil || cond.Status == metav1.ConditionTrue || cond.Reason == "" {
+		return nil
+	}
+	cause := WorkloadDiagnosisCause{
+		Reason:  cond.Reason,
+		Object:  workloadDiagnosisObject(cartov1alpha1.WorkloadKind, workload.Name),
+		Message: cond.Message,
+	}
+
+	switch cond.Reason {
+	case cartov1alpha1.ServiceAccountSecretErrorResourcesSubmittedReason:
+		serviceAccount := "default"
+		if workload.Spec.ServiceAccountName != nil && *workload.Spec.ServiceAccountName != "" {
+			serviceAccount = *workload.Spec.ServiceAccountName
+		}
+		cause.Remediations = append(cause.Remediations, WorkloadRemediation{
+			Description: fmt.Sprintf("Check that the service account %q exists and has a token secret", serviceAccount),
+			Command:     fmt.Sprintf("kubectl get serviceaccount %s -n %s -o yaml", serviceAccount, workload.Namespace),
+		})
+	case cartov1alpha1.ResourceRealizerBuilderErrorResourcesSubmittedReason:
+		if supplyChain := workload.Status.SupplyChainRef.Name; supplyChain != "" {
+			cause.Remediations = append(cause.Remediations, WorkloadRemediation{
+				Description: fmt.Sprintf("Check the resources and templates of the supply chain %q", supplyChain),
+				Command:     fmt.Sprintf("tanzu apps cluster-supply-chain get %s", supplyChain),
+			})
+		}
+	}
+
+	return []WorkloadDiagnosisCause{cause}
+}
+
+// diagnoseResources reports the first resource stamped for the workload that is not ready
+// and the failing pods created for it, resources stamped for the deliverable are inspected
+// when the deliverable is the first resource not ready
+func (opts *WorkloadDiagnoseOptions) diagnoseResources(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload) ([]WorkloadDiagnosisCause, error) {
+	resource := firstNotReadyResource(workload.Status.Resources)
+	namespace := workload.Namespace
+	if resource != nil && resource.StampedRef != nil && resource.StampedRef.ObjectReference != nil && resource.StampedRef.Kind == cartov1alpha1.DeliverableKind {
+		deliverable := &cartov1alpha1.Deliverable{}
+		key := types.NamespacedName{Namespace: resource.StampedRef.Namespace, Name: resource.StampedRef.Name}
+		if key.Namespace == "" {
+			key.Namespace = workload.Namespace
+		}
+		if err := c.Get(ctx, key, deliverable); err == nil {
+			if deliverableResource := firstNotReadyResource(deliverable.Status.Resources); deliverableResource != nil {
+				resource, namespace = deliverableResource, key.Namespace
+			}
+		}
+	}
+	if resource == nil {
+		return nil, nil
+	}
+
+	cause := WorkloadDiagnosisCause{
+		Reason: "NotReady",
+		Object: resource.Name,
+	}
+	if cond := cliprinter.FindCondition(resource.Conditions, cartov1alpha1.ConditionResourceReady); cond != nil {
+		if cond.Reason != "" {
+			cause.Reason = cond.Reason
+		}
+		cause.Message = cond.Message
+	}
+	ref := resource.StampedRef
+	if ref == nil || ref.ObjectReference == nil {
+		return []WorkloadDiagnosisCause{cause}, nil
+	}
+	if ref.Namespace != "" {
+		namespace = ref.Namespace
+	}
+	cause.Object = fmt.Sprintf("%s: %s", resource.Name, workloadDiagnosisObject(ref.Kind, ref.Name))
+	resourceName := ref.Resource
+	if resourceName == "" {
+		resourceName = strings.ToLower(ref.Kind)
+	}
+	cause.Remediations = append(cause.Remediations, WorkloadRemediation{
+		Description: fmt.Sprintf("Describe the %s stamped for the resource %q", ref.Kind, resource.Name),
+		Command:     fmt.Sprintf("kubectl describe %s %s -n %s", resourceName, ref.Name, namespace),
+	})
+	causes := []WorkloadDiagnosisCause{cause}
+
+	// pods are owned by the stamped object or by the objects it creates, like builds and task runs
+	b := &workloadTreeBuilder{
+		c:        c,
+		maxDepth: 3,
+		visited:  map[string]bool{},
+		lists:    map[string][]unstructured.Unstructured{},
+	}
+	gvk, err := b.stampedRefKind(ref)
+	if err != nil || gvk.Empty() {
+		return causes, nil
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, obj); err != nil {
+		if isResourceUnavailable(err) {
+			return causes, nil
+		}
+		return nil, err
+	}
+	node, err := b.node(ctx, obj, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range workloadTreePodNames(node) {
+		pod := &corev1.Pod{}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, pod); err != nil {
+			if isResourceUnavailable(err) {
+				continue
+			}
+			return nil, err
+		}
+		causes = append(causes, diagnosePod(pod)...)
+	}
+
+	return causes, nil
+}
+
+// diagnoseEvents reports the most recent warning events for the workload and its resources
+func (opts *WorkloadDiagnoseOptions) diagnoseEvents(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload) ([]WorkloadDiagnosisCause, error) {
+	objects, namespaces := workloadRelatedObjects(ctx, c, workload)
+	events := []corev1.Event{}
+	for _, namespace := range namespaces {
+		list := &corev1.EventList{}
+		if err := c.List(ctx, list, client.InNamespace(namespace)); err != nil {
+			return nil, err
+		}
+		for _, event := range list.Items {
+			if event.Type == corev1.EventTypeWarning && objects[eventObjectKeyFor(&event)] {
+				events = append(events, event)
+			}
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return printer.EventTimestamp(&events[j]).Before(printer.EventTimestamp(&events[i]))
+	})
+
+	causes := []WorkloadDiagnosisCause{}
+	seen := map[string]bool{}
+	for _, event := range events {
+		object := workloadDiagnosisObject(event.InvolvedObject.Kind, event.InvolvedObject.Name)
+		key := object + "/" + event.Reason
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		causes = append(causes, WorkloadDiagnosisCause{
+			Reason:  event.Reason,
+			Object:  object,
+			Message: event.Message,
+			Remediations: []WorkloadRemediation{{
+				Description: "Show the events of the workload",
+				Command:     fmt.Sprintf("tanzu apps workload events %s%s", workload.Name, namespaceFlag(c, workload.Namespace)),
+			}},
+		})
+		if len(causes) == workloadDiagnoseMaxEvents {
+			break
+		}
+	}
+	return causes, nil
+}
+
+// diagnosePod reports the containers of a pod that cannot start or that failed
+func diagnosePod(pod *corev1.Pod) []WorkloadDiagnosisCause {
+	causes := []WorkloadDiagnosisCause{}
+	object := workloadDiagnosisObject("Pod", pod.Name)
+	if cond := podCondition(pod, corev1.PodScheduled); cond != nil && cond.Status == corev1.ConditionFalse {
+		causes = append(causes, WorkloadDiagnosisCause{
+			Reason:  cond.Reason,
+			Object:  object,
+			Message: cond.Message,
+			Remediations: []WorkloadRemediation{{
+				Description: "Describe the pod",
+				Command:     fmt.Sprintf("kubectl describe pod %s -n %s", pod.Name, pod.Namespace),
+			}},
+		})
+	}
+
+	statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		if waiting := status.State.Waiting; waiting != nil && waiting.Reason != "" && waiting.Reason != "PodInitializing" && waiting.Reason != "ContainerCreating" {
+			remediation := WorkloadRemediation{
+				Description: fmt.Sprintf("Show the logs of the container %q", status.Name),
+				Command:     fmt.Sprintf("kubectl logs %s -c %s -n %s --previous", pod.Name, status.Name, pod.Namespace),
+			}
+			if waiting.Reason == "ImagePullBackOff" || waiting.Reason == "ErrImagePull" {
+				remediation = WorkloadRemediation{
+					Description: "Describe the pod",
+					Command:     fmt.Sprintf("kubectl describe pod %s -n %s", pod.Name, pod.Namespace),
+				}
+			}
+			causes = append(causes, WorkloadDiagnosisCause{
+				Reason:       waiting.Reason,
+				Object:       fmt.Sprintf("%s container %s", object, status.Name),
+				Message:      waiting.Message,
+				Remediations: []WorkloadRemediation{remediation},
+			})
+		}
+		if terminated := status.State.Terminated; terminated != nil && terminated.ExitCode != 0 {
+			causes = append(causes, WorkloadDiagnosisCause{
+				Reason:  terminated.Reason,
+				Object:  fmt.Sprintf("%s container %s", object, status.Name),
+				Message: fmt.Sprintf("container exited with code %d", terminated.ExitCode),
+				Remediations: []WorkloadRemediation{{
+					Description: fmt.Sprintf("Show the logs of the container %q", status.Name),
+					Command:     fmt.Sprintf("kubectl logs %s -c %s -n %s", pod.Name, status.Name, pod.Namespace),
+				}},
+			})
+		}
+	}
+	return causes
+}
+
+func podCondition(pod *corev1.Pod, conditionType corev1.PodConditionType) *corev1.PodCondition {
+	for i := range pod.Status.Conditions {
+		if pod.Status.Conditions[i].Type == conditionType {
+			return &pod.Status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// firstNotReadyResource returns the first resource, in the supply chain order, that is not ready
+func firstNotReadyResource(resources []cartov1alpha1.RealizedResource) *cartov1alpha1.RealizedResource {
+	for i := range resources {
+		cond := cliprinter.FindCondition(resources[i].Conditions, cartov1alpha1.ConditionResourceReady)
+		if cond == nil || cond.Status != metav1.ConditionTrue {
+			return &resources[i]
+		}
+	}
+	return nil
+}
+
+func workloadTreePodNames(node *printer.WorkloadTreeNode) []string {
+	names := []string{}
+	if node.Kind == "Pod" && schema.FromAPIVersionAndKind(node.APIVersion, node.Kind).Group == "" {
+		names = append(names, node.Name)
+	}
+	for _, child := range node.Children {
+		names = append(names, workloadTreePodNames(child)...)
+	}
+	return names
+}
+
+func workloadDiagnosisObject(kind, name string) string {
+	return fmt.Sprintf("%s/%s", kind, name)
+}
+
+func NewWorkloadDiagnoseCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &WorkloadDiagnoseOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "diagnose",
+		Short: "Explain why a workload is not ready",
+		Long: strings.TrimSpace(`
+Inspect a workload and list the likely causes for it not being ready, the most likely
+cause first. The workload conditions are checked to find out whether a supply chain
+was selected and whether its resources were stamped, followed by the first resource
+that is not ready, the failing pods created for that resource and the recent warning
+events.
+
+Each cause comes with commands that either fix the problem, like the labels needed to
+match a supply chain, or show more details about it.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s workload diagnose my-workload", c.Name),
+			fmt.Sprintf("%s workload diagnose my-workload %s json", c.Name, flags.OutputFlagName),
+		}, "\n"),
+		PreRunE:           cli.ValidateE(ctx, opts),
+		RunE:              cli.ExecE(ctx, c, opts),
+		ValidArgsFunction: completion.SuggestWorkloadNames(ctx, c),
+	}
+
+	cli.Args(cmd,
+		cli.NameArg(&opts.Name),
+	)
+
+	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the diagnosis formatted. Supported formats: \"json\", \"yaml\", \"yml\"")
+
+	return cmd
+}
@@ -0,0 +1,434 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	diecorev1 "dies.dev/apis/core/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+func TestWorkloadDiagnoseOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:        "empty",
+			Validatable: &commands.WorkloadDiagnoseOptions{},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMissingField(flags.NamespaceFlagName),
+				validation.ErrMissingField(cli.NameArgumentName),
+			),
+		},
+		{
+			Name: "valid",
+			Validatable: &commands.WorkloadDiagnoseOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Output:    "json",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid output",
+			Validatable: &commands.WorkloadDiagnoseOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Output:    "table",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("table", flags.OutputFlagName, []string{"json", "yaml", "yml"}),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestWorkloadDiagnoseCommand(t *testing.T) {
+	defaultNamespace := "default"
+	workloadName := "my-workload"
+	eventTime := time.Date(2023, time.January, 1, 10, 0, 0, 0, time.UTC)
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	givenNamespaceDefault := diecorev1.NamespaceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(defaultNamespace)
+		})
+
+	workload := diecartov1alpha1.WorkloadBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(workloadName)
+			d.Namespace(defaultNamespace)
+			d.AddLabel(apis.WorkloadTypeLabelName, "webapp")
+		})
+	notReadyWorkload := func(condType, reason, message string) *diecartov1alpha1.WorkloadDie {
+		return workload.
+			StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+				d.ConditionsDie(
+					diecartov1alpha1.WorkloadConditionReadyBlank.Status(metav1.ConditionFalse).Reason(reason).Message(message),
+					diemetav1.ConditionBlank.Type(condType).Status(metav1.ConditionFalse).Reason(reason).Message(message),
+				)
+			})
+	}
+	supplyChain := func(name string, selector map[string]string) *diecartov1alpha1.ClusterSupplyChainDie {
+		return diecartov1alpha1.ClusterSupplyChainBlank.
+			MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+				d.Name(name)
+			}).
+			SpecDie(func(d *diecartov1alpha1.SupplyChainSpecDie) {
+				d.Selector(selector)
+			})
+	}
+	sourceToURL := supplyChain("source-to-url", map[string]string{apis.WorkloadTypeLabelName: "web"})
+	testingPipeline := supplyChain("source-test-to-url", map[string]string{apis.WorkloadTypeLabelName: "web", "apps.tanzu.vmware.com/has-tests": "true"})
+	webapp := supplyChain("webapp", map[string]string{apis.WorkloadTypeLabelName: "webapp"})
+
+	failingResource := workload.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.UID("workload-uid")
+		}).
+		StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+			d.ConditionsDie(
+				diecartov1alpha1.WorkloadConditionReadyBlank.Status(metav1.ConditionUnknown).Reason("MissingValueAtPath"),
+			)
+			d.Resources(
+				diecartov1alpha1.RealizedResourceBlank.
+					Name("source-provider").
+					ConditionsDie(diecartov1alpha1.WorkloadConditionResourceReadyBlank.Status(metav1.ConditionTrue)).
+					DieRelease(),
+				diecartov1alpha1.RealizedResourceBlank.
+					Name("image-provider").
+					StampedRef(&cartov1alpha1.StampedRef{
+						ObjectReference: &corev1.ObjectReference{APIVersion: "kpack.io/v1alpha2", Kind: "Image", Namespace: defaultNamespace, Name: workloadName},
+						Resource:        "images.kpack.io",
+					}).
+					ConditionsDie(
+						diecartov1alpha1.WorkloadConditionResourceReadyBlank.Status(metav1.ConditionUnknown).Reason("MissingValueAtPath").Message("waiting to read value [.status.latestImage] from resource [images.kpack.io/my-workload] in namespace [default]"),
+					).
+					DieRelease(),
+			)
+		})
+	image := &unstructured.Unstructured{}
+	image.SetAPIVersion("kpack.io/v1alpha2")
+	image.SetKind("Image")
+	image.SetNamespace(defaultNamespace)
+	image.SetName(workloadName)
+	image.SetUID("image-uid")
+	build := &unstructured.Unstructured{}
+	build.SetAPIVersion("kpack.io/v1alpha2")
+	build.SetKind("Build")
+	build.SetNamespace(defaultNamespace)
+	build.SetName(workloadName + "-build-1")
+	build.SetUID("build-uid")
+	build.SetOwnerReferences([]metav1.OwnerReference{{Name: workloadName, UID: "image-uid"}})
+	buildPod := diecorev1.PodBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(workloadName + "-build-1-build-pod")
+			d.Namespace(defaultNamespace)
+			d.UID("pod-uid")
+			d.OwnerReferences(metav1.OwnerReference{Name: workloadName + "-build-1", UID: "build-uid"})
+		}).
+		StatusDie(func(d *diecorev1.PodStatusDie) {
+			d.InitContainerStatusDie("prepare", func(d *diecorev1.ContainerStatusDie) {
+				d.StateDie(func(d *diecorev1.ContainerStateDie) {
+					d.TerminatedDie(func(d *diecorev1.ContainerStateTerminatedDie) {
+						d.ExitCode(0).Reason("Completed")
+					})
+				})
+			})
+			d.InitContainerStatusDie("analyze", func(d *diecorev1.ContainerStatusDie) {
+				d.StateDie(func(d *diecorev1.ContainerStateDie) {
+					d.WaitingDie(func(d *diecorev1.ContainerStateWaitingDie) {
+						d.Reason("ImagePullBackOff").Message(`Back-off pulling image "registry.example.com/lifecycle"`)
+					})
+				})
+			})
+		})
+	warningEvent := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "build-pod-event",
+			Namespace: defaultNamespace,
+		},
+		InvolvedObject: corev1.ObjectReference{Kind: "Image", Namespace: defaultNamespace, Name: workloadName},
+		Type:           corev1.EventTypeWarning,
+		Reason:         "BuildFailed",
+		Message:        "build my-workload-build-1 failed",
+		LastTimestamp:  metav1.NewTime(eventTime),
+	}
+	normalEvent := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "image-event",
+			Namespace: defaultNamespace,
+		},
+		InvolvedObject: corev1.ObjectReference{Kind: "Image", Namespace: defaultNamespace, Name: workloadName},
+		Type:           corev1.EventTypeNormal,
+		Reason:         "Created",
+		Message:        "build my-workload-build-1 created",
+		LastTimestamp:  metav1.NewTime(eventTime.Add(-time.Minute)),
+	}
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "empty",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name:         "workload not found",
+			Args:         []string{workloadName},
+			GivenObjects: []client.Object{givenNamespaceDefault},
+			ShouldError:  true,
+			ExpectOutput: `
+Workload "default/my-workload" not found
+`,
+		},
+		{
+			Name: "ready workload",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				workload.StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+					d.ConditionsDie(diecartov1alpha1.WorkloadConditionReadyBlank.Status(metav1.ConditionTrue))
+				}),
+			},
+			ExpectOutput: `
+Workload "my-workload" is ready, no problems found
+`,
+		},
+		{
+			Name:         "workload not reconciled",
+			Args:         []string{workloadName},
+			GivenObjects: []client.Object{workload},
+			ExpectOutput: `
+🔎 Workload "my-workload" is not ready, likely causes:
+
+   1. NotReconciled (Workload/my-workload)
+      the workload has no status, the supply chain controller may not be running
+      List the supply chains installed in the cluster:
+        tanzu apps cluster-supply-chain list
+
+`,
+		},
+		{
+			Name: "labels missing",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				notReadyWorkload(cartov1alpha1.WorkloadSupplyChainReady, cartov1alpha1.WorkloadLabelsMissingSupplyChainReason, "no supply chain found where full selector is satisfied by labels: map[apps.tanzu.vmware.com/workload-type:webapp]"),
+				sourceToURL, testingPipeline,
+			},
+			ExpectOutput: `
+🔎 Workload "my-workload" is not ready, likely causes:
+
+   1. WorkloadLabelsMissing (Workload/my-workload)
+      no supply chain found where full selector is satisfied by labels: map[apps.tanzu.vmware.com/workload-type:webapp]
+      Set the labels selected by the supply chain "source-to-url":
+        tanzu apps workload apply my-workload --type web
+      Set the labels selected by the supply chain "source-test-to-url":
+        tanzu apps workload apply my-workload --type web --label apps.tanzu.vmware.com/has-tests=true
+
+`,
+		},
+		{
+			Name: "labels missing in another namespace",
+			Args: []string{workloadName, flags.NamespaceFlagName, "dev", flags.OutputFlagName, "json"},
+			GivenObjects: []client.Object{
+				notReadyWorkload(cartov1alpha1.WorkloadSupplyChainReady, cartov1alpha1.WorkloadLabelsMissingSupplyChainReason, "no supply chain found").
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Namespace("dev")
+					}),
+				sourceToURL,
+			},
+			ExpectOutput: `
+{
+	"name": "my-workload",
+	"namespace": "dev",
+	"ready": false,
+	"causes": [
+		{
+			"rank": 1,
+			"reason": "WorkloadLabelsMissing",
+			"object": "Workload/my-workload",
+			"message": "no supply chain found",
+			"remediations": [
+				{
+					"description": "Set the labels selected by the supply chain \"source-to-url\"",
+					"command": "tanzu apps workload apply my-workload --namespace dev --type web"
+				}
+			]
+		}
+	]
+}
+`,
+		},
+		{
+			Name: "no supply chains installed",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				notReadyWorkload(cartov1alpha1.WorkloadSupplyChainReady, cartov1alpha1.NotFoundSupplyChainReadyReason, "no supply chain found"),
+			},
+			ExpectOutput: `
+🔎 Workload "my-workload" is not ready, likely causes:
+
+   1. SupplyChainNotFound (Workload/my-workload)
+      no supply chain found
+      No supply chains are installed in the cluster, check the installed supply chains:
+        tanzu apps cluster-supply-chain list
+
+`,
+		},
+		{
+			Name: "multiple supply chain matches",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				notReadyWorkload(cartov1alpha1.WorkloadSupplyChainReady, cartov1alpha1.MultipleMatchesSupplyChainReadyReason, "more than one supply chain selected workload"),
+				webapp, supplyChain("webapp-copy", map[string]string{apis.WorkloadTypeLabelName: "webapp"}), sourceToURL,
+			},
+			ExpectOutput: `
+🔎 Workload "my-workload" is not ready, likely causes:
+
+   1. MultipleSupplyChainMatches (Workload/my-workload)
+      more than one supply chain selected workload
+      Supply chain "webapp" matches the workload, compare its selector with the other matches:
+        tanzu apps cluster-supply-chain get webapp
+      Supply chain "webapp-copy" matches the workload, compare its selector with the other matches:
+        tanzu apps cluster-supply-chain get webapp-copy
+
+`,
+		},
+		{
+			Name: "multiple supply chain matches with field selectors",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				notReadyWorkload(cartov1alpha1.WorkloadSupplyChainReady, cartov1alpha1.MultipleMatchesSupplyChainReadyReason, "more than one supply chain selected workload"),
+				webapp, supplyChain("webapp-copy", map[string]string{apis.WorkloadTypeLabelName: "webapp"}),
+				supplyChain("webapp-image", map[string]string{apis.WorkloadTypeLabelName: "webapp"}).
+					SpecDie(func(d *diecartov1alpha1.SupplyChainSpecDie) {
+						d.SelectorMatchFields(cartov1alpha1.FieldSelectorRequirement{
+							Key:      "spec.image",
+							Operator: cartov1alpha1.FieldSelectorOperator("Exists"),
+						})
+					}),
+			},
+			ExpectOutput: `
+🔎 Workload "my-workload" is not ready, likely causes:
+
+   1. MultipleSupplyChainMatches (Workload/my-workload)
+      more than one supply chain selected workload
+      Supply chain "webapp" matches the workload, compare its selector with the other matches:
+        tanzu apps cluster-supply-chain get webapp
+      Supply chain "webapp-copy" matches the workload, compare its selector with the other matches:
+        tanzu apps cluster-supply-chain get webapp-copy
+
+`,
+		},
+		{
+			Name: "service account secret error",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				notReadyWorkload(cartov1alpha1.WorkloadResourceSubmitted, cartov1alpha1.ServiceAccountSecretErrorResourcesSubmittedReason, `failed to get secret for service account "default"`),
+			},
+			ExpectOutput: `
+🔎 Workload "my-workload" is not ready, likely causes:
+
+   1. ServiceAccountSecretError (Workload/my-workload)
+      failed to get secret for service account "default"
+      Check that the service account "default" exists and has a token secret:
+        kubectl get serviceaccount default -n default -o yaml
+
+`,
+		},
+		{
+			Name:         "failing resource",
+			Args:         []string{workloadName},
+			GivenObjects: []client.Object{failingResource, image, build, buildPod, warningEvent, normalEvent},
+			ExpectOutput: `
+🔎 Workload "my-workload" is not ready, likely causes:
+
+   1. MissingValueAtPath (image-provider: Image/my-workload)
+      waiting to read value [.status.latestImage] from resource [images.kpack.io/my-workload] in namespace [default]
+      Describe the Image stamped for the resource "image-provider":
+        kubectl describe images.kpack.io my-workload -n default
+
+   2. ImagePullBackOff (Pod/my-workload-build-1-build-pod container analyze)
+      Back-off pulling image "registry.example.com/lifecycle"
+      Describe the pod:
+        kubectl describe pod my-workload-build-1-build-pod -n default
+
+   3. BuildFailed (Image/my-workload)
+      build my-workload-build-1 failed
+      Show the events of the workload:
+        tanzu apps workload events my-workload
+
+`,
+		},
+		{
+			Name:         "failing resource as yaml",
+			Args:         []string{workloadName, flags.OutputFlagName, "yaml"},
+			GivenObjects: []client.Object{failingResource, image, build, buildPod},
+			ExpectOutput: `
+---
+causes:
+- message: waiting to read value [.status.latestImage] from resource [images.kpack.io/my-workload]
+    in namespace [default]
+  object: 'image-provider: Image/my-workload'
+  rank: 1
+  reason: MissingValueAtPath
+  remediations:
+  - command: kubectl describe images.kpack.io my-workload -n default
+    description: Describe the Image stamped for the resource "image-provider"
+- message: Back-off pulling image "registry.example.com/lifecycle"
+  object: Pod/my-workload-build-1-build-pod container analyze
+  rank: 2
+  reason: ImagePullBackOff
+  remediations:
+  - command: kubectl describe pod my-workload-build-1-build-pod -n default
+    description: Describe the pod
+name: my-workload
+namespace: default
+ready: false
+`,
+		},
+		{
+			Name: "list supply chains failed",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				notReadyWorkload(cartov1alpha1.WorkloadSupplyChainReady, cartov1alpha1.WorkloadLabelsMissingSupplyChainReason, "no supply chain found"),
+			},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("list", "ClusterSupplyChainList"),
+			},
+			ShouldError: true,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewWorkloadDiagnoseCommand)
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+type WorkloadDiffOptions struct {
+	WorkloadOptions
+	UpdateStrategy string
+}
+
+var (
+	_ validation.Validatable = (*WorkloadDiffOptions)(nil)
+	_ cli.Executable         = (*WorkloadDiffOptions)(nil)
+)
+
+// WorkloadDiff is the machine readable result of the diff command
+type WorkloadDiff struct {
+	Name      string                `json:"name"`
+	Namespace string                `json:"namespace"`
+	Exists    bool                  `json:"exists"`
+	Changes   []printer.FieldChange `json:"changes"`
+}
+
+func (opts *WorkloadDiffOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+	errs = errs.Also(opts.WorkloadOptions.Validate(ctx))
+
+	if opts.UpdateStrategy != "" && cli.CommandFromContext(ctx).Flags().Changed(cli.StripDash(flags.UpdateStrategyFlagName)) {
+		if opts.FilePath == "" {
+			errs = errs.Also(validation.ErrMissingField(flags.FilePathFlagName))
+		}
+		errs = errs.Also(validation.Enum(opts.UpdateStrategy, flags.UpdateStrategyFlagName, []string{mergeUpdateStrategy, replaceUpdateStrategy}))
+	}
+
+	return errs
+}
+
+func (opts *WorkloadDiffOptions) Exec(ctx context.Context, c *cli.Config) error {
+	fileWorkload := &cartov1alpha1.Workload{}
+	if opts.FilePath != "" {
+		if err := opts.WorkloadOptions.LoadInputWorkload(c.Stdin, fileWorkload); err != nil {
+			return err
+		}
+
+		if opts.Name == "" {
+			opts.Name = fileWorkload.Name
+		}
+		if fileWorkload.Namespace != "" && !cli.CommandFromContext(ctx).Flags().Changed(cli.StripDash(flags.NamespaceFlagName)) {
+			opts.Namespace = fileWorkload.Namespace
+		}
+	}
+
+	// validate that a namespace and name are provided
+	errs := validation.FieldErrors{}
+	if opts.Name == "" {
+		errs = errs.Also(validation.ErrMissingField(cli.NameArgumentName))
+	}
+	if opts.Namespace == "" {
+		errs = errs.Also(validation.ErrMissingField(flags.NamespaceFlagName))
+	}
+	if err := errs.ToAggregate(); err != nil {
+		return err
+	}
+
+	_, currentWorkload, workload, err := opts.resolveWorkload(ctx, c, fileWorkload, opts.UpdateStrategy)
+	if err != nil {
+		return err
+	}
+
+	// source code is not published, so the digest of the image with the local source is unknown
+	// and the image in the cluster is kept when it points to the same repository
+	if opts.LocalPath != "" && currentWorkload != nil && currentWorkload.Spec.Source != nil && workload.Spec.Source != nil {
+		if currentImage := currentWorkload.Spec.Source.Image; strings.Split(currentImage, "@")[0] == workload.Spec.Source.Image {
+			workload.Spec.Source.Image = currentImage
+		}
+	}
+
+	if opts.Output != "" {
+		changes, err := printer.ResourceDiffFields(currentWorkload, workload, c.Scheme)
+		if err != nil {
+			return err
+		}
+		export, err := printer.OutputObject(WorkloadDiff{
+			Name:      workload.Name,
+			Namespace: workload.Namespace,
+			Exists:    currentWorkload != nil,
+			Changes:   changes,
+		}, printer.OutputFormat(opts.Output))
+		if err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Failed to output workload diff:"), err)
+			return cli.SilenceError(err)
+		}
+		c.Printf("%s\n", export)
+		if len(changes) != 0 {
+			return cli.SilenceError(fmt.Errorf("workload %q differs from the cluster", workload.Name))
+		}
+		return nil
+	}
+
+	difference, noChange, err := printer.ResourceDiff(currentWorkload, workload, c.Scheme)
+	if err != nil {
+		return err
+	}
+	if noChange {
+		c.Infof("Workload %q is unchanged\n", workload.Name)
+		return nil
+	}
+
+	if currentWorkload == nil {
+		c.Emoji(cli.Magnifying, fmt.Sprintf("Workload %q does not exist, it would be created:\n", workload.Name))
+	} else {
+		c.Emoji(cli.Magnifying, fmt.Sprintf("Workload %q would be updated:\n", workload.Name))
+	}
+	c.Printf("%s", difference)
+
+	return cli.SilenceError(fmt.Errorf("workload %q differs from the cluster", workload.Name))
+}
+
+func NewWorkloadDiffCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &WorkloadDiffOptions{}
+	opts.LoadDefaults(c)
+
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Show the changes apply would make to a workload",
+		Long: strings.TrimSpace(`
+Show the differences between the workload in the cluster and the workload that would
+result from applying the provided configuration. Flags are layered on top of the
+configuration file the same way as in apply. Nothing is written to the cluster and
+local source code is not published.
+
+The command exits with a non-zero status when differences are found, so it can be
+used to detect drift between a configuration file and the cluster.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s workload diff %s workload.yaml", c.Name, flags.FilePathFlagName),
+			fmt.Sprintf("%s workload diff %s workload.yaml %s json", c.Name, flags.FilePathFlagName, flags.OutputFlagName),
+		}, "\n"),
+		PreRunE:           cli.ValidateE(ctx, opts),
+		RunE:              cli.ExecE(ctx, c, opts),
+		ValidArgsFunction: completion.SuggestWorkloadNames(ctx, c),
+	}
+
+	cli.Args(cmd,
+		cli.OptionalNameArg(&opts.Name),
+	)
+
+	opts.DefineSpecFlags(ctx, c, cmd)
+	cmd.Flags().StringVarP(&opts.FilePath, cli.StripDash(flags.FilePathFlagName), "f", "", "`file path` containing the description of a single workload, other flags are layered on top of this resource. Use value \"-\" to read from stdin")
+	cmd.MarkFlagFilename(cli.StripDash(flags.FilePathFlagName), ".yaml", ".yml")
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the changed fields formatted. Supported formats: \"json\", \"yaml\", \"yml\"")
+	cmd.Flags().StringVar(&opts.UpdateStrategy, cli.StripDash(flags.UpdateStrategyFlagName), mergeUpdateStrategy, fmt.Sprintf("specify configuration file update strategy (supported strategies: %s, %s)", mergeUpdateStrategy, replaceUpdateStrategy))
+	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.UpdateStrategyFlagName), func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{replaceUpdateStrategy, mergeUpdateStrategy}, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	// Bind flags to environment variables
+	opts.DefineEnvVars(ctx, c, cmd)
+
+	return cmd
+}
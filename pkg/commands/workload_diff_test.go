@@ -0,0 +1,267 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"context"
+	"testing"
+
+	diecorev1 "dies.dev/apis/core/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+func TestWorkloadDiffOptionsValidate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name: "valid options",
+			Validatable: &commands.WorkloadDiffOptions{
+				WorkloadOptions: commands.WorkloadOptions{
+					Namespace: "default",
+					Name:      "my-resource",
+					Env:       []string{"FOO=bar"},
+				},
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid output",
+			Validatable: &commands.WorkloadDiffOptions{
+				WorkloadOptions: commands.WorkloadOptions{
+					Namespace: "default",
+					Name:      "my-resource",
+					Output:    "table",
+				},
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("table", flags.OutputFlagName, []string{"json", "yaml", "yml"}),
+		},
+		{
+			Name: "update strategy without filepath",
+			Validatable: &commands.WorkloadDiffOptions{
+				WorkloadOptions: commands.WorkloadOptions{
+					Namespace: "default",
+					Name:      "my-resource",
+				},
+				UpdateStrategy: "replace",
+			},
+			Prepare: func(t *testing.T, ctx context.Context) (context.Context, error) {
+				cmd := commands.NewWorkloadDiffCommand(ctx, cli.NewDefaultConfig("test", scheme))
+				if err := cmd.Flags().Set(cli.StripDash(flags.UpdateStrategyFlagName), "replace"); err != nil {
+					return ctx, err
+				}
+				ctx = cli.WithCommand(ctx, cmd)
+				return ctx, nil
+			},
+			ExpectFieldErrors: validation.ErrMissingField(flags.FilePathFlagName),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestWorkloadDiffCommand(t *testing.T) {
+	defaultNamespace := "default"
+	workloadName := "spring-petclinic"
+	file := "testdata/workload.yaml"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	givenNamespaceDefault := diecorev1.NamespaceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(defaultNamespace)
+		})
+
+	parent := diecartov1alpha1.WorkloadBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(workloadName)
+			d.Namespace(defaultNamespace)
+			d.Labels(map[string]string{
+				apis.AppPartOfLabelName:    workloadName,
+				apis.WorkloadTypeLabelName: "web",
+			})
+		}).
+		SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+			d.Env(corev1.EnvVar{Name: "SPRING_PROFILES_ACTIVE", Value: "mysql"})
+			d.Resources(&corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("500m"),
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+				},
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("100m"),
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+				},
+			})
+			d.Source(&cartov1alpha1.Source{
+				Git: &cartov1alpha1.GitSource{
+					URL: "https://github.com/spring-projects/spring-petclinic.git",
+					Ref: cartov1alpha1.GitRef{
+						Branch: "main",
+					},
+				},
+			})
+		})
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "missing name",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name:         "file matches workload in cluster",
+			Args:         []string{flags.FilePathFlagName, file},
+			GivenObjects: []client.Object{parent},
+			ExpectOutput: `
+Workload "spring-petclinic" is unchanged
+`,
+		},
+		{
+			Name:         "flags layered on top of file",
+			Args:         []string{flags.FilePathFlagName, file, flags.EnvFlagName, "NAME=value", flags.ImageFlagName, "ubuntu:focal"},
+			GivenObjects: []client.Object{parent},
+			ShouldError:  true,
+			ExpectOutput: `
+🔎 Workload "spring-petclinic" would be updated:
+...
+ 10, 10   |spec:
+ 11, 11   |  env:
+ 12, 12   |  - name: SPRING_PROFILES_ACTIVE
+ 13, 13   |    value: mysql
+     14 + |  - name: NAME
+     15 + |    value: value
+     16 + |  image: ubuntu:focal
+ 14, 17   |  resources:
+ 15, 18   |    limits:
+ 16, 19   |      cpu: 500m
+ 17, 20   |      memory: 1Gi
+ 18, 21   |    requests:
+ 19, 22   |      cpu: 100m
+ 20, 23   |      memory: 1Gi
+ 21     - |  source:
+ 22     - |    git:
+ 23     - |      ref:
+ 24     - |        branch: main
+ 25     - |      url: https://github.com/spring-projects/spring-petclinic.git
+`,
+		},
+		{
+			Name:         "workload does not exist",
+			Args:         []string{flags.FilePathFlagName, file},
+			GivenObjects: []client.Object{givenNamespaceDefault},
+			ShouldError:  true,
+			ExpectOutput: `
+🔎 Workload "spring-petclinic" does not exist, it would be created:
+      1 + |---
+      2 + |apiVersion: carto.run/v1alpha1
+      3 + |kind: Workload
+      4 + |metadata:
+      5 + |  labels:
+      6 + |    app.kubernetes.io/part-of: spring-petclinic
+      7 + |    apps.tanzu.vmware.com/workload-type: web
+      8 + |  name: spring-petclinic
+      9 + |  namespace: default
+     10 + |spec:
+     11 + |  env:
+     12 + |  - name: SPRING_PROFILES_ACTIVE
+     13 + |    value: mysql
+     14 + |  resources:
+     15 + |    limits:
+     16 + |      cpu: 500m
+     17 + |      memory: 1Gi
+     18 + |    requests:
+     19 + |      cpu: 100m
+     20 + |      memory: 1Gi
+     21 + |  source:
+     22 + |    git:
+     23 + |      ref:
+     24 + |        branch: main
+     25 + |      url: https://github.com/spring-projects/spring-petclinic.git
+`,
+		},
+		{
+			Name:        "namespace does not exist",
+			Args:        []string{flags.FilePathFlagName, file},
+			ShouldError: true,
+			ExpectOutput: `
+Error: namespace "default" not found, it may not exist or user does not have permissions to read it.
+`,
+		},
+		{
+			Name:         "output changed fields as json",
+			Args:         []string{flags.FilePathFlagName, file, flags.EnvFlagName, "SPRING_PROFILES_ACTIVE-", flags.LabelFlagName, "foo=bar", flags.OutputFlagName, "json"},
+			GivenObjects: []client.Object{parent},
+			ShouldError:  true,
+			ExpectOutput: `
+{
+	"name": "spring-petclinic",
+	"namespace": "default",
+	"exists": true,
+	"changes": [
+		{
+			"path": "metadata.labels.foo",
+			"operation": "added"
+		},
+		{
+			"path": "spec.env",
+			"operation": "removed"
+		}
+	]
+}
+`,
+		},
+		{
+			Name:         "output unchanged workload as yaml",
+			Args:         []string{flags.FilePathFlagName, file, flags.OutputFlagName, "yaml"},
+			GivenObjects: []client.Object{parent},
+			ExpectOutput: `
+---
+changes: []
+exists: true
+name: spring-petclinic
+namespace: default
+`,
+		},
+		{
+			Name:         "get failed",
+			Args:         []string{flags.FilePathFlagName, file},
+			GivenObjects: []client.Object{parent},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "Workload"),
+			},
+			ShouldError: true,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewWorkloadDiffCommand)
+}
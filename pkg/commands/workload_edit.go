@@ -0,0 +1,330 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/wait"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+const workloadEditHeader = `# Please edit the workload below. Lines beginning with a '#' at the top of the file
+# will be ignored, and an empty file will abort the edit. If an error occurs while
+# saving, this file will be reopened with the relevant failures.
+#
+`
+
+type WorkloadEditOptions struct {
+	Namespace string
+	Name      string
+
+	Yes            bool
+	Wait           bool
+	WaitTimeout    time.Duration
+	Tail           bool
+	TailTimestamps bool
+	DelayTime      time.Duration
+}
+
+var (
+	_ validation.Validatable = (*WorkloadEditOptions)(nil)
+	_ cli.Executable         = (*WorkloadEditOptions)(nil)
+)
+
+func (opts *WorkloadEditOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	if opts.Namespace == "" {
+		errs = errs.Also(validation.ErrMissingField(flags.NamespaceFlagName))
+	}
+
+	if opts.Name == "" {
+		errs = errs.Also(validation.ErrMissingField(cli.NameArgumentName))
+	}
+
+	return errs
+}
+
+func (opts *WorkloadEditOptions) Exec(ctx context.Context, c *cli.Config) error {
+	currentWorkload, err := getWorkload(ctx, c, opts.Namespace, opts.Name)
+	if err != nil {
+		return err
+	}
+
+	original, err := printer.ExportResource(currentWorkload, printer.OutputFormat(printer.OutputFormatYaml), c.Scheme)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp("", fmt.Sprintf("%s-*.yaml", currentWorkload.Name))
+	if err != nil {
+		return err
+	}
+	file.Close()
+	defer os.Remove(file.Name())
+
+	var workload *cartov1alpha1.Workload
+	content := original
+	var editErr error
+	for {
+		header := workloadEditHeader
+		if editErr != nil {
+			header += fmt.Sprintf("# workload %q is invalid:\n", currentWorkload.Name)
+			errs := []error{editErr}
+			if agg, ok := editErr.(utilerrors.Aggregate); ok {
+				errs = agg.Errors()
+			}
+			for _, e := range errs {
+				header += fmt.Sprintf("# * %s\n", e)
+			}
+			header += "#\n"
+		}
+		if err := os.WriteFile(file.Name(), []byte(header+content), 0600); err != nil {
+			return err
+		}
+		if err := opts.runEditor(ctx, c, file.Name()); err != nil {
+			return err
+		}
+		raw, err := os.ReadFile(file.Name())
+		if err != nil {
+			return err
+		}
+		edited := stripEditHeader(string(raw))
+
+		if strings.TrimSpace(edited) == "" {
+			c.Infof("Edit cancelled, saved file was empty\n")
+			return nil
+		}
+		if edited == original {
+			c.Infof("Edit cancelled, no changes made\n")
+			return nil
+		}
+		if editErr != nil && edited == content {
+			// the file was saved without fixing the reported errors, give up instead of reopening it forever
+			return fmt.Errorf("edit cancelled, no valid changes were saved: %w", editErr)
+		}
+		content = edited
+
+		workload, editErr = loadEditedWorkload(currentWorkload, content)
+		if editErr == nil {
+			break
+		}
+	}
+
+	updateOpts := &WorkloadOptions{
+		Namespace: opts.Namespace,
+		Name:      opts.Name,
+		Yes:       opts.Yes,
+	}
+	if err := updateOpts.checkSupplyChainParams(ctx, c, workload, true); err != nil {
+		return err
+	}
+	okToUpdate, err := updateOpts.Update(ctx, c, currentWorkload, workload)
+	if err != nil {
+		return err
+	}
+	if !okToUpdate {
+		return nil
+	}
+	c.Printf("\n")
+	DisplayCommandNextSteps(c, workload)
+	c.Printf("\n")
+
+	anyTail := opts.Tail || opts.TailTimestamps
+	if opts.Wait || anyTail {
+		c.Infof("Waiting for workload %q to become ready...\n", workload.Name)
+
+		statusChangeWorkers := []wait.Worker{getStatusChangeWorker(c, currentWorkload)}
+		if err := raceWithTimeout(ctx, c, workload, opts.WaitTimeout, true, waitErrorForStatusChange, statusChangeWorkers); err != nil {
+			return cli.SilenceError(err)
+		}
+
+		workers := []wait.Worker{getReadyConditionWorker(c, workload, opts.DelayTime)}
+		if anyTail {
+			workers = append(workers, getTailWorker(c, workload, opts.TailTimestamps))
+		}
+		if err := raceWithTimeout(ctx, c, workload, opts.WaitTimeout, true, waitErrorForReadyCondition, workers); err != nil {
+			return cli.SilenceError(err)
+		}
+		c.Infof("Workload %q is ready\n\n", workload.Name)
+	}
+
+	return nil
+}
+
+// runEditor opens the file in the editor defined by $VISUAL or $EDITOR, falling back to
+// the default editor of the platform
+func (opts *WorkloadEditOptions) runEditor(ctx context.Context, c *cli.Config, path string) error {
+	editor := []string{"vi"}
+	if runtime.GOOS == "windows" {
+		editor = []string{"notepad"}
+	}
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if value := strings.Fields(os.Getenv(env)); len(value) != 0 {
+			editor = value
+			break
+		}
+	}
+
+	cmd := c.Exec(ctx, editor[0], append(editor[1:], path)...)
+	cmd.Stdin = c.Stdin
+	cmd.Stdout = c.Stdout
+	cmd.Stderr = c.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("unable to run editor %q: %w", strings.Join(editor, " "), err)
+	}
+	return nil
+}
+
+// stripEditHeader removes the comment lines added at the top of the edited file
+func stripEditHeader(content string) string {
+	for strings.HasPrefix(content, "#") {
+		i := strings.Index(content, "\n")
+		if i < 0 {
+			return ""
+		}
+		content = content[i+1:]
+	}
+	return content
+}
+
+// loadEditedWorkload parses and validates the edited workload, returning the current
+// workload with the labels, annotations and spec taken from the edited one
+func loadEditedWorkload(currentWorkload *cartov1alpha1.Workload, content string) (*cartov1alpha1.Workload, error) {
+	edited := &cartov1alpha1.Workload{}
+	if err := edited.Load(strings.NewReader(content)); err != nil {
+		return nil, err
+	}
+
+	errs := validation.FieldErrors{}
+	if edited.Name != currentWorkload.Name {
+		errs = errs.Also(validation.ErrForbiddenFieldWithDetail("metadata.name", "the name of the workload cannot be changed"))
+	}
+	if edited.Namespace != currentWorkload.Namespace {
+		errs = errs.Also(validation.ErrForbiddenFieldWithDetail("metadata.namespace", "the namespace of the workload cannot be changed"))
+	}
+	errs = errs.Also(edited.Validate())
+	errs = errs.Also(validateWorkloadSpecFields(&edited.Spec))
+	if err := errs.ToAggregate(); err != nil {
+		return nil, err
+	}
+
+	workload := currentWorkload.DeepCopy()
+	workload.Labels = edited.Labels
+	workload.Annotations = edited.Annotations
+	workload.Spec = edited.Spec
+	return workload, nil
+}
+
+// validateWorkloadSpecFields applies to the spec the same rules that are used to validate
+// the values of the env, resource and service ref flags
+func validateWorkloadSpecFields(spec *cartov1alpha1.WorkloadSpec) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	errs = errs.Also(validation.EnvVars(envVarFlags(spec.Env), "spec.env"))
+	if spec.Build != nil {
+		errs = errs.Also(validation.EnvVars(envVarFlags(spec.Build.Env), "spec.build.env"))
+	}
+
+	if spec.Resources != nil {
+		for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+			limit, hasLimit := spec.Resources.Limits[name]
+			request, hasRequest := spec.Resources.Requests[name]
+			if hasLimit && hasRequest {
+				errs = errs.Also(validation.CompareQuantity(limit.String(), request.String(), fmt.Sprintf("spec.resources.requests.%s", name)))
+			}
+		}
+	}
+
+	for i, claim := range spec.ServiceClaims {
+		errs = errs.Also(validation.K8sName(claim.Name, "name").ViaFieldIndex("spec.serviceClaims", i))
+		if claim.Ref == nil {
+			errs = errs.Also(validation.ErrMissingField("ref").ViaFieldIndex("spec.serviceClaims", i))
+			continue
+		}
+		ref := fmt.Sprintf("%s:%s:%s", claim.Ref.APIVersion, claim.Ref.Kind, claim.Ref.Name)
+		errs = errs.Also(validation.ObjectReference(ref, "ref").ViaFieldIndex("spec.serviceClaims", i))
+	}
+
+	return errs
+}
+
+// envVarFlags formats the env vars the way they are set with the env flags, so they are checked
+// with the same rules as the flag values
+func envVarFlags(envs []corev1.EnvVar) []string {
+	values := make([]string, 0, len(envs))
+	for _, env := range envs {
+		values = append(values, fmt.Sprintf("%s=%s", env.Name, env.Value))
+	}
+	return values
+}
+
+func NewWorkloadEditCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &WorkloadEditOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "edit",
+		Short: "Edit a workload in an editor",
+		Long: strings.TrimSpace(`
+Edit a workload in the cluster with the editor defined by the VISUAL or EDITOR
+environment variables, falling back to vi (notepad on Windows).
+
+The workload is opened without its status and system managed fields. When the file is
+saved and closed, the workload is validated; if it is not valid, the file is reopened
+with the errors at the top. Valid changes are shown and applied to the workload the
+same way as an update.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s workload edit my-workload", c.Name),
+			fmt.Sprintf("%s workload edit my-workload %s", c.Name, flags.WaitFlagName),
+		}, "\n"),
+		PreRunE:           cli.ValidateE(ctx, opts),
+		RunE:              cli.ExecE(ctx, c, opts),
+		ValidArgsFunction: completion.SuggestWorkloadNames(ctx, c),
+	}
+
+	cli.Args(cmd,
+		cli.NameArg(&opts.Name),
+	)
+
+	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
+	cmd.Flags().BoolVarP(&opts.Yes, cli.StripDash(flags.YesFlagName), "y", false, "accept all prompts")
+	cmd.Flags().BoolVar(&opts.Wait, cli.StripDash(flags.WaitFlagName), false, "waits for workload to become ready")
+	cmd.Flags().DurationVar(&opts.WaitTimeout, cli.StripDash(flags.WaitTimeoutFlagName), 10*time.Minute, "timeout for workload to become ready when waiting")
+	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.WaitTimeoutFlagName), completion.SuggestDurationUnits(ctx, completion.CommonDurationUnits))
+	cmd.Flags().BoolVar(&opts.Tail, cli.StripDash(flags.TailFlagName), false, "show logs while waiting for workload to become ready")
+	cmd.Flags().BoolVar(&opts.TailTimestamps, cli.StripDash(flags.TailTimestampFlagName), false, "show logs and add timestamp to each log line while waiting for workload to become ready")
+	cmd.Flags().DurationVar(&opts.DelayTime, cli.StripDash(flags.DelayTimeFlagName), 30*time.Second, "delay set to prevent premature exit before supply chain step completion when waiting/tailing")
+	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.DelayTimeFlagName), completion.SuggestDurationUnits(ctx, completion.CommonDurationUnits))
+
+	return cmd
+}
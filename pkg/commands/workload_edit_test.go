@@ -0,0 +1,301 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	diecorev1 "dies.dev/apis/core/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+func TestWorkloadEditOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:        "empty",
+			Validatable: &commands.WorkloadEditOptions{},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMissingField(flags.NamespaceFlagName),
+				validation.ErrMissingField(cli.NameArgumentName),
+			),
+		},
+		{
+			Name: "valid",
+			Validatable: &commands.WorkloadEditOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+			},
+			ShouldValidate: true,
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestWorkloadEditCommand(t *testing.T) {
+	defaultNamespace := "default"
+	workloadName := "my-workload"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	givenNamespaceDefault := diecorev1.NamespaceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(defaultNamespace)
+		})
+
+	parent := diecartov1alpha1.WorkloadBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(workloadName)
+			d.Namespace(defaultNamespace)
+		}).
+		SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+			d.Image("registry.example/my-workload:v1")
+			d.Env(corev1.EnvVar{Name: "FOO", Value: "bar"})
+		})
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "invalid args",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name:         "workload not found",
+			Args:         []string{workloadName},
+			GivenObjects: []client.Object{givenNamespaceDefault},
+			ShouldError:  true,
+			ExpectOutput: `
+Workload "default/my-workload" not found
+`,
+		},
+		{
+			Name:         "no changes",
+			Args:         []string{workloadName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent},
+			ExecHelper:   "EditWorkloadUnchanged",
+			ExpectOutput: `
+Edit cancelled, no changes made
+`,
+		},
+		{
+			Name:         "empty file",
+			Args:         []string{workloadName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent},
+			ExecHelper:   "EditWorkloadEmpty",
+			ExpectOutput: `
+Edit cancelled, saved file was empty
+`,
+		},
+		{
+			Name:         "update workload",
+			Args:         []string{workloadName, flags.YesFlagName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent},
+			ExecHelper:   "EditWorkloadEnv",
+			ExpectUpdates: []client.Object{
+				parent.
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Env(corev1.EnvVar{Name: "FOO", Value: "baz"})
+					}),
+			},
+			ExpectOutput: `
+🔎 Update workload:
+...
+  6,  6   |  namespace: default
+  7,  7   |spec:
+  8,  8   |  env:
+  9,  9   |  - name: FOO
+ 10     - |    value: bar
+     10 + |    value: baz
+ 11, 11   |  image: registry.example/my-workload:v1
+👍 Updated workload "my-workload"
+
+To see logs:   "tanzu apps workload tail my-workload --timestamp --since 1h"
+To get status: "tanzu apps workload get my-workload"
+
+`,
+		},
+		{
+			Name:         "reopen invalid workload until it is fixed",
+			Args:         []string{workloadName, flags.YesFlagName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent},
+			ExecHelper:   "EditWorkloadInvalidThenValid",
+			ExpectUpdates: []client.Object{
+				parent.
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Env(corev1.EnvVar{Name: "FOO", Value: "baz"})
+					}),
+			},
+			ExpectOutput: `
+🔎 Update workload:
+...
+  6,  6   |  namespace: default
+  7,  7   |spec:
+  8,  8   |  env:
+  9,  9   |  - name: FOO
+ 10     - |    value: bar
+     10 + |    value: baz
+ 11, 11   |  image: registry.example/my-workload:v1
+👍 Updated workload "my-workload"
+
+To see logs:   "tanzu apps workload tail my-workload --timestamp --since 1h"
+To get status: "tanzu apps workload get my-workload"
+
+`,
+		},
+		{
+			Name:         "invalid workload saved without fixes",
+			Args:         []string{workloadName, flags.YesFlagName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent},
+			ExecHelper:   "EditWorkloadInvalid",
+			ShouldError:  true,
+			ExpectOutput: `
+`,
+		},
+		{
+			Name:         "invalid build env saved without fixes",
+			Args:         []string{workloadName, flags.YesFlagName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent},
+			ExecHelper:   "EditWorkloadInvalidBuildEnv",
+			ShouldError:  true,
+			ExpectOutput: `
+`,
+		},
+		{
+			Name:         "editor fails",
+			Args:         []string{workloadName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent},
+			ExecHelper:   "EditWorkloadFail",
+			ShouldError:  true,
+		},
+		{
+			Name:         "update failed",
+			Args:         []string{workloadName, flags.YesFlagName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent},
+			ExecHelper:   "EditWorkloadEnv",
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("update", "Workload"),
+			},
+			ExpectUpdates: []client.Object{
+				parent.
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Env(corev1.EnvVar{Name: "FOO", Value: "baz"})
+					}),
+			},
+			ShouldError: true,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewWorkloadEditCommand)
+}
+
+// editWorkloadFile rewrites the file received by the fake editor
+func editWorkloadFile(edit func(content string) string) {
+	path := os.Args[len(os.Args)-1]
+	content, err := os.ReadFile(path)
+	if err != nil {
+		os.Exit(1)
+	}
+	if err := os.WriteFile(path, []byte(edit(string(content))), 0600); err != nil {
+		os.Exit(1)
+	}
+}
+
+func TestHelperProcess_EditWorkloadUnchanged(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") != "1" {
+		return
+	}
+	os.Exit(0)
+}
+
+func TestHelperProcess_EditWorkloadEmpty(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") != "1" {
+		return
+	}
+	editWorkloadFile(func(content string) string {
+		return ""
+	})
+	os.Exit(0)
+}
+
+func TestHelperProcess_EditWorkloadEnv(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") != "1" {
+		return
+	}
+	editWorkloadFile(func(content string) string {
+		return strings.Replace(content, "value: bar", "value: baz", 1)
+	})
+	os.Exit(0)
+}
+
+func TestHelperProcess_EditWorkloadInvalid(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") != "1" {
+		return
+	}
+	editWorkloadFile(func(content string) string {
+		return strings.Replace(content, "name: FOO", `name: ""`, 1)
+	})
+	os.Exit(0)
+}
+
+func TestHelperProcess_EditWorkloadInvalidBuildEnv(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") != "1" {
+		return
+	}
+	editWorkloadFile(func(content string) string {
+		if strings.Contains(content, "build:") {
+			return content
+		}
+		return strings.Replace(content, "spec:\n", "spec:\n  build:\n    env:\n    - value: bar\n", 1)
+	})
+	os.Exit(0)
+}
+
+func TestHelperProcess_EditWorkloadInvalidThenValid(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") != "1" {
+		return
+	}
+	editWorkloadFile(func(content string) string {
+		if !strings.Contains(content, "is invalid") {
+			return strings.Replace(content, "name: FOO", `name: ""`, 1)
+		}
+		content = strings.Replace(content, `name: ""`, "name: FOO", 1)
+		return strings.Replace(content, "value: bar", "value: baz", 1)
+	})
+	os.Exit(0)
+}
+
+func TestHelperProcess_EditWorkloadFail(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") != "1" {
+		return
+	}
+	os.Exit(1)
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	apiwatch "k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	cliprinter "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/watch"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+type WorkloadEventsOptions struct {
+	Namespace string
+	Name      string
+
+	Watch  bool
+	Output string
+}
+
+var (
+	_ validation.Validatable = (*WorkloadEventsOptions)(nil)
+	_ cli.Executable         = (*WorkloadEventsOptions)(nil)
+)
+
+// eventObjectKey identifies an object that events can refer to
+type eventObjectKey struct {
+	Namespace string
+	Kind      string
+	Name      string
+}
+
+func (opts *WorkloadEventsOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	if opts.Namespace == "" {
+		errs = errs.Also(validation.ErrMissingField(flags.NamespaceFlagName))
+	}
+
+	if opts.Name == "" {
+		errs = errs.Also(validation.ErrMissingField(cli.NameArgumentName))
+	}
+
+	if opts.Output != "" {
+		errs = errs.Also(validation.Enum(opts.Output, flags.OutputFlagName, []string{printer.OutputFormatJson, printer.OutputFormatYaml, printer.OutputFormatYml}))
+	}
+
+	return errs
+}
+
+func (opts *WorkloadEventsOptions) Exec(ctx context.Context, c *cli.Config) error {
+	workload, err := getWorkload(ctx, c, opts.Namespace, opts.Name)
+	if err != nil {
+		return err
+	}
+
+	objects, namespaces := workloadRelatedObjects(ctx, c, workload)
+
+	events := &corev1.EventList{}
+	resourceVersions := map[string]string{}
+	for _, namespace := range namespaces {
+		list := &corev1.EventList{}
+		if err := c.List(ctx, list, client.InNamespace(namespace)); err != nil {
+			return err
+		}
+		resourceVersions[namespace] = list.ResourceVersion
+		for _, event := range list.Items {
+			if objects[eventObjectKeyFor(&event)] {
+				events.Items = append(events.Items, event)
+			}
+		}
+	}
+	sort.SliceStable(events.Items, func(i, j int) bool {
+		return printer.EventTimestamp(&events.Items[i]).Before(printer.EventTimestamp(&events.Items[j]))
+	})
+
+	if opts.Output != "" {
+		if err := opts.outputEvents(c, events); err != nil {
+			return err
+		}
+	} else if len(events.Items) == 0 {
+		c.Infof("No events found for workload %q.\n", workload.Name)
+	} else if err := printer.WorkloadEventsPrinter(c.Stdout, events, false); err != nil {
+		return err
+	}
+
+	if opts.Watch {
+		return opts.watchEvents(ctx, c, objects, namespaces, resourceVersions)
+	}
+	return nil
+}
+
+// watchEvents prints the events related to the workload as they are received, until the
+// command is interrupted
+func (opts *WorkloadEventsOptions) watchEvents(ctx context.Context, c *cli.Config, objects map[eventObjectKey]bool, namespaces []string, resourceVersions map[string]string) error {
+	clientWithWatch, err := watch.GetWatcher(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	received := make(chan apiwatch.Event)
+	for _, namespace := range namespaces {
+		watcher, err := clientWithWatch.Watch(ctx, &corev1.EventList{}, client.InNamespace(namespace), &client.ListOptions{
+			Raw: &metav1.ListOptions{ResourceVersion: resourceVersions[namespace]},
+		})
+		if err != nil {
+			return err
+		}
+		defer watcher.Stop()
+		go func() {
+			for event := range watcher.ResultChan() {
+				select {
+				case received <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e := <-received:
+			event, ok := e.Object.(*corev1.Event)
+			if !ok || e.Type == apiwatch.Deleted || !objects[eventObjectKeyFor(event)] {
+				continue
+			}
+			events := &corev1.EventList{Items: []corev1.Event{*event}}
+			if opts.Output != "" {
+				if err := opts.outputEvents(c, events); err != nil {
+					return err
+				}
+			} else if err := printer.WorkloadEventsPrinter(c.Stdout, events, true); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (opts *WorkloadEventsOptions) outputEvents(c *cli.Config, events *corev1.EventList) error {
+	list := []cliprinter.Object{}
+	for i := range events.Items {
+		list = append(list, &events.Items[i])
+	}
+	export, err := cliprinter.OutputResources(list, printer.OutputFormat(opts.Output), c.Scheme)
+	if err != nil {
+		c.Eprintf("%s %s\n", printer.Serrorf("Failed to output events:"), err)
+		return cli.SilenceError(err)
+	}
+	c.Printf("%s\n", export)
+	return nil
+}
+
+// workloadRelatedObjects returns the workload, the resources stamped by its supply chain and
+// the resources stamped for its deliverable, along with the namespaces they are in
+func workloadRelatedObjects(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload) (map[eventObjectKey]bool, []string) {
+	objects := map[eventObjectKey]bool{}
+	namespaces := []string{}
+	add := func(namespace, kind, name string) {
+		if namespace == "" {
+			namespace = workload.Namespace
+		}
+		objects[eventObjectKey{Namespace: namespace, Kind: kind, Name: name}] = true
+		for _, n := range namespaces {
+			if n == namespace {
+				return
+			}
+		}
+		namespaces = append(namespaces, namespace)
+	}
+
+	add(workload.Namespace, cartov1alpha1.WorkloadKind, workload.Name)
+	for _, resource := range workload.Status.Resources {
+		if resource.StampedRef != nil && resource.StampedRef.ObjectReference != nil {
+			add(resource.StampedRef.Namespace, resource.StampedRef.Kind, resource.StampedRef.Name)
+		}
+	}
+
+	if wldDeliverable := getWorkloadResourceByKind(workload, cartov1alpha1.DeliverableKind); wldDeliverable != nil {
+		deliverable := &cartov1alpha1.Deliverable{}
+		key := types.NamespacedName{Namespace: wldDeliverable.StampedRef.Namespace, Name: wldDeliverable.StampedRef.Name}
+		if key.Namespace == "" {
+			key.Namespace = workload.Namespace
+		}
+		if err := c.Get(ctx, key, deliverable); err == nil {
+			for _, resource := range deliverable.Status.Resources {
+				if resource.StampedRef != nil && resource.StampedRef.ObjectReference != nil {
+					add(resource.StampedRef.Namespace, resource.StampedRef.Kind, resource.StampedRef.Name)
+				}
+			}
+		}
+	}
+
+	return objects, namespaces
+}
+
+func eventObjectKeyFor(event *corev1.Event) eventObjectKey {
+	namespace := event.InvolvedObject.Namespace
+	if namespace == "" {
+		namespace = event.Namespace
+	}
+	return eventObjectKey{Namespace: namespace, Kind: event.InvolvedObject.Kind, Name: event.InvolvedObject.Name}
+}
+
+func NewWorkloadEventsCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &WorkloadEventsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "events",
+		Short: "Show the events of a workload and its resources",
+		Long: strings.TrimSpace(`
+Show the Kubernetes events related to a workload, the resources stamped by its supply
+chain and the resources stamped for its deliverable, merged in a single list ordered
+by time.
+
+Use ` + flags.WatchFlagName + ` to keep receiving new events until the command is interrupted.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s workload events my-workload", c.Name),
+			fmt.Sprintf("%s workload events my-workload %s", c.Name, flags.WatchFlagName),
+		}, "\n"),
+		PreRunE:           cli.ValidateE(ctx, opts),
+		RunE:              cli.ExecE(ctx, c, opts),
+		ValidArgsFunction: completion.SuggestWorkloadNames(ctx, c),
+	}
+
+	cli.Args(cmd,
+		cli.NameArg(&opts.Name),
+	)
+
+	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
+	cmd.Flags().BoolVarP(&opts.Watch, cli.StripDash(flags.WatchFlagName), "w", false, "watch for new events after listing the current ones")
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the events formatted. Supported formats: \"json\", \"yaml\", \"yml\"")
+
+	return cmd
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	diecorev1 "dies.dev/apis/core/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	watchhelper "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/watch"
+	watchfakes "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/watch/fake"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+func TestWorkloadEventsOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:        "empty",
+			Validatable: &commands.WorkloadEventsOptions{},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMissingField(flags.NamespaceFlagName),
+				validation.ErrMissingField(cli.NameArgumentName),
+			),
+		},
+		{
+			Name: "valid",
+			Validatable: &commands.WorkloadEventsOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Watch:     true,
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid output",
+			Validatable: &commands.WorkloadEventsOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Output:    "table",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("table", flags.OutputFlagName, []string{"json", "yaml", "yml"}),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestWorkloadEventsCommand(t *testing.T) {
+	defaultNamespace := "default"
+	deliveryNamespace := "delivery"
+	workloadName := "my-workload"
+	now := time.Now()
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	givenNamespaceDefault := diecorev1.NamespaceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(defaultNamespace)
+		})
+
+	parent := diecartov1alpha1.WorkloadBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(workloadName)
+			d.Namespace(defaultNamespace)
+		}).
+		StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+			d.Resources(
+				diecartov1alpha1.RealizedResourceBlank.
+					Name("image-provider").
+					StampedRef(&cartov1alpha1.StampedRef{
+						ObjectReference: &corev1.ObjectReference{Kind: "Image", Namespace: defaultNamespace, Name: workloadName},
+					}).
+					DieRelease(),
+				diecartov1alpha1.RealizedResourceBlank.
+					Name("deliverable").
+					StampedRef(&cartov1alpha1.StampedRef{
+						ObjectReference: &corev1.ObjectReference{Kind: cartov1alpha1.DeliverableKind, Namespace: defaultNamespace, Name: workloadName},
+					}).
+					DieRelease(),
+			)
+		})
+	deliverable := diecartov1alpha1.DeliverableBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(workloadName)
+			d.Namespace(defaultNamespace)
+		}).
+		StatusDie(func(d *diecartov1alpha1.DeliverableStatusDie) {
+			d.Resources(
+				diecartov1alpha1.RealizedResourceBlank.
+					Name("app-deploy").
+					StampedRef(&cartov1alpha1.StampedRef{
+						ObjectReference: &corev1.ObjectReference{Kind: "App", Namespace: deliveryNamespace, Name: workloadName},
+					}).
+					DieRelease(),
+			)
+		})
+
+	event := func(namespace, name, kind, objectName, eventType, reason, message string, timestamp time.Time) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+			},
+			InvolvedObject: corev1.ObjectReference{Kind: kind, Namespace: namespace, Name: objectName},
+			Type:           eventType,
+			Reason:         reason,
+			Message:        message,
+			LastTimestamp:  metav1.NewTime(timestamp),
+		}
+	}
+	workloadEvent := event(defaultNamespace, "workload-event", cartov1alpha1.WorkloadKind, workloadName, corev1.EventTypeNormal, "StampedObjectApplied", "Created object [images.kpack.io/my-workload]", now.Add(-10*time.Minute))
+	imageEvent := event(defaultNamespace, "image-event", "Image", workloadName, corev1.EventTypeWarning, "BuildFailed", "build my-workload-build-1 failed", now.Add(-5*time.Minute))
+	appEvent := event(deliveryNamespace, "app-event", "App", workloadName, corev1.EventTypeNormal, "Deployed", "App deployed", now.Add(-8*time.Minute))
+	otherEvent := event(defaultNamespace, "other-event", cartov1alpha1.WorkloadKind, "other-workload", corev1.EventTypeNormal, "StampedObjectApplied", "Created object", now.Add(-1*time.Minute))
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "invalid args",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name:         "workload not found",
+			Args:         []string{workloadName},
+			GivenObjects: []client.Object{givenNamespaceDefault},
+			ShouldError:  true,
+			ExpectOutput: `
+Workload "default/my-workload" not found
+`,
+		},
+		{
+			Name:         "events of the workload and its resources",
+			Args:         []string{workloadName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent, deliverable, workloadEvent, imageEvent, appEvent, otherEvent},
+			ExpectOutput: `
+   AGE   TYPE      REASON                 OBJECT                 MESSAGE
+   10m   Normal    StampedObjectApplied   workload/my-workload   Created object [images.kpack.io/my-workload]
+   8m    Normal    Deployed               app/my-workload        App deployed
+   5m    Warning   BuildFailed            image/my-workload      build my-workload-build-1 failed
+`,
+		},
+		{
+			Name:         "no events",
+			Args:         []string{workloadName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent, otherEvent},
+			ExpectOutput: `
+No events found for workload "my-workload".
+`,
+		},
+		{
+			Name: "output events as yaml",
+			Args: []string{workloadName, flags.OutputFlagName, "yaml"},
+			GivenObjects: []client.Object{
+				givenNamespaceDefault,
+				parent,
+				event(defaultNamespace, "workload-event", cartov1alpha1.WorkloadKind, workloadName, corev1.EventTypeNormal, "StampedObjectApplied", "Created object [images.kpack.io/my-workload]", time.Date(2023, time.May, 4, 10, 30, 0, 0, time.UTC)),
+			},
+			ExpectOutput: `
+---
+- apiVersion: v1
+  eventTime: null
+  firstTimestamp: null
+  involvedObject:
+    kind: Workload
+    name: my-workload
+    namespace: default
+  kind: Event
+  lastTimestamp: "2023-05-04T10:30:00Z"
+  message: Created object [images.kpack.io/my-workload]
+  metadata:
+    creationTimestamp: "1970-01-01T00:00:01Z"
+    name: workload-event
+    namespace: default
+    resourceVersion: "999"
+  reason: StampedObjectApplied
+  reportingComponent: ""
+  reportingInstance: ""
+  source: {}
+  type: Normal
+`,
+		},
+		{
+			Name:         "list events failed",
+			Args:         []string{workloadName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("list", "EventList"),
+			},
+			ShouldError: true,
+		},
+		{
+			Name:         "watch new events",
+			Args:         []string{workloadName, flags.WatchFlagName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent, workloadEvent},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				fakeWatcher := watchfakes.NewFakeWithWatch(false, config.Client, []watch.Event{
+					{Type: watch.Added, Object: otherEvent},
+					{Type: watch.Added, Object: imageEvent},
+				})
+				ctx = watchhelper.WithWatcher(ctx, fakeWatcher)
+				ctx, cancel := context.WithCancel(ctx)
+				time.AfterFunc(100*time.Millisecond, cancel)
+				return ctx, nil
+			},
+			ExpectOutput: `
+   AGE   TYPE     REASON                 OBJECT                 MESSAGE
+   10m   Normal   StampedObjectApplied   workload/my-workload   Created object [images.kpack.io/my-workload]
+   5m    Warning   BuildFailed   image/my-workload   build my-workload-build-1 failed
+`,
+		},
+		{
+			Name:         "watcher error",
+			Args:         []string{workloadName, flags.WatchFlagName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				fakeWatcher := watchfakes.NewFakeWithWatch(true, config.Client, []watch.Event{})
+				ctx = watchhelper.WithWatcher(ctx, fakeWatcher)
+				return ctx, nil
+			},
+			ShouldError: true,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewWorkloadEventsCommand)
+}
@@ -0,0 +1,296 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+// exportedMetadataPrefixes are the prefixes of the labels and annotations that only make sense in
+// the build cluster and are not exported with the deliverable
+var exportedMetadataPrefixes = []string{"kapp.k14s.io/", "kubectl.kubernetes.io/"}
+
+// exportedMetadataKeys are the labels Cartographer stamps on the deliverable that point to objects
+// in the build cluster. The workload name label is kept so the deliverable can be traced back
+var exportedMetadataKeys = []string{
+	"carto.run/supply-chain-name",
+	"carto.run/resource-name",
+	"carto.run/template-kind",
+	"carto.run/cluster-template-name",
+	"carto.run/workload-namespace",
+}
+
+type WorkloadExportDeliverableOptions struct {
+	Namespace string
+	Name      string
+
+	Output         string
+	ToContext      string
+	ToNamespace    string
+	ServiceAccount string
+}
+
+var (
+	_ validation.Validatable = (*WorkloadExportDeliverableOptions)(nil)
+	_ cli.Executable         = (*WorkloadExportDeliverableOptions)(nil)
+)
+
+func (opts *WorkloadExportDeliverableOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	if opts.Namespace == "" {
+		errs = errs.Also(validation.ErrMissingField(flags.NamespaceFlagName))
+	}
+
+	if opts.Name == "" {
+		errs = errs.Also(validation.ErrMissingField(cli.NameArgumentName))
+	} else {
+		errs = errs.Also(validation.K8sName(opts.Name, cli.NameArgumentName))
+	}
+
+	if opts.Output != "" {
+		errs = errs.Also(validation.Enum(opts.Output, flags.OutputFlagName, []string{printer.OutputFormatJson, printer.OutputFormatYaml, printer.OutputFormatYml}))
+		if opts.ToContext != "" {
+			errs = errs.Also(validation.ErrMultipleOneOf(flags.OutputFlagName, flags.ToContextFlagName))
+		}
+	}
+
+	if opts.ToNamespace != "" {
+		errs = errs.Also(validation.K8sName(opts.ToNamespace, flags.ToNamespaceFlagName))
+	}
+
+	if opts.ServiceAccount != "" {
+		errs = errs.Also(validation.K8sName(opts.ServiceAccount, flags.ServiceAccountFlagName))
+	}
+
+	return errs
+}
+
+func (opts *WorkloadExportDeliverableOptions) Exec(ctx context.Context, c *cli.Config) error {
+	workload := &cartov1alpha1.Workload{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: opts.Namespace, Name: opts.Name}, workload); err != nil {
+		if apierrs.IsNotFound(err) {
+			c.Errorf("Workload %q not found\n", fmt.Sprintf("%s/%s", opts.Namespace, opts.Name))
+			return cli.SilenceError(err)
+		}
+		return err
+	}
+
+	deliverable, err := getWorkloadStampedDeliverable(ctx, c, workload)
+	if err != nil {
+		return err
+	}
+	if deliverable == nil {
+		c.Errorf("No deliverable found in the resources of workload %q\n", fmt.Sprintf("%s/%s", opts.Namespace, opts.Name))
+		return cli.SilenceError(fmt.Errorf("no deliverable found for workload %q", opts.Name))
+	}
+	deliverable = opts.exportable(deliverable)
+
+	if opts.ToContext != "" {
+		return opts.apply(ctx, c, deliverable)
+	}
+
+	format := printer.OutputFormat(printer.OutputFormatYaml)
+	if opts.Output != "" {
+		format = printer.OutputFormat(opts.Output)
+	}
+	export, err := printer.ExportResource(deliverable, format, c.Scheme)
+	if err != nil {
+		c.Eprintf("%s %s\n", printer.Serrorf("Failed to export deliverable:"), err)
+		return cli.SilenceError(err)
+	}
+	c.Printf("%s\n", export)
+	return nil
+}
+
+// exportable returns a copy of the deliverable holding only the name, namespace, labels,
+// annotations and spec, with the namespace and service account rewritten as requested
+func (opts *WorkloadExportDeliverableOptions) exportable(deliverable *cartov1alpha1.Deliverable) *cartov1alpha1.Deliverable {
+	exported := &cartov1alpha1.Deliverable{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        deliverable.Name,
+			Namespace:   deliverable.Namespace,
+			Labels:      withoutClusterMetadata(deliverable.Labels),
+			Annotations: withoutClusterMetadata(deliverable.Annotations),
+		},
+		Spec: *deliverable.Spec.DeepCopy(),
+	}
+	if opts.ToNamespace != "" {
+		exported.Namespace = opts.ToNamespace
+	}
+	if opts.ServiceAccount != "" {
+		exported.Spec.ServiceAccountName = opts.ServiceAccount
+	}
+	return exported
+}
+
+// apply creates the deliverable in the cluster of the target context, or updates it when it
+// already exists there
+func (opts *WorkloadExportDeliverableOptions) apply(ctx context.Context, c *cli.Config, deliverable *cartov1alpha1.Deliverable) error {
+	target := c.NewContextClient(opts.ToContext)
+	if deliverable.Namespace == "" {
+		deliverable.Namespace = target.DefaultNamespace()
+	}
+	key := fmt.Sprintf("%s/%s", deliverable.Namespace, deliverable.Name)
+
+	current := &cartov1alpha1.Deliverable{}
+	err := target.Get(ctx, client.ObjectKey{Namespace: deliverable.Namespace, Name: deliverable.Name}, current)
+	if err != nil && !apierrs.IsNotFound(err) {
+		return err
+	}
+	if apierrs.IsNotFound(err) {
+		if err := target.Create(ctx, deliverable); err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf(fmt.Sprintf("Failed to create deliverable %q in context %q:", key, opts.ToContext)), err)
+			return cli.SilenceError(err)
+		}
+		c.Successf("Created deliverable %q in context %q\n", key, opts.ToContext)
+		return nil
+	}
+
+	current.Labels = deliverable.Labels
+	current.Annotations = deliverable.Annotations
+	current.Spec = deliverable.Spec
+	if err := target.Update(ctx, current); err != nil {
+		c.Eprintf("%s %s\n", printer.Serrorf(fmt.Sprintf("Failed to update deliverable %q in context %q:", key, opts.ToContext)), err)
+		return cli.SilenceError(err)
+	}
+	c.Successf("Updated deliverable %q in context %q\n", key, opts.ToContext)
+	return nil
+}
+
+// getWorkloadStampedDeliverable returns the deliverable produced by the supply chain of the
+// workload. It is either stamped directly, or written as an output to a config map for another
+// cluster to pick up. Nil is returned when the workload has produced neither
+func getWorkloadStampedDeliverable(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload) (*cartov1alpha1.Deliverable, error) {
+	if deliverable := getWorkloadDeliverable(ctx, c, workload); deliverable != nil {
+		return deliverable, nil
+	}
+
+	for _, resource := range workload.Status.Resources {
+		if resource.StampedRef == nil || resource.StampedRef.ObjectReference == nil || resource.StampedRef.Kind != "ConfigMap" {
+			continue
+		}
+		configMap := &corev1.ConfigMap{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: resource.StampedRef.Namespace, Name: resource.StampedRef.Name}, configMap); err != nil {
+			if apierrs.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		keys := make([]string, 0, len(configMap.Data))
+		for key := range configMap.Data {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			deliverable := &cartov1alpha1.Deliverable{}
+			if err := yaml.Unmarshal([]byte(configMap.Data[key]), deliverable); err != nil || deliverable.Kind != cartov1alpha1.DeliverableKind {
+				continue
+			}
+			return deliverable, nil
+		}
+	}
+	return nil, nil
+}
+
+// withoutClusterMetadata returns the labels or annotations without the ones that only make sense
+// in the build cluster
+func withoutClusterMetadata(metadata map[string]string) map[string]string {
+	if len(metadata) == 0 {
+		return nil
+	}
+	filtered := map[string]string{}
+	for key, value := range metadata {
+		exported := true
+		for _, prefix := range exportedMetadataPrefixes {
+			if strings.HasPrefix(key, prefix) {
+				exported = false
+				break
+			}
+		}
+		for _, k := range exportedMetadataKeys {
+			if key == k {
+				exported = false
+				break
+			}
+		}
+		if exported {
+			filtered[key] = value
+		}
+	}
+	if len(filtered) == 0 {
+		return nil
+	}
+	return filtered
+}
+
+func NewWorkloadExportDeliverableCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &WorkloadExportDeliverableOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "export-deliverable",
+		Short: "Export the deliverable produced by a workload",
+		Long: strings.TrimSpace(`
+Export the deliverable produced by the supply chain of a workload, to promote it to a run cluster.
+
+The deliverable is either stamped by the supply chain or written to a config map for another
+cluster to pick up. It is exported without status nor the metadata set by the build cluster. The
+namespace and service account of the deliverable can be rewritten with ` + flags.ToNamespaceFlagName + ` and
+` + flags.ServiceAccountFlagName + `.
+
+The deliverable is printed as yaml, or json with ` + flags.OutputFlagName + `. With ` + flags.ToContextFlagName + ` it is instead
+created or updated in the cluster of that kubeconfig context.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s workload export-deliverable my-workload > deliverable.yaml", c.Name),
+			fmt.Sprintf("%s workload export-deliverable my-workload %s apps %s run-sa", c.Name, flags.ToNamespaceFlagName, flags.ServiceAccountFlagName),
+			fmt.Sprintf("%s workload export-deliverable my-workload %s run-cluster", c.Name, flags.ToContextFlagName),
+		}, "\n"),
+		PreRunE:           cli.ValidateE(ctx, opts),
+		RunE:              cli.ExecE(ctx, c, opts),
+		ValidArgsFunction: completion.SuggestWorkloadNames(ctx, c),
+	}
+
+	cli.Args(cmd,
+		cli.NameArg(&opts.Name),
+	)
+
+	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the deliverable formatted. Supported formats: \"json\", \"yaml\", \"yml\"")
+	cmd.Flags().StringVar(&opts.ToContext, cli.StripDash(flags.ToContextFlagName), "", "create or update the deliverable in the cluster of the kubeconfig `context`")
+	cmd.Flags().StringVar(&opts.ToNamespace, cli.StripDash(flags.ToNamespaceFlagName), "", "rewrite the `namespace` of the deliverable")
+	cmd.Flags().StringVar(&opts.ServiceAccount, cli.StripDash(flags.ServiceAccountFlagName), "", "rewrite the service account of the deliverable to `name`")
+
+	return cmd
+}
@@ -0,0 +1,395 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"context"
+	"testing"
+
+	diecorev1 "dies.dev/apis/core/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+func TestWorkloadExportDeliverableOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:        "invalid empty",
+			Validatable: &commands.WorkloadExportDeliverableOptions{},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMissingField(flags.NamespaceFlagName),
+				validation.ErrMissingField(cli.NameArgumentName),
+			),
+		},
+		{
+			Name: "valid",
+			Validatable: &commands.WorkloadExportDeliverableOptions{
+				Namespace:      "default",
+				Name:           "my-workload",
+				Output:         "json",
+				ToNamespace:    "apps",
+				ServiceAccount: "run-sa",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid output",
+			Validatable: &commands.WorkloadExportDeliverableOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Output:    "wide",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("wide", flags.OutputFlagName, []string{"json", "yaml", "yml"}),
+		},
+		{
+			Name: "output and to context",
+			Validatable: &commands.WorkloadExportDeliverableOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Output:    "yaml",
+				ToContext: "run-cluster",
+			},
+			ExpectFieldErrors: validation.ErrMultipleOneOf(flags.OutputFlagName, flags.ToContextFlagName),
+		},
+		{
+			Name: "invalid to namespace and service account",
+			Validatable: &commands.WorkloadExportDeliverableOptions{
+				Namespace:      "default",
+				Name:           "my-workload",
+				ToNamespace:    "apps-",
+				ServiceAccount: "run-sa-",
+			},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrInvalidValue("apps-", flags.ToNamespaceFlagName),
+				validation.ErrInvalidValue("run-sa-", flags.ServiceAccountFlagName),
+			),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestWorkloadExportDeliverableCommand(t *testing.T) {
+	defaultNamespace := "default"
+	workloadName := "my-workload"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	deliverable := diecartov1alpha1.DeliverableBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(workloadName)
+			d.Namespace(defaultNamespace)
+			d.AddLabel(cartov1alpha1.WorkloadLabelName, workloadName)
+			d.AddLabel("app.tanzu.vmware.com/deliverable-type", "web")
+			d.AddLabel("kapp.k14s.io/app", "1676543210")
+			d.AddAnnotation("kubectl.kubernetes.io/last-applied-configuration", "{}")
+			d.OwnerReferences(metav1.OwnerReference{APIVersion: "carto.run/v1alpha1", Kind: cartov1alpha1.WorkloadKind, Name: workloadName, UID: "workload-uid"})
+		}).
+		SpecDie(func(d *diecartov1alpha1.DeliverableSpecDie) {
+			d.Source(&cartov1alpha1.Source{Image: "registry.example/my-workload-bundle:latest"})
+			d.ServiceAccountName("build-sa")
+		}).
+		StatusDie(func(d *diecartov1alpha1.DeliverableStatusDie) {
+			d.DeliveryRef(cartov1alpha1.ObjectReference{Name: "delivery-basic"})
+		})
+	stamping := func(kind, name string) *diecartov1alpha1.WorkloadDie {
+		return diecartov1alpha1.WorkloadBlank.
+			MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+				d.Name(workloadName)
+				d.Namespace(defaultNamespace)
+			}).
+			StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+				d.Resources(cartov1alpha1.RealizedResource{
+					Name: "deliverable",
+					StampedRef: &cartov1alpha1.StampedRef{
+						ObjectReference: &corev1.ObjectReference{Kind: kind, Namespace: defaultNamespace, Name: name},
+					},
+				})
+			})
+	}
+	configMap := diecorev1.ConfigMapBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(workloadName + "-deliverable")
+			d.Namespace(defaultNamespace)
+		}).
+		AddData("deliverable", `apiVersion: carto.run/v1alpha1
+kind: Deliverable
+metadata:
+  name: my-workload
+  labels:
+    app.tanzu.vmware.com/deliverable-type: web
+    carto.run/workload-name: my-workload
+spec:
+  source:
+    image: registry.example/my-workload-bundle:latest
+`)
+	useSameClusterForContext := func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+		config.NewContextClient = func(context string) cli.Client {
+			return config.Client
+		}
+		return ctx, nil
+	}
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "empty",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name: "workload not found",
+			Args: []string{workloadName},
+			ExpectOutput: `
+Workload "default/my-workload" not found
+`,
+			ShouldError: true,
+		},
+		{
+			Name: "no deliverable",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				stamping("ConfigMap", "other"),
+				diecorev1.ConfigMapBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("other")
+						d.Namespace(defaultNamespace)
+					}).
+					AddData("config", "not a deliverable"),
+			},
+			ExpectOutput: `
+No deliverable found in the resources of workload "default/my-workload"
+`,
+			ShouldError: true,
+		},
+		{
+			Name: "stamped deliverable",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				stamping(cartov1alpha1.DeliverableKind, workloadName),
+				deliverable,
+			},
+			ExpectOutput: `
+---
+apiVersion: carto.run/v1alpha1
+kind: Deliverable
+metadata:
+  labels:
+    app.tanzu.vmware.com/deliverable-type: web
+    carto.run/workload-name: my-workload
+  name: my-workload
+  namespace: default
+spec:
+  serviceAccountName: build-sa
+  source:
+    image: registry.example/my-workload-bundle:latest
+`,
+		},
+		{
+			Name: "stamped deliverable with cartographer labels",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				stamping(cartov1alpha1.DeliverableKind, workloadName),
+				deliverable.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel("app.kubernetes.io/component", "deliverable")
+						d.AddLabel("app.kubernetes.io/part-of", workloadName)
+						d.AddLabel("carto.run/supply-chain-name", "source-to-url")
+						d.AddLabel("carto.run/resource-name", "deliverable")
+						d.AddLabel("carto.run/template-kind", "ClusterTemplate")
+						d.AddLabel("carto.run/cluster-template-name", "deliverable-template")
+						d.AddLabel("carto.run/workload-namespace", defaultNamespace)
+					}),
+			},
+			ExpectOutput: `
+---
+apiVersion: carto.run/v1alpha1
+kind: Deliverable
+metadata:
+  labels:
+    app.kubernetes.io/component: deliverable
+    app.kubernetes.io/part-of: my-workload
+    app.tanzu.vmware.com/deliverable-type: web
+    carto.run/workload-name: my-workload
+  name: my-workload
+  namespace: default
+spec:
+  serviceAccountName: build-sa
+  source:
+    image: registry.example/my-workload-bundle:latest
+`,
+		},
+		{
+			Name: "deliverable in a config map",
+			Args: []string{workloadName, flags.ToNamespaceFlagName, "apps", flags.ServiceAccountFlagName, "run-sa"},
+			GivenObjects: []client.Object{
+				stamping("ConfigMap", workloadName+"-deliverable"),
+				configMap,
+			},
+			ExpectOutput: `
+---
+apiVersion: carto.run/v1alpha1
+kind: Deliverable
+metadata:
+  labels:
+    app.tanzu.vmware.com/deliverable-type: web
+    carto.run/workload-name: my-workload
+  name: my-workload
+  namespace: apps
+spec:
+  serviceAccountName: run-sa
+  source:
+    image: registry.example/my-workload-bundle:latest
+`,
+		},
+		{
+			Name: "json output",
+			Args: []string{workloadName, flags.OutputFlagName, "json"},
+			GivenObjects: []client.Object{
+				stamping(cartov1alpha1.DeliverableKind, workloadName),
+				deliverable,
+			},
+			ExpectOutput: `
+{
+	"apiVersion": "carto.run/v1alpha1",
+	"kind": "Deliverable",
+	"metadata": {
+		"labels": {
+			"app.tanzu.vmware.com/deliverable-type": "web",
+			"carto.run/workload-name": "my-workload"
+		},
+		"name": "my-workload",
+		"namespace": "default"
+	},
+	"spec": {
+		"serviceAccountName": "build-sa",
+		"source": {
+			"image": "registry.example/my-workload-bundle:latest"
+		}
+	}
+}
+`,
+		},
+		{
+			Name: "create in another context",
+			Args: []string{workloadName, flags.ToContextFlagName, "run-cluster", flags.ToNamespaceFlagName, "apps"},
+			GivenObjects: []client.Object{
+				stamping("ConfigMap", workloadName+"-deliverable"),
+				configMap,
+			},
+			Prepare: useSameClusterForContext,
+			ExpectCreates: []client.Object{
+				diecartov1alpha1.DeliverableBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(workloadName)
+						d.Namespace("apps")
+						d.AddLabel("app.tanzu.vmware.com/deliverable-type", "web")
+						d.AddLabel(cartov1alpha1.WorkloadLabelName, workloadName)
+					}).
+					SpecDie(func(d *diecartov1alpha1.DeliverableSpecDie) {
+						d.Source(&cartov1alpha1.Source{Image: "registry.example/my-workload-bundle:latest"})
+					}),
+			},
+			ExpectOutput: `
+Created deliverable "apps/my-workload" in context "run-cluster"
+`,
+		},
+		{
+			Name: "update in another context",
+			Args: []string{workloadName, flags.ToContextFlagName, "run-cluster", flags.ToNamespaceFlagName, "apps", flags.ServiceAccountFlagName, "run-sa"},
+			GivenObjects: []client.Object{
+				stamping("ConfigMap", workloadName+"-deliverable"),
+				configMap,
+				diecartov1alpha1.DeliverableBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(workloadName)
+						d.Namespace("apps")
+					}).
+					SpecDie(func(d *diecartov1alpha1.DeliverableSpecDie) {
+						d.Source(&cartov1alpha1.Source{Image: "registry.example/my-workload-bundle:previous"})
+					}),
+			},
+			Prepare: useSameClusterForContext,
+			ExpectUpdates: []client.Object{
+				diecartov1alpha1.DeliverableBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(workloadName)
+						d.Namespace("apps")
+						d.AddLabel("app.tanzu.vmware.com/deliverable-type", "web")
+						d.AddLabel(cartov1alpha1.WorkloadLabelName, workloadName)
+					}).
+					SpecDie(func(d *diecartov1alpha1.DeliverableSpecDie) {
+						d.Source(&cartov1alpha1.Source{Image: "registry.example/my-workload-bundle:latest"})
+						d.ServiceAccountName("run-sa")
+					}),
+			},
+			ExpectOutput: `
+Updated deliverable "apps/my-workload" in context "run-cluster"
+`,
+		},
+		{
+			Name: "create error in another context",
+			Args: []string{workloadName, flags.ToContextFlagName, "run-cluster"},
+			GivenObjects: []client.Object{
+				stamping("ConfigMap", workloadName+"-deliverable"),
+				configMap,
+			},
+			Prepare: useSameClusterForContext,
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("create", "Deliverable"),
+			},
+			ExpectCreates: []client.Object{
+				diecartov1alpha1.DeliverableBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(workloadName)
+						d.Namespace(defaultNamespace)
+						d.AddLabel("app.tanzu.vmware.com/deliverable-type", "web")
+						d.AddLabel(cartov1alpha1.WorkloadLabelName, workloadName)
+					}).
+					SpecDie(func(d *diecartov1alpha1.DeliverableSpecDie) {
+						d.Source(&cartov1alpha1.Source{Image: "registry.example/my-workload-bundle:latest"})
+					}),
+			},
+			ExpectOutput: `
+Failed to create deliverable "default/my-workload" in context "run-cluster": inducing failure for create Deliverable
+`,
+			ShouldError: true,
+		},
+		{
+			Name: "get error",
+			Args: []string{workloadName},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "Workload"),
+			},
+			ShouldError: true,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewWorkloadExportDeliverableCommand)
+}
@@ -19,6 +19,7 @@ package commands
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -28,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
 	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
 	knativeservingv1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/knative/serving/v1"
 	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
@@ -42,9 +44,14 @@ import (
 type WorkloadGetOptions struct {
 	Namespace string
 	Name      string
-
-	Export bool
-	Output string
+	// Names of the workloads to get after Name, when several names are provided
+	Names []string
+	App   string
+
+	Export  bool
+	Output  string
+	Related bool
+	Outputs bool
 }
 
 var (
@@ -52,6 +59,16 @@ var (
 	_ cli.Executable         = (*WorkloadGetOptions)(nil)
 )
 
+// WorkloadRelated is the machine readable form of the details shown by the get command
+type WorkloadRelated struct {
+	Workload        map[string]interface{}   `json:"workload"`
+	Deliverable     map[string]interface{}   `json:"deliverable"`
+	Pods            []map[string]interface{} `json:"pods"`
+	KnativeServices []map[string]interface{} `json:"knativeServices"`
+	Issues          []printer.ConditionIssue `json:"issues"`
+	Ready           bool                     `json:"ready"`
+}
+
 func (opts *WorkloadGetOptions) Validate(ctx context.Context) validation.FieldErrors {
 	errs := validation.FieldErrors{}
 
@@ -59,18 +76,58 @@ func (opts *WorkloadGetOptions) Validate(ctx context.Context) validation.FieldEr
 		errs = errs.Also(validation.ErrMissingField(flags.NamespaceFlagName))
 	}
 
-	if opts.Name == "" {
+	if opts.App != "" {
+		if opts.Name != "" {
+			errs = errs.Also(validation.ErrMultipleOneOf(cli.NamesArgumentName, flags.AppFlagName))
+		}
+		errs = errs.Also(validation.K8sName(opts.App, flags.AppFlagName))
+		if opts.Export {
+			errs = errs.Also(validation.ErrMultipleOneOf(flags.ExportFlagName, flags.AppFlagName))
+		}
+	} else if opts.Name == "" {
 		errs = errs.Also(validation.ErrMissingField(cli.NameArgumentName))
 	}
 
+	if len(opts.Names) != 0 && opts.Export {
+		errs = errs.Also(validation.ErrMultipleOneOf(flags.ExportFlagName, cli.NamesArgumentName))
+	}
+
+	if opts.Related {
+		if opts.Export {
+			errs = errs.Also(validation.ErrMultipleOneOf(flags.ExportFlagName, flags.RelatedFlagName))
+		}
+		if opts.Output == "" {
+			errs = errs.Also(validation.ErrMissingField(flags.OutputFlagName))
+		}
+	}
+
+	if opts.Outputs {
+		if opts.Export {
+			errs = errs.Also(validation.ErrMultipleOneOf(flags.ExportFlagName, flags.OutputsFlagName))
+		}
+		if opts.Output != "" {
+			errs = errs.Also(validation.ErrMultipleOneOf(flags.OutputFlagName, flags.OutputsFlagName))
+		}
+	}
+
 	if opts.Output != "" {
-		errs = errs.Also(validation.Enum(opts.Output, flags.OutputFlagName, []string{printer.OutputFormatJson, printer.OutputFormatYaml, printer.OutputFormatYml}))
+		if opts.Export {
+			errs = errs.Also(validation.Enum(opts.Output, flags.OutputFlagName, []string{printer.OutputFormatJson, printer.OutputFormatYaml, printer.OutputFormatYml}))
+		} else if opts.Related {
+			errs = errs.Also(validation.OutputFormat(opts.Output, flags.OutputFlagName, printer.ObjectOutputFormats))
+		} else {
+			errs = errs.Also(validation.OutputFormat(opts.Output, flags.OutputFlagName, printer.TableOutputFormats))
+		}
 	}
 
 	return errs
 }
 
 func (opts *WorkloadGetOptions) Exec(ctx context.Context, c *cli.Config) error {
+	if opts.App != "" || len(opts.Names) != 0 {
+		return opts.execMultiple(ctx, c)
+	}
+
 	workload := &cartov1alpha1.Workload{}
 	err := c.Get(ctx, client.ObjectKey{Namespace: opts.Namespace, Name: opts.Name}, workload)
 	if err != nil {
@@ -104,6 +161,19 @@ func (opts *WorkloadGetOptions) Exec(ctx context.Context, c *cli.Config) error {
 		return nil
 	}
 
+	if opts.Related {
+		related, err := opts.related(ctx, c, workload)
+		if err != nil {
+			return err
+		}
+		return opts.outputObject(c, related)
+	}
+
+	if opts.Output == printer.OutputFormatWide {
+		listOpts := &WorkloadListOptions{Namespace: opts.Namespace, Output: opts.Output}
+		return listOpts.printTable(ctx, c, c.Stdout, &cartov1alpha1.WorkloadList{Items: []cartov1alpha1.Workload{*workload}}, false)
+	}
+
 	if opts.Output != "" {
 		export, err := printer.OutputResource(workload, printer.OutputFormat(opts.Output), c.Scheme)
 		if err != nil {
@@ -115,7 +185,91 @@ func (opts *WorkloadGetOptions) Exec(ctx context.Context, c *cli.Config) error {
 		return nil
 	}
 
-	//print workload details
+	return opts.printDetails(ctx, c, workload)
+}
+
+// execMultiple gets each of the named workloads, or the workloads part of the app. Workloads that
+// are not found are reported and fail the command once the others are printed
+func (opts *WorkloadGetOptions) execMultiple(ctx context.Context, c *cli.Config) error {
+	workloads := &cartov1alpha1.WorkloadList{}
+	var notFoundErr error
+	if opts.App != "" {
+		if err := c.List(ctx, workloads, client.InNamespace(opts.Namespace), client.MatchingLabels{apis.AppPartOfLabelName: opts.App}); err != nil {
+			return err
+		}
+		if len(workloads.Items) == 0 {
+			c.Einfof("No workloads found.\n")
+			return cli.SilenceError(fmt.Errorf("no workloads found for app %q", opts.App))
+		}
+		printer.SortByNamespaceAndName(workloads.Items)
+	} else {
+		for _, name := range append([]string{opts.Name}, opts.Names...) {
+			workload := &cartov1alpha1.Workload{}
+			if err := c.Get(ctx, client.ObjectKey{Namespace: opts.Namespace, Name: name}, workload); err != nil {
+				if !apierrs.IsNotFound(err) {
+					return err
+				}
+				if notFoundErr == nil {
+					nsGet := &corev1.Namespace{}
+					if getErr := c.Get(ctx, types.NamespacedName{Name: opts.Namespace}, nsGet); getErr != nil && apierrs.IsNotFound(getErr) {
+						c.Eprintf("%s %s\n", printer.Serrorf("Error:"), fmt.Sprintf("namespace %q not found, it may not exist or user does not have permissions to read it.", opts.Namespace))
+						return cli.SilenceError(getErr)
+					}
+				}
+				c.Eerrorf("Workload %q not found\n", fmt.Sprintf("%s/%s", opts.Namespace, name))
+				notFoundErr = err
+				continue
+			}
+			workloads.Items = append(workloads.Items, *workload)
+		}
+	}
+
+	switch {
+	case len(workloads.Items) == 0:
+	case opts.Related:
+		relatedList := make([]*WorkloadRelated, len(workloads.Items))
+		for i := range workloads.Items {
+			related, err := opts.related(ctx, c, &workloads.Items[i])
+			if err != nil {
+				return err
+			}
+			relatedList[i] = related
+		}
+		if err := opts.outputObject(c, relatedList); err != nil {
+			return err
+		}
+	case opts.Output == printer.OutputFormatWide:
+		listOpts := &WorkloadListOptions{Namespace: opts.Namespace, Output: opts.Output}
+		if err := listOpts.printTable(ctx, c, c.Stdout, workloads, false); err != nil {
+			return err
+		}
+	case opts.Output != "":
+		var list []printer.Object
+		for i := range workloads.Items {
+			list = append(list, &workloads.Items[i])
+		}
+		export, err := printer.OutputResources(list, printer.OutputFormat(opts.Output), c.Scheme)
+		if err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Failed to output workload:"), err)
+			return cli.SilenceError(err)
+		}
+		c.Printf("%s\n", export)
+	default:
+		for i := range workloads.Items {
+			if err := opts.printDetails(ctx, c, &workloads.Items[i]); err != nil {
+				return err
+			}
+		}
+	}
+
+	if notFoundErr != nil {
+		return cli.SilenceError(notFoundErr)
+	}
+	return nil
+}
+
+// printDetails prints the human readable view of the workload
+func (opts *WorkloadGetOptions) printDetails(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload) error {
 	c.Emoji(cli.Antenna, cliprinter.Sboldf("Overview\n"))
 	if err := printer.WorkloadOverviewPrinter(c.Stdout, workload); err != nil {
 		return err
@@ -167,33 +321,73 @@ func (opts *WorkloadGetOptions) Exec(ctx context.Context, c *cli.Config) error {
 		}
 	}
 
+	// Print the outputs of the supply chain resources
+	if opts.Outputs {
+		c.Printf("\n")
+		c.Emoji(cli.Link, cliprinter.Sboldf("Outputs\n"))
+		if len(workload.Status.Resources) == 0 {
+			c.Infof(printer.AddPaddingStart("Supply Chain outputs not found.\n"))
+		} else if err := printer.WorkloadOutputsPrinter(c.Stdout, workload); err != nil {
+			return err
+		}
+	}
+
+	// Print the latest run of the runnables stamped by the workload
+	if runnables := getWorkloadRunnables(ctx, c, workload); len(runnables) != 0 {
+		c.Printf("\n")
+		c.Emoji(cli.Runner, cliprinter.Sboldf("Runs\n"))
+		for i := range runnables {
+			runs, err := listRunnableRuns(ctx, c, &runnables[i])
+			if err != nil {
+				return err
+			}
+			var latest *printer.WorkloadRun
+			if len(runs) != 0 {
+				latest = &runs[0]
+			}
+			if err := printer.RunnableInfoPrinter(c.Stdout, &runnables[i], latest); err != nil {
+				return err
+			}
+			if latest == nil {
+				c.Infof(printer.AddPaddingStart("No runs found.\n"))
+			}
+		}
+	}
+
+	// Print the results of the scans stamped by the workload
+	scanResults, err := getWorkloadScanResults(ctx, c, workload)
+	if err != nil {
+		return err
+	}
+	if len(scanResults) != 0 {
+		c.Printf("\n")
+		if err := printWorkloadScanResults(c, workload, scanResults); err != nil {
+			return err
+		}
+	}
+
 	// Deliverable
 	c.Printf("\n")
 	c.Emoji(cli.Delivery, cliprinter.Sboldf("Delivery\n"))
 	// Print workload deliverable resources
-	wldDeliverable := getWorkloadResourceByKind(workload, cartov1alpha1.DeliverableKind)
 	var deliverableStatusReadyCond *metav1.Condition
 	notFoundMsg := printer.AddPaddingStart("Delivery resources not found.\n")
 	deliverable := &cartov1alpha1.Deliverable{}
-	if wldDeliverable != nil {
-		if err := c.Get(ctx, client.ObjectKey{Namespace: wldDeliverable.StampedRef.Namespace, Name: wldDeliverable.StampedRef.Name}, deliverable); err != nil {
-			c.Printf("\n")
-			c.Infof(notFoundMsg)
-		} else if deliverable != nil {
-			deliverableStatusReadyCond = printer.FindCondition(deliverable.Status.Conditions, cartov1alpha1.ConditionReady)
-			if err := printer.DeliveryInfoPrinter(c.Stdout, deliverable); err != nil {
-				return err
-			}
-			c.Printf("\n")
-			if len(deliverable.Status.Resources) == 0 {
-				c.Infof(notFoundMsg)
-			} else if err := printer.DeliverableResourcesPrinter(c.Stdout, deliverable); err != nil {
-				return err
-			}
-		}
-	} else {
+	if d := getWorkloadDeliverable(ctx, c, workload); d == nil {
 		c.Printf("\n")
 		c.Infof(notFoundMsg)
+	} else {
+		deliverable = d
+		deliverableStatusReadyCond = printer.FindCondition(deliverable.Status.Conditions, cartov1alpha1.ConditionReady)
+		if err := printer.DeliveryInfoPrinter(c.Stdout, deliverable); err != nil {
+			return err
+		}
+		c.Printf("\n")
+		if len(deliverable.Status.Resources) == 0 {
+			c.Infof(notFoundMsg)
+		} else if err := printer.DeliverableResourcesPrinter(c.Stdout, deliverable); err != nil {
+			return err
+		}
 	}
 
 	// Print workload issues
@@ -221,7 +415,7 @@ func (opts *WorkloadGetOptions) Exec(ctx context.Context, c *cli.Config) error {
 
 	arg := []string{"pods.v1."}
 	labelSelectorParams := fmt.Sprintf("%s%s%s", cartov1alpha1.WorkloadLabelName, "=", workload.Name)
-	if tableResult, err := source.FetchResourceObjects(c.Builder, workload.Namespace, labelSelectorParams, arg); err != nil {
+	if tableResult, err := source.FetchResourceObjects(c.NewBuilder(), workload.Namespace, labelSelectorParams, arg); err != nil {
 		c.Eprintf("\n")
 		c.Eerrorf("Failed to list pods:\n")
 		c.Eprintf("  %s\n", err)
@@ -236,14 +430,10 @@ func (opts *WorkloadGetOptions) Exec(ctx context.Context, c *cli.Config) error {
 		}
 	}
 
-	ksvcs := &knativeservingv1.ServiceList{}
-	_ = c.List(ctx, ksvcs, client.InNamespace(workload.Namespace), client.MatchingLabels{cartov1alpha1.WorkloadLabelName: workload.Name})
+	ksvcs := listWorkloadKnativeServices(ctx, c, workload)
 	if len(ksvcs.Items) > 0 {
-		ksvcs = ksvcs.DeepCopy()
-		printer.SortByNamespaceAndName(ksvcs.Items)
 		c.Printf("\n")
-		c.Emoji(cli.Ship, cliprinter.Sboldf("Knative Services\n"))
-		if err := printer.KnativeServicePrinter(c, ksvcs); err != nil {
+		if err := printKnativeServices(ctx, c, ksvcs); err != nil {
 			return err
 		}
 	}
@@ -259,15 +449,94 @@ func (opts *WorkloadGetOptions) Exec(ctx context.Context, c *cli.Config) error {
 	return nil
 }
 
+// related collects the workload together with the resources shown by the human readable view
+// into a single document
+func (opts *WorkloadGetOptions) related(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload) (*WorkloadRelated, error) {
+	related := &WorkloadRelated{
+		Pods:            []map[string]interface{}{},
+		KnativeServices: []map[string]interface{}{},
+	}
+	var err error
+	if related.Workload, err = printer.UnstructuredResource(workload, c.Scheme); err != nil {
+		return nil, err
+	}
+
+	deliverable := getWorkloadDeliverable(ctx, c, workload)
+	if deliverable != nil {
+		if related.Deliverable, err = printer.UnstructuredResource(deliverable, c.Scheme); err != nil {
+			return nil, err
+		}
+	}
+	related.Ready, related.Issues = workloadReadiness(workload, deliverable)
+
+	labelSelectorParams := fmt.Sprintf("%s%s%s", cartov1alpha1.WorkloadLabelName, "=", workload.Name)
+	if tableResult, err := source.FetchResourceObjects(c.NewBuilder(), workload.Namespace, labelSelectorParams, []string{"pods.v1."}); err != nil {
+		c.Eerrorf("Failed to list pods:\n")
+		c.Eprintf("  %s\n", err)
+	} else if podTable, ok := tableResult.(*metav1.Table); ok {
+		for _, row := range podTable.Rows {
+			pod := map[string]interface{}{}
+			for i, column := range podTable.ColumnDefinitions {
+				// only the columns shown by the human readable view
+				if column.Priority == 0 && i < len(row.Cells) {
+					pod[strings.ToLower(column.Name)] = row.Cells[i]
+				}
+			}
+			related.Pods = append(related.Pods, pod)
+		}
+	}
+
+	ksvcs := listWorkloadKnativeServices(ctx, c, workload)
+	for i := range ksvcs.Items {
+		ksvc, err := printer.UnstructuredResource(&ksvcs.Items[i], c.Scheme)
+		if err != nil {
+			return nil, err
+		}
+		related.KnativeServices = append(related.KnativeServices, ksvc)
+	}
+
+	return related, nil
+}
+
+func (opts *WorkloadGetOptions) outputObject(c *cli.Config, obj interface{}) error {
+	export, err := printer.OutputObject(obj, printer.OutputFormat(opts.Output))
+	if err != nil {
+		c.Eprintf("%s %s\n", printer.Serrorf("Failed to output workload:"), err)
+		return cli.SilenceError(err)
+	}
+	c.Printf("%s\n", export)
+	return nil
+}
+
 func NewWorkloadGetCommand(ctx context.Context, c *cli.Config) *cobra.Command {
 	opts := &WorkloadGetOptions{}
 
 	cmd := &cobra.Command{
 		Use:   "get",
 		Short: "Get details from a workload",
-		Long:  strings.TrimSpace(`Get details from a workload`),
+		Long: strings.TrimSpace(`
+Get details from one or more workloads.
+
+Several workload names can be provided, or all the workloads part of an application can be
+selected with ` + flags.AppFlagName + `. Each workload is then shown in turn, while the ` + flags.OutputFlagName + ` formats
+render all of them in a single document or table.
+
+With ` + flags.RelatedFlagName + ` the ` + flags.OutputFlagName + ` document includes, next to the workload, the resources shown by
+the human readable view: the deliverable, pods, Knative services, messages and whether the
+workload and its deliverable are ready.
+
+With ` + flags.OutputsFlagName + ` the values output by each supply chain resource are listed next to the
+resources it consumed as inputs, tracing for example the git revision that produced an image
+and the image that produced a configuration.
+`),
 		Example: strings.Join([]string{
 			fmt.Sprintf("%s workload get my-workload", c.Name),
+			fmt.Sprintf("%s workload get my-workload %s wide", c.Name, flags.OutputFlagName),
+			fmt.Sprintf("%s workload get my-workload %s jsonpath='{.status.supplyChainRef.name}'", c.Name, flags.OutputFlagName),
+			fmt.Sprintf("%s workload get my-workload %s json %s", c.Name, flags.OutputFlagName, flags.RelatedFlagName),
+			fmt.Sprintf("%s workload get my-workload %s", c.Name, flags.OutputsFlagName),
+			fmt.Sprintf("%s workload get my-workload my-other-workload", c.Name),
+			fmt.Sprintf("%s workload get %s spring-petclinic %s wide", c.Name, flags.AppFlagName, flags.OutputFlagName),
 		}, "\n"),
 		PreRunE:           cli.ValidateE(ctx, opts),
 		RunE:              cli.ExecE(ctx, c, opts),
@@ -275,12 +544,26 @@ func NewWorkloadGetCommand(ctx context.Context, c *cli.Config) *cobra.Command {
 	}
 
 	cli.Args(cmd,
-		cli.NameArg(&opts.Name),
+		cli.Arg{
+			Name:     cli.NamesArgumentName,
+			Arity:    -1,
+			Optional: true,
+			Set: func(cmd *cobra.Command, args []string, offset int) error {
+				if len(args) > offset {
+					opts.Name = args[offset]
+					opts.Names = args[offset+1:]
+				}
+				return nil
+			},
+		},
 	)
 
 	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
+	cmd.Flags().StringVar(&opts.App, cli.StripDash(flags.AppFlagName), "", "get the workloads part of the `application`")
 	cmd.Flags().BoolVarP(&opts.Export, cli.StripDash(flags.ExportFlagName), "e", false, "export workload in yaml format")
-	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the Workload formatted. Supported formats: \"json\", \"yaml\", \"yml\"")
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the Workload formatted. Supported formats: \"json\", \"yaml\", \"yml\", \"wide\", \"name\", \"custom-columns=<columns>\", \"jsonpath=<expression>\", \"go-template=<template>\", \"go-template-file=<path>\"")
+	cmd.Flags().BoolVar(&opts.Outputs, cli.StripDash(flags.OutputsFlagName), false, "list the outputs of each supply chain resource and the resources it consumed as inputs")
+	cmd.Flags().BoolVar(&opts.Related, cli.StripDash(flags.RelatedFlagName), false, "include the deliverable, pods, Knative services, messages and readiness of the workload in the "+flags.OutputFlagName+" document")
 
 	return cmd
 }
@@ -294,6 +577,101 @@ func getWorkloadResourceByKind(workload *cartov1alpha1.Workload, kind string) *c
 	return nil
 }
 
+// getWorkloadDeliverable returns the deliverable stamped by the workload, or nil when there is none
+// or it can not be read
+func getWorkloadDeliverable(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload) *cartov1alpha1.Deliverable {
+	wldDeliverable := getWorkloadResourceByKind(workload, cartov1alpha1.DeliverableKind)
+	if wldDeliverable == nil {
+		return nil
+	}
+	deliverable := &cartov1alpha1.Deliverable{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: wldDeliverable.StampedRef.Namespace, Name: wldDeliverable.StampedRef.Name}, deliverable); err != nil {
+		return nil
+	}
+	return deliverable
+}
+
+// listWorkloadKnativeServices returns the Knative services of the workload sorted by name, errors
+// are ignored as Knative may not be installed in the cluster
+func listWorkloadKnativeServices(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload) *knativeservingv1.ServiceList {
+	return listKnativeServices(ctx, c, workload.Namespace, workload.Name)
+}
+
+// listKnativeServices returns the Knative services in the namespace labeled with the workload name
+// sorted by name, errors are ignored as Knative may not be installed in the cluster
+func listKnativeServices(ctx context.Context, c *cli.Config, namespace, workloadName string) *knativeservingv1.ServiceList {
+	ksvcs := &knativeservingv1.ServiceList{}
+	_ = c.List(ctx, ksvcs, client.InNamespace(namespace), client.MatchingLabels{cartov1alpha1.WorkloadLabelName: workloadName})
+	ksvcs = ksvcs.DeepCopy()
+	printer.SortByNamespaceAndName(ksvcs.Items)
+	return ksvcs
+}
+
+// listKnativeRevisions returns the revisions of the Knative service, newest first. Errors are
+// ignored as the revisions are only shown to complement the service
+func listKnativeRevisions(ctx context.Context, c *cli.Config, ksvc *knativeservingv1.Service) *knativeservingv1.RevisionList {
+	revisions := &knativeservingv1.RevisionList{}
+	_ = c.List(ctx, revisions, client.InNamespace(ksvc.Namespace), client.MatchingLabels{knativeservingv1.ServiceLabelKey: ksvc.Name})
+	revisions = revisions.DeepCopy()
+	sort.SliceStable(revisions.Items, func(i, j int) bool {
+		ti, tj := revisions.Items[i].CreationTimestamp, revisions.Items[j].CreationTimestamp
+		if !ti.Equal(&tj) {
+			return tj.Before(&ti)
+		}
+		return revisions.Items[i].Name > revisions.Items[j].Name
+	})
+	return revisions
+}
+
+// printKnativeServices prints the Knative Services section shown by the workload and deliverable
+// get commands, each service is followed by its latest created and ready revisions and the
+// traffic routed to its revisions so that a stuck rollout is noticed
+func printKnativeServices(ctx context.Context, c *cli.Config, ksvcs *knativeservingv1.ServiceList) error {
+	c.Emoji(cli.Ship, cliprinter.Sboldf("Knative Services\n"))
+	if err := printer.KnativeServicePrinter(c, ksvcs); err != nil {
+		return err
+	}
+	for i := range ksvcs.Items {
+		ksvc := &ksvcs.Items[i]
+		revisions := listKnativeRevisions(ctx, c, ksvc)
+		if len(revisions.Items) == 0 && ksvc.Status.LatestCreatedRevisionName == "" {
+			continue
+		}
+		c.Printf("\n")
+		if err := printer.KnativeRevisionsInfoPrinter(c.Stdout, ksvc); err != nil {
+			return err
+		}
+		if len(revisions.Items) > 0 {
+			c.Printf("\n")
+			if err := printer.KnativeRevisionsPrinter(c.Stdout, ksvc, revisions.Items); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// workloadReadiness returns whether the workload and its deliverable, when there is one, are ready
+// and the messages reported by their conditions when they are not
+func workloadReadiness(workload *cartov1alpha1.Workload, deliverable *cartov1alpha1.Deliverable) (bool, []printer.ConditionIssue) {
+	issues := []printer.ConditionIssue{}
+	workloadReadyCond := printer.FindCondition(workload.Status.Conditions, cartov1alpha1.WorkloadConditionReady)
+	var deliverableReadyCond *metav1.Condition
+	if deliverable != nil {
+		deliverableReadyCond = printer.FindCondition(deliverable.Status.Conditions, cartov1alpha1.ConditionReady)
+	}
+
+	ready := workloadReadyCond != nil && workloadReadyCond.Status == metav1.ConditionTrue &&
+		(deliverable == nil || (deliverableReadyCond != nil && deliverableReadyCond.Status == metav1.ConditionTrue))
+	if !areAllResourcesReady(workloadReadyCond, deliverableReadyCond) {
+		issues = append(issues, printer.ConditionIssues(cartov1alpha1.WorkloadKind, workload.Status.Conditions)...)
+		if deliverable != nil {
+			issues = append(issues, printer.ConditionIssues(cartov1alpha1.DeliverableKind, deliverable.Status.Conditions)...)
+		}
+	}
+	return ready, issues
+}
+
 func areAllResourcesReady(resourcesConditions ...*metav1.Condition) bool {
 	for _, condition := range resourcesConditions {
 		if ready := condition == nil || (condition.Status == metav1.ConditionTrue || condition.Message == ""); !ready {
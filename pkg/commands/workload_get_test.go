@@ -26,11 +26,13 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
 	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
 	knativeservingv1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/knative/serving/v1"
+	scanningv1beta1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/scanning/v1beta1"
 	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
 	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
@@ -100,7 +102,150 @@ func TestWorkloadGetOptionsValidate(t *testing.T) {
 				Name:      "my-workload",
 				Output:    "myFormat",
 			},
-			ExpectFieldErrors: validation.EnumInvalidValue("myFormat", flags.OutputFlagName, []string{"json", "yaml", "yml"}),
+			ExpectFieldErrors: validation.EnumInvalidValue("myFormat", flags.OutputFlagName, []string{"json", "yaml", "yml", "wide", "name", "custom-columns", "jsonpath", "go-template", "go-template-file"}),
+		},
+		{
+			Name: "valid jsonpath output format",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Output:    "jsonpath={.status.supplyChainRef.name}",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid go-template output format",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Output:    "go-template={{.metadata.name",
+			},
+			ExpectFieldErrors: validation.FieldErrors{
+				field.Invalid(field.NewPath(flags.OutputFlagName), "go-template={{.metadata.name", "error parsing template {{.metadata.name: template: go-template:1: unclosed action"),
+			},
+		},
+		{
+			Name: "export with wide output format",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Export:    true,
+				Output:    "wide",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("wide", flags.OutputFlagName, []string{"json", "yaml", "yml"}),
+		},
+		{
+			Name: "related",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Output:    "jsonpath={.ready}",
+				Related:   true,
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "related without output",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Related:   true,
+			},
+			ExpectFieldErrors: validation.ErrMissingField(flags.OutputFlagName),
+		},
+		{
+			Name: "related with export",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Export:    true,
+				Output:    "yaml",
+				Related:   true,
+			},
+			ExpectFieldErrors: validation.ErrMultipleOneOf(flags.ExportFlagName, flags.RelatedFlagName),
+		},
+		{
+			Name: "related with wide output format",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Output:    "wide",
+				Related:   true,
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("wide", flags.OutputFlagName, printer.ObjectOutputFormats),
+		},
+		{
+			Name: "outputs with output format",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Output:    "yaml",
+				Outputs:   true,
+			},
+			ExpectFieldErrors: validation.ErrMultipleOneOf(flags.OutputFlagName, flags.OutputsFlagName),
+		},
+		{
+			Name: "outputs with export",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Export:    true,
+				Outputs:   true,
+			},
+			ExpectFieldErrors: validation.ErrMultipleOneOf(flags.ExportFlagName, flags.OutputsFlagName),
+		},
+		{
+			Name: "multiple names",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Names:     []string{"my-other-workload"},
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "multiple names with export",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Names:     []string{"my-other-workload"},
+				Export:    true,
+			},
+			ExpectFieldErrors: validation.ErrMultipleOneOf(flags.ExportFlagName, cli.NamesArgumentName),
+		},
+		{
+			Name: "app",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				App:       "my-app",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "app with names",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				App:       "my-app",
+			},
+			ExpectFieldErrors: validation.ErrMultipleOneOf(cli.NamesArgumentName, flags.AppFlagName),
+		},
+		{
+			Name: "app with export",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				App:       "my-app",
+				Export:    true,
+			},
+			ExpectFieldErrors: validation.ErrMultipleOneOf(flags.ExportFlagName, flags.AppFlagName),
+		},
+		{
+			Name: "invalid app",
+			Validatable: &commands.WorkloadGetOptions{
+				Namespace: "default",
+				App:       "my-",
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("my-", flags.AppFlagName),
 		},
 	}
 
@@ -116,6 +261,7 @@ func TestWorkloadGetCommand(t *testing.T) {
 	_ = cartov1alpha1.AddToScheme(scheme)
 	_ = corev1.AddToScheme(scheme)
 	_ = knativeservingv1.AddToScheme(scheme)
+	_ = scanningv1beta1.AddToScheme(scheme)
 	objTimeStamp := metav1.NewTime(time.Now().AddDate(-2, 0, 0))
 
 	parent := diecartov1alpha1.WorkloadBlank.
@@ -168,6 +314,8 @@ func TestWorkloadGetCommand(t *testing.T) {
 				},
 			)
 		})
+	sourceScan, imageScan := workloadScans(defaultNamespace, workloadName)
+	ksvcRollout, revision1, revision2, revision3 := knativeServiceRollout(defaultNamespace, workloadName)
 	deliverableBlank := diecartov1alpha1.DeliverableBlank.MetadataDie(func(d *diemetav1.ObjectMetaDie) {
 		d.Name(workloadName)
 		d.Namespace(defaultNamespace)
@@ -461,6 +609,199 @@ No pods found for workload.
 
 To see logs: "tanzu apps workload tail my-workload --timestamp --since 1h"
 
+`,
+		}, {
+			Name: "show latest run",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.ConditionsDie(
+							diecartov1alpha1.WorkloadConditionReadyBlank.
+								Status(metav1.ConditionTrue).Reason("Ready").
+								Message(""),
+						).SupplyChainRef(cartov1alpha1.ObjectReference{
+							APIVersion: "supplychains.tanzu.vmware.com/v1alpha1",
+							Kind:       "SupplyChain",
+							Name:       "my-supply-chain",
+							Namespace:  defaultNamespace,
+						})
+						d.Resources(
+							diecartov1alpha1.RealizedResourceBlank.
+								Name("source-tester").
+								StampedRef(&cartov1alpha1.StampedRef{
+									ObjectReference: &corev1.ObjectReference{APIVersion: "carto.run/v1alpha1", Kind: cartov1alpha1.RunnableKind, Namespace: defaultNamespace, Name: workloadName},
+									Resource:        "runnables.carto.run",
+								}).
+								DieRelease(),
+						)
+					}),
+				&cartov1alpha1.Runnable{
+					ObjectMeta: metav1.ObjectMeta{Namespace: defaultNamespace, Name: workloadName},
+					Spec: cartov1alpha1.RunnableSpec{
+						RunTemplateRef: cartov1alpha1.RunTemplateReference{Kind: "ClusterRunTemplate", Name: "tekton-taskrun"},
+					},
+					Status: cartov1alpha1.RunnableStatus{
+						OwnerStatus: cartov1alpha1.OwnerStatus{
+							Conditions: []metav1.Condition{{Type: cartov1alpha1.RunnableConditionReady, Status: metav1.ConditionFalse, Reason: "SucceededCondition"}},
+						},
+					},
+				},
+				runnableRun("TaskRun", workloadName+"-p4hzn", workloadName, "True", "2023-05-04T09:30:00Z", "2023-05-04T09:33:00Z"),
+				runnableRun("TaskRun", workloadName+"-x8f2k", workloadName, "False", "2023-05-04T10:30:00Z", "2023-05-04T10:31:35Z"),
+			},
+			ExpectOutput: `
+📡 Overview
+   name:        my-workload
+   type:        <empty>
+   namespace:   default
+
+📦 Supply Chain
+   name:   my-supply-chain
+
+   NAME            READY   HEALTHY   UPDATED   RESOURCE
+   source-tester                               runnables.carto.run/my-workload
+
+🏃 Runs
+   name:           my-workload
+   run template:   tekton-taskrun
+   status:         SucceededCondition
+   latest run:     my-workload-x8f2k (TaskRun)
+   result:         Failed
+   message:        "step-test" exited with code 1 (image: "docker.io/library/gradle")
+
+🚚 Delivery
+
+   Delivery resources not found.
+
+💬 Messages
+   No messages found.
+
+No pods found for workload.
+
+To see logs: "tanzu apps workload tail my-workload --timestamp --since 1h"
+
+`,
+		}, {
+			Name: "show runnable without runs",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.SupplyChainRef(cartov1alpha1.ObjectReference{
+							Name: "my-supply-chain",
+						})
+						d.Resources(
+							diecartov1alpha1.RealizedResourceBlank.
+								Name("source-tester").
+								StampedRef(&cartov1alpha1.StampedRef{
+									ObjectReference: &corev1.ObjectReference{APIVersion: "carto.run/v1alpha1", Kind: cartov1alpha1.RunnableKind, Name: workloadName},
+									Resource:        "runnables.carto.run",
+								}).
+								DieRelease(),
+						)
+					}),
+				&cartov1alpha1.Runnable{
+					ObjectMeta: metav1.ObjectMeta{Namespace: defaultNamespace, Name: workloadName},
+					Spec: cartov1alpha1.RunnableSpec{
+						RunTemplateRef: cartov1alpha1.RunTemplateReference{Kind: "ClusterRunTemplate", Name: "tekton-taskrun"},
+					},
+				},
+			},
+			ExpectOutput: `
+📡 Overview
+   name:        my-workload
+   type:        <empty>
+   namespace:   default
+
+📦 Supply Chain
+   name:   my-supply-chain
+
+   NAME            READY   HEALTHY   UPDATED   RESOURCE
+   source-tester                               runnables.carto.run/my-workload
+
+🏃 Runs
+   name:           my-workload
+   run template:   tekton-taskrun
+   status:         <unknown>
+   No runs found.
+
+🚚 Delivery
+
+   Delivery resources not found.
+
+💬 Messages
+   No messages found.
+
+No pods found for workload.
+
+To see logs: "tanzu apps workload tail my-workload --timestamp --since 1h"
+
+`,
+		}, {
+			Name: "show scanning",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.ConditionsDie(
+							diecartov1alpha1.WorkloadConditionReadyBlank.
+								Status(metav1.ConditionFalse).Reason("HealthyConditionRule").
+								Message("policy violated"),
+						).SupplyChainRef(cartov1alpha1.ObjectReference{
+							Name: "my-supply-chain",
+						})
+						d.Resources(
+							diecartov1alpha1.RealizedResourceBlank.
+								Name("source-scanner").
+								StampedRef(&cartov1alpha1.StampedRef{
+									ObjectReference: &corev1.ObjectReference{APIVersion: "scanning.apps.tanzu.vmware.com/v1beta1", Kind: scanningv1beta1.SourceScanKind, Namespace: defaultNamespace, Name: workloadName},
+									Resource:        "sourcescans.scanning.apps.tanzu.vmware.com",
+								}).
+								DieRelease(),
+							diecartov1alpha1.RealizedResourceBlank.
+								Name("image-scanner").
+								StampedRef(&cartov1alpha1.StampedRef{
+									ObjectReference: &corev1.ObjectReference{APIVersion: "scanning.apps.tanzu.vmware.com/v1beta1", Kind: scanningv1beta1.ImageScanKind, Namespace: defaultNamespace, Name: workloadName},
+									Resource:        "imagescans.scanning.apps.tanzu.vmware.com",
+								}).
+								DieRelease(),
+						)
+					}),
+				sourceScan,
+				imageScan,
+			},
+			ExpectOutput: `
+📡 Overview
+   name:        my-workload
+   type:        <empty>
+   namespace:   default
+
+📦 Supply Chain
+   name:   my-supply-chain
+
+   NAME             READY   HEALTHY   UPDATED   RESOURCE
+   source-scanner                               sourcescans.scanning.apps.tanzu.vmware.com/my-workload
+   image-scanner                                imagescans.scanning.apps.tanzu.vmware.com/my-workload
+
+🔎 Scanning
+   NAME             KIND         POLICY        COMPLIANT   CRITICAL   HIGH   MEDIUM
+   source-scanner   SourceScan   scan-policy   True        0          0      3
+   image-scanner    ImageScan    scan-policy   False       1          2      4
+
+   ImageScan [EvaluationFailed]:   Policy violated because of 1 CVEs: CVE-2023-24538 (Critical)
+
+🚚 Delivery
+
+   Delivery resources not found.
+
+💬 Messages
+   Workload [HealthyConditionRule]:   policy violated
+
+No pods found for workload.
+
+To see logs: "tanzu apps workload tail my-workload --timestamp --since 1h"
+
 `,
 		}, {
 			Name: "no issues reported with overview type",
@@ -1306,42 +1647,28 @@ To see logs: "tanzu apps workload tail my-workload --timestamp --since 1h"
 
 `,
 		}, {
-			Name: "show pods and knative services",
+			Name: "show knative revisions and traffic",
 			Args: []string{workloadName},
 			GivenObjects: []client.Object{
 				parent.
-					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
-						d.AddLabel(apis.WorkloadTypeLabelName, "web")
-					}).
 					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
 						d.ConditionsDie(
 							diecartov1alpha1.WorkloadConditionReadyBlank.
 								Status(metav1.ConditionTrue).
-								Reason("Worked").
-								Message("Ready"),
+								Reason("Ready"),
 						).SupplyChainRef(cartov1alpha1.ObjectReference{
-							APIVersion: "supplychains.tanzu.vmware.com/v1alpha1",
-							Kind:       "SupplyChain",
-							Name:       "my-supply-chain",
-							Namespace:  defaultNamespace,
+							Name: "my-supply-chain",
 						})
 					}),
-				ksvcDieWithURL,
-				ksvcDieWithNoURL,
-				pod1Die.
-					StatusDie(func(d *diecorev1.PodStatusDie) {
-						d.Phase(corev1.PodRunning)
-					}),
-				pod2Die.
-					StatusDie(func(d *diecorev1.PodStatusDie) {
-						d.Phase(corev1.PodFailed)
-					}),
+				ksvcRollout,
+				revision1,
+				revision2,
+				revision3,
 			},
-			BuilderObjects: []client.Object{pod1Die, pod2Die},
 			ExpectOutput: `
 📡 Overview
    name:        my-workload
-   type:        web
+   type:        <empty>
    namespace:   default
 
 📦 Supply Chain
@@ -1356,9 +1683,78 @@ To see logs: "tanzu apps workload tail my-workload --timestamp --since 1h"
 💬 Messages
    No messages found.
 
-🛶 Pods
-   NAME   READY   STATUS   RESTARTS   AGE
-   pod1   0/0              0          <unknown>
+No pods found for workload.
+
+🚢 Knative Services
+   NAME          READY            URL
+   my-workload   RevisionFailed   https://my-workload.default.example.com
+
+   service:          my-workload
+   latest created:   my-workload-00003 (not ready)
+   latest ready:     my-workload-00002
+
+   NAME                READY              TRAFFIC   TAGS       AGE
+   my-workload-00003   ContainerMissing   0%        <empty>    24h
+   my-workload-00002   Ready              90%       <empty>    2d
+   my-workload-00001   Ready              10%       previous   3d
+
+To see logs: "tanzu apps workload tail my-workload --timestamp --since 1h"
+
+`,
+		}, {
+			Name: "show pods and knative services",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(apis.WorkloadTypeLabelName, "web")
+					}).
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.ConditionsDie(
+							diecartov1alpha1.WorkloadConditionReadyBlank.
+								Status(metav1.ConditionTrue).
+								Reason("Worked").
+								Message("Ready"),
+						).SupplyChainRef(cartov1alpha1.ObjectReference{
+							APIVersion: "supplychains.tanzu.vmware.com/v1alpha1",
+							Kind:       "SupplyChain",
+							Name:       "my-supply-chain",
+							Namespace:  defaultNamespace,
+						})
+					}),
+				ksvcDieWithURL,
+				ksvcDieWithNoURL,
+				pod1Die.
+					StatusDie(func(d *diecorev1.PodStatusDie) {
+						d.Phase(corev1.PodRunning)
+					}),
+				pod2Die.
+					StatusDie(func(d *diecorev1.PodStatusDie) {
+						d.Phase(corev1.PodFailed)
+					}),
+			},
+			BuilderObjects: []client.Object{pod1Die, pod2Die},
+			ExpectOutput: `
+📡 Overview
+   name:        my-workload
+   type:        web
+   namespace:   default
+
+📦 Supply Chain
+   name:   my-supply-chain
+
+   Supply Chain resources not found.
+
+🚚 Delivery
+
+   Delivery resources not found.
+
+💬 Messages
+   No messages found.
+
+🛶 Pods
+   NAME   READY   STATUS   RESTARTS   AGE
+   pod1   0/0              0          <unknown>
    pod2   0/0              0          <unknown>
 
 🚢 Knative Services
@@ -1628,6 +2024,87 @@ status:
 		"supplyChainRef": {}
 	}
 }
+`,
+		}, {
+			Name: "get workload output data in wide format",
+			Args: []string{workloadName, flags.OutputFlagName, "wide"},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(apis.AppPartOfLabelName, workloadName)
+						d.AddLabel(apis.WorkloadTypeLabelName, "web")
+						d.CreationTimestamp(metav1.NewTime(time.Now().Add(-5 * time.Hour)))
+					}).
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Source(&cartov1alpha1.Source{
+							Git: &cartov1alpha1.GitSource{
+								URL: "https://github.com/spring-projects/spring-petclinic.git",
+								Ref: cartov1alpha1.GitRef{Branch: "main"},
+							},
+						})
+					}).
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.SupplyChainRef(cartov1alpha1.ObjectReference{Kind: "ClusterSupplyChain", Name: "source-to-url"})
+						d.ConditionsDie(
+							diecartov1alpha1.WorkloadConditionReadyBlank.
+								Status(metav1.ConditionFalse).
+								Reason("HealthyConditionRule"),
+						)
+					}),
+				ksvcDieWithURL,
+				ksvcDieWithNoURL,
+			},
+			ExpectOutput: `
+NAME          TYPE   APP           READY                  AGE   SOURCE                                                         SUPPLY CHAIN    REASON                 URL
+my-workload   web    my-workload   HealthyConditionRule   5h    https://github.com/spring-projects/spring-petclinic.git@main   source-to-url   HealthyConditionRule   https://example.com
+`,
+		}, {
+			Name: "get workload output data in name format",
+			Args: []string{workloadName, flags.OutputFlagName, "name"},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ExpectOutput: `
+workload.carto.run/my-workload
+`,
+		}, {
+			Name: "get workload output data with jsonpath",
+			Args: []string{workloadName, flags.OutputFlagName, `jsonpath={.metadata.name} {.status.conditions[?(@.type=="Ready")].reason}`},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.ConditionsDie(
+							diecartov1alpha1.WorkloadConditionReadyBlank.
+								Status(metav1.ConditionFalse).
+								Reason("HealthyConditionRule"),
+						)
+					}),
+			},
+			ExpectOutput: `
+my-workload HealthyConditionRule
+`,
+		}, {
+			Name: "get workload output data with custom columns",
+			Args: []string{workloadName, flags.OutputFlagName, "custom-columns=NAME:.metadata.name,TYPE:.metadata.labels.apps\\.tanzu\\.vmware\\.com/workload-type"},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(apis.WorkloadTypeLabelName, "web")
+					}),
+			},
+			ExpectOutput: `
+NAME          TYPE
+my-workload   web
+`,
+		}, {
+			Name: "get workload output data with failing go-template",
+			Args: []string{workloadName, flags.OutputFlagName, "go-template={{.metadata.name.first}}"},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ShouldError: true,
+			ExpectOutput: `
+Failed to output workload: template: go-template:1:11: executing "go-template" at <.metadata.name.first>: can't evaluate field first in type interface {}
 `,
 		}, {
 			Name: "show healthy rule condition issue from workload and deliverable",
@@ -2084,6 +2561,411 @@ Knative Services
 
 To see logs: "tanzu apps workload tail my-workload --timestamp --since 1h"
 
+`,
+		}, {
+			Name: "show outputs",
+			Args: []string{workloadName, flags.OutputsFlagName},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.ConditionsDie(
+							diecartov1alpha1.WorkloadConditionReadyBlank.
+								Status(metav1.ConditionTrue).
+								Reason("Ready"),
+						)
+						d.Resources(
+							diecartov1alpha1.RealizedResourceBlank.
+								Name("source-provider").
+								Outputs(cartov1alpha1.Output{
+									Name:    "revision",
+									Preview: "main/abcdef",
+									Digest:  "sha256:9e1d0b",
+								}).
+								ConditionsResourceHealthyReadyTrueDie().
+								DieRelease(),
+							diecartov1alpha1.RealizedResourceBlank.
+								Name("image-provider").
+								Inputs(cartov1alpha1.Input{Name: "source-provider"}).
+								Outputs(cartov1alpha1.Output{
+									Name:    "image",
+									Preview: "registry.example.com/my-workload@sha256:c0ffee",
+									Digest:  "sha256:a1b2c3",
+								}).
+								ConditionsResourceHealthyReadyTrueDie().
+								DieRelease(),
+						)
+					}),
+			},
+			ExpectOutput: `
+📡 Overview
+   name:        my-workload
+   type:        <empty>
+   namespace:   default
+
+📦 Supply Chain
+   name:   <none>
+
+   NAME              READY   HEALTHY   UPDATED     RESOURCE
+   source-provider   True    True      <unknown>   not found
+   image-provider    True    True      <unknown>   not found
+
+🔗 Outputs
+   RESOURCE          INPUTS            OUTPUT     PREVIEW                                          DIGEST          UPDATED
+   source-provider   <none>            revision   main/abcdef                                      sha256:9e1d0b   <unknown>
+   image-provider    source-provider   image      registry.example.com/my-workload@sha256:c0ffee   sha256:a1b2c3   <unknown>
+
+🚚 Delivery
+
+   Delivery resources not found.
+
+💬 Messages
+   No messages found.
+
+No pods found for workload.
+
+To see logs: "tanzu apps workload tail my-workload --timestamp --since 1h"
+
+`,
+		}, {
+			Name: "show outputs without resources",
+			Args: []string{workloadName, flags.OutputsFlagName},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ExpectOutput: `
+📡 Overview
+   name:        my-workload
+   type:        <empty>
+   namespace:   default
+
+Supply Chain reference not found.
+
+   Supply Chain resources not found.
+
+🔗 Outputs
+   Supply Chain outputs not found.
+
+🚚 Delivery
+
+   Delivery resources not found.
+
+💬 Messages
+   No messages found.
+
+No pods found for workload.
+
+To see logs: "tanzu apps workload tail my-workload --timestamp --since 1h"
+
+`,
+		}, {
+			Name: "get workload output data with related resources in yaml format",
+			Args: []string{workloadName, flags.OutputFlagName, "yaml", flags.RelatedFlagName},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.ConditionsDie(
+							diecartov1alpha1.WorkloadConditionReadyBlank.
+								Status(metav1.ConditionTrue).
+								Reason("Ready"),
+						)
+					}),
+				ksvcDieWithURL,
+				pod1Die,
+			},
+			BuilderObjects: []client.Object{pod1Die},
+			ExpectOutput: `
+---
+deliverable: null
+issues: []
+knativeServices:
+- apiVersion: serving.knative.dev/v1
+  kind: Service
+  metadata:
+    creationTimestamp: "1970-01-01T00:00:01Z"
+    labels:
+      carto.run/workload-name: my-workload
+    name: ksvc1
+    namespace: default
+    resourceVersion: "999"
+  status:
+    conditions:
+    - lastTransitionTime: null
+      message: ""
+      reason: ""
+      status: "True"
+      type: Ready
+    url: https://example.com
+pods:
+- age: <unknown>
+  name: pod1
+  ready: 0/0
+  restarts: 0
+  status: ""
+ready: true
+workload:
+  apiVersion: carto.run/v1alpha1
+  kind: Workload
+  metadata:
+    creationTimestamp: "1970-01-01T00:00:01Z"
+    name: my-workload
+    namespace: default
+    resourceVersion: "999"
+  spec: {}
+  status:
+    conditions:
+    - lastTransitionTime: null
+      message: ""
+      reason: Ready
+      status: "True"
+      type: Ready
+    supplyChainRef: {}
+`,
+		}, {
+			Name: "get workload output data with related deliverable and issues in json format",
+			Args: []string{workloadName, flags.OutputFlagName, "json", flags.RelatedFlagName},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.ConditionsDie(
+							diecartov1alpha1.CreateConditionReadyTrue("Ready", ""),
+						)
+						d.Resources(
+							diecartov1alpha1.RealizedResourceBlank.
+								Name("deliverable").
+								StampedRef(&cartov1alpha1.StampedRef{
+									ObjectReference: &corev1.ObjectReference{
+										Kind:      cartov1alpha1.DeliverableKind,
+										Namespace: defaultNamespace,
+										Name:      workloadName,
+									},
+								}).
+								DieRelease(),
+						)
+					}),
+				deliverableBlank.
+					StatusDie(func(d *diecartov1alpha1.DeliverableStatusDie) {
+						d.ConditionsDie(
+							diecartov1alpha1.CreateConditionReadyUnknown(
+								"OopsieDoodle",
+								"a hopefully informative message about what went wrong"),
+						)
+					}),
+			},
+			ExpectOutput: `
+{
+	"workload": {
+		"apiVersion": "carto.run/v1alpha1",
+		"kind": "Workload",
+		"metadata": {
+			"creationTimestamp": "1970-01-01T00:00:01Z",
+			"name": "my-workload",
+			"namespace": "default",
+			"resourceVersion": "999"
+		},
+		"spec": {},
+		"status": {
+			"conditions": [
+				{
+					"lastTransitionTime": null,
+					"message": "",
+					"reason": "Ready",
+					"status": "True",
+					"type": "Ready"
+				}
+			],
+			"resources": [
+				{
+					"name": "deliverable",
+					"stampedRef": {
+						"kind": "Deliverable",
+						"name": "my-workload",
+						"namespace": "default"
+					}
+				}
+			],
+			"supplyChainRef": {}
+		}
+	},
+	"deliverable": {
+		"apiVersion": "carto.run/v1alpha1",
+		"kind": "Deliverable",
+		"metadata": {
+			"creationTimestamp": "1970-01-01T00:00:01Z",
+			"name": "my-workload",
+			"namespace": "default",
+			"resourceVersion": "999"
+		},
+		"spec": {},
+		"status": {
+			"conditions": [
+				{
+					"lastTransitionTime": null,
+					"message": "a hopefully informative message about what went wrong",
+					"reason": "OopsieDoodle",
+					"status": "Unknown",
+					"type": "Ready"
+				}
+			],
+			"deliveryRef": {}
+		}
+	},
+	"pods": [],
+	"knativeServices": [],
+	"issues": [
+		{
+			"kind": "Deliverable",
+			"reason": "OopsieDoodle",
+			"message": "a hopefully informative message about what went wrong"
+		}
+	],
+	"ready": false
+}
+`,
+		}, {
+			Name: "get workload output data with related resources and jsonpath",
+			Args: []string{workloadName, flags.OutputFlagName, "jsonpath={.ready}", flags.RelatedFlagName},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ExpectOutput: `
+false
+`,
+		}, {
+			Name: "get multiple workloads in name format",
+			Args: []string{workloadName, "my-other-workload", flags.OutputFlagName, "name"},
+			GivenObjects: []client.Object{
+				parent,
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("my-other-workload")
+					}),
+			},
+			ExpectOutput: `
+workload.carto.run/my-workload
+workload.carto.run/my-other-workload
+`,
+		}, {
+			Name: "get multiple workloads with one not found",
+			Args: []string{"my-missing-workload", workloadName, flags.OutputFlagName, "name"},
+			GivenObjects: []client.Object{
+				parent,
+				diecorev1.NamespaceBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name(defaultNamespace)
+					}),
+			},
+			ShouldError: true,
+			ExpectOutput: `
+workload.carto.run/my-workload
+`,
+			ExpectStderr: `
+Workload "default/my-missing-workload" not found
+`,
+		}, {
+			Name: "get multiple workloads with related resources",
+			Args: []string{workloadName, "my-other-workload", flags.OutputFlagName, "jsonpath={range [*]}{.workload.metadata.name} {.ready}{\"\\n\"}{end}", flags.RelatedFlagName},
+			GivenObjects: []client.Object{
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.ConditionsDie(
+							diecartov1alpha1.WorkloadConditionReadyBlank.Status(metav1.ConditionTrue),
+						)
+					}),
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("my-other-workload")
+					}),
+			},
+			ExpectOutput: `
+my-workload true
+my-other-workload false
+`,
+		}, {
+			Name: "get workloads part of an app",
+			Args: []string{flags.AppFlagName, "my-app", flags.OutputFlagName, "custom-columns=NAME:.metadata.name,APP:.metadata.labels.app\\.kubernetes\\.io/part-of"},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(apis.AppPartOfLabelName, "my-app")
+					}),
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("my-other-workload")
+						d.AddLabel(apis.AppPartOfLabelName, "my-app")
+					}),
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("my-unrelated-workload")
+						d.AddLabel(apis.AppPartOfLabelName, "other-app")
+					}),
+			},
+			ExpectOutput: `
+NAME                APP
+my-other-workload   my-app
+my-workload         my-app
+`,
+		}, {
+			Name: "get workloads part of an app with details",
+			Args: []string{flags.AppFlagName, "my-app"},
+			GivenObjects: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(apis.AppPartOfLabelName, "my-app")
+					}),
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("my-other-workload")
+						d.AddLabel(apis.AppPartOfLabelName, "my-app")
+					}),
+			},
+			ExpectOutput: `
+📡 Overview
+   name:        my-other-workload
+   type:        <empty>
+   namespace:   default
+
+Supply Chain reference not found.
+
+   Supply Chain resources not found.
+
+🚚 Delivery
+
+   Delivery resources not found.
+
+💬 Messages
+   No messages found.
+
+No pods found for workload.
+
+To see logs: "tanzu apps workload tail my-other-workload --timestamp --since 1h"
+
+📡 Overview
+   name:        my-workload
+   type:        <empty>
+   namespace:   default
+
+Supply Chain reference not found.
+
+   Supply Chain resources not found.
+
+🚚 Delivery
+
+   Delivery resources not found.
+
+💬 Messages
+   No messages found.
+
+No pods found for workload.
+
+To see logs: "tanzu apps workload tail my-workload --timestamp --since 1h"
+
+`,
+		}, {
+			Name:        "get workloads part of an app without workloads",
+			Args:        []string{flags.AppFlagName, "my-app"},
+			ShouldError: true,
+			ExpectStderr: `
+No workloads found.
 `,
 		},
 	}
@@ -0,0 +1,354 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	cliprinter "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+const (
+	// workloadHistoryDataKey is the key in the history ConfigMap holding the recorded revisions
+	workloadHistoryDataKey = "revisions"
+	// maxWorkloadRevisions is the number of revisions kept in the history of a workload
+	maxWorkloadRevisions = 10
+)
+
+// WorkloadRevision is a spec applied to a workload, as recorded in the workload history
+type WorkloadRevision struct {
+	// Revision is the sequence number of the revision, starting at 1
+	Revision int `json:"revision"`
+	// Timestamp is the time the spec was applied
+	Timestamp metav1.Time `json:"timestamp"`
+	// Manager is the field manager that applied the spec
+	Manager string `json:"manager,omitempty"`
+	// ChangedFields are the paths of the fields changed from the previous revision
+	ChangedFields []string `json:"changedFields,omitempty"`
+	// Spec is the workload spec applied in this revision
+	Spec cartov1alpha1.WorkloadSpec `json:"spec"`
+}
+
+type WorkloadHistoryOptions struct {
+	Namespace string
+	Name      string
+
+	Output string
+}
+
+var (
+	_ validation.Validatable = (*WorkloadHistoryOptions)(nil)
+	_ cli.Executable         = (*WorkloadHistoryOptions)(nil)
+)
+
+func (opts *WorkloadHistoryOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	if opts.Namespace == "" {
+		errs = errs.Also(validation.ErrMissingField(flags.NamespaceFlagName))
+	}
+
+	if opts.Name == "" {
+		errs = errs.Also(validation.ErrMissingField(cli.NameArgumentName))
+	}
+
+	if opts.Output != "" {
+		errs = errs.Also(validation.Enum(opts.Output, flags.OutputFlagName, []string{printer.OutputFormatJson, printer.OutputFormatYaml, printer.OutputFormatYml}))
+	}
+
+	return errs
+}
+
+func (opts *WorkloadHistoryOptions) Exec(ctx context.Context, c *cli.Config) error {
+	workload, err := getWorkload(ctx, c, opts.Namespace, opts.Name)
+	if err != nil {
+		return err
+	}
+
+	_, revisions, err := loadWorkloadHistory(ctx, c, workload)
+	if err != nil {
+		return err
+	}
+
+	if opts.Output != "" {
+		export, err := printer.OutputObject(revisions, printer.OutputFormat(opts.Output))
+		if err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Failed to output workload history:"), err)
+			return cli.SilenceError(err)
+		}
+		c.Printf("%s\n", export)
+		return nil
+	}
+
+	if len(revisions) == 0 {
+		c.Infof("No revision history found for workload %q\n", workload.Name)
+		return nil
+	}
+
+	c.Emoji(cli.Antenna, cliprinter.Sboldf("History\n"))
+	if err := opts.printTable(c, workload, revisions); err != nil {
+		return err
+	}
+	c.Printf("\n")
+	c.Infof("To roll back to a revision run: tanzu apps workload rollback %s %s <revision>\n", workload.Name, flags.ToRevisionFlagName)
+
+	return nil
+}
+
+func NewWorkloadHistoryCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &WorkloadHistoryOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "Show the revision history of a workload",
+		Long: strings.TrimSpace(`
+Show the revisions recorded for a workload. A revision is recorded each time the
+workload spec is created or updated with this plugin, keeping the last 10 revisions.
+
+For each revision the time it was applied, the field manager that applied it and the
+fields changed from the previous revision are displayed.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s workload history my-workload", c.Name),
+		}, "\n"),
+		PreRunE:           cli.ValidateE(ctx, opts),
+		RunE:              cli.ExecE(ctx, c, opts),
+		ValidArgsFunction: completion.SuggestWorkloadNames(ctx, c),
+	}
+
+	cli.Args(cmd,
+		cli.NameArg(&opts.Name),
+	)
+
+	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the revisions formatted. Supported formats: \"json\", \"yaml\", \"yml\"")
+
+	return cmd
+}
+
+func (opts *WorkloadHistoryOptions) printTable(c *cli.Config, workload *cartov1alpha1.Workload, revisions []WorkloadRevision) error {
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{
+		PaddingStart: printer.PaddingStart,
+	}).With(func(h table.PrintHandler) {
+		columns := []metav1beta1.TableColumnDefinition{
+			{Name: "Revision", Type: "string"},
+			{Name: "Timestamp", Type: "string"},
+			{Name: "Manager", Type: "string"},
+			{Name: "Changed Fields", Type: "string"},
+		}
+		h.TableHandler(columns, func(workload *cartov1alpha1.Workload, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+			rows := make([]metav1beta1.TableRow, 0, len(revisions))
+			for _, r := range revisions {
+				timestamp := ""
+				if !r.Timestamp.IsZero() {
+					timestamp = r.Timestamp.UTC().Format(time.RFC3339)
+				}
+				rows = append(rows, metav1beta1.TableRow{
+					Cells: []interface{}{
+						strconv.Itoa(r.Revision),
+						cliprinter.EmptyString(timestamp),
+						cliprinter.EmptyString(r.Manager),
+						cliprinter.EmptyString(strings.Join(r.ChangedFields, ", ")),
+					},
+				})
+			}
+			return rows, nil
+		})
+	})
+
+	return tablePrinter.PrintObj(workload, c.Stdout)
+}
+
+// workloadHistoryName returns the name of the ConfigMap holding the history of the workload
+func workloadHistoryName(workloadName string) string {
+	return fmt.Sprintf("%s.workload-history", workloadName)
+}
+
+// loadWorkloadHistory returns the ConfigMap holding the history of the workload, nil if there is
+// no history yet, and the revisions recorded in it
+func loadWorkloadHistory(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload) (*corev1.ConfigMap, []WorkloadRevision, error) {
+	history := &corev1.ConfigMap{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: workload.Namespace, Name: workloadHistoryName(workload.Name)}, history); err != nil {
+		if apierrs.IsNotFound(err) {
+			return nil, []WorkloadRevision{}, nil
+		}
+		return nil, nil, err
+	}
+
+	revisions := []WorkloadRevision{}
+	if data := history.Data[workloadHistoryDataKey]; data != "" {
+		if err := json.Unmarshal([]byte(data), &revisions); err != nil {
+			return nil, nil, fmt.Errorf("unable to read history of workload %q: %w", workload.Name, err)
+		}
+	}
+	return history, revisions, nil
+}
+
+// recordWorkloadRevision appends the spec of a workload that was just created or updated to its
+// history. Failing to record the revision does not fail the command, a warning is displayed instead,
+// on stderr when shouldPrint is false as stdout is then reserved for the workload document
+func recordWorkloadRevision(ctx context.Context, c *cli.Config, currentWorkload, workload *cartov1alpha1.Workload, shouldPrint bool) {
+	if err := saveWorkloadRevision(ctx, c, currentWorkload, workload); err != nil {
+		printWarning(c, shouldPrint, "unable to record workload revision: %s\n", err)
+	}
+}
+
+func saveWorkloadRevision(ctx context.Context, c *cli.Config, currentWorkload, workload *cartov1alpha1.Workload) error {
+	// the history is owned by the workload so it is deleted along with it, a workload
+	// without uid was not persisted by the api server and can not own it
+	if workload.UID == "" {
+		return nil
+	}
+
+	history, revisions, err := loadWorkloadHistory(ctx, c, workload)
+	if err != nil {
+		return err
+	}
+
+	// workloads updated before the history was recorded start with their previous spec
+	if len(revisions) == 0 && currentWorkload != nil {
+		revisions = append(revisions, newWorkloadRevision(currentWorkload, 1, nil))
+	}
+
+	var previous *WorkloadRevision
+	if len(revisions) != 0 {
+		previous = &revisions[len(revisions)-1]
+		if equality.Semantic.DeepEqual(withoutRebuildParam(previous.Spec), withoutRebuildParam(workload.Spec)) {
+			return nil
+		}
+	}
+
+	next := 1
+	changedFields := []string{}
+	if previous != nil {
+		next = previous.Revision + 1
+		changes, err := printer.ResourceDiffFields(
+			&cartov1alpha1.Workload{Spec: withoutRebuildParam(previous.Spec)},
+			&cartov1alpha1.Workload{Spec: withoutRebuildParam(workload.Spec)},
+			c.Scheme,
+		)
+		if err != nil {
+			return err
+		}
+		for _, change := range changes {
+			changedFields = append(changedFields, change.Path)
+		}
+	}
+	revisions = append(revisions, newWorkloadRevision(workload, next, changedFields))
+	if len(revisions) > maxWorkloadRevisions {
+		revisions = revisions[len(revisions)-maxWorkloadRevisions:]
+	}
+
+	data, err := json.Marshal(revisions)
+	if err != nil {
+		return err
+	}
+
+	if history == nil {
+		history = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: workload.Namespace,
+				Name:      workloadHistoryName(workload.Name),
+				Labels: map[string]string{
+					cartov1alpha1.WorkloadLabelName: workload.Name,
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(workload, cartov1alpha1.SchemeGroupVersion.WithKind("Workload")),
+				},
+			},
+			Data: map[string]string{
+				workloadHistoryDataKey: string(data),
+			},
+		}
+		return c.Create(ctx, history)
+	}
+
+	if history.Data == nil {
+		history.Data = map[string]string{}
+	}
+	history.Data[workloadHistoryDataKey] = string(data)
+	return c.Update(ctx, history)
+}
+
+// withoutRebuildParam returns a copy of the spec without the counter bumped by the rebuild
+// command, which is not a change applied by the user
+func withoutRebuildParam(spec cartov1alpha1.WorkloadSpec) cartov1alpha1.WorkloadSpec {
+	spec = *spec.DeepCopy()
+	spec.RemoveParam(cartov1alpha1.WorkloadRebuildParam)
+	if len(spec.Params) == 0 {
+		spec.Params = nil
+	}
+	return spec
+}
+
+// newWorkloadRevision creates a revision for the spec of the workload, the time and manager of
+// the revision are taken from the most recent managed fields entry that applied or updated the
+// spec. Entries of the status subresource, written by the controller after each update, are ignored
+func newWorkloadRevision(workload *cartov1alpha1.Workload, revision int, changedFields []string) WorkloadRevision {
+	r := WorkloadRevision{
+		Revision:      revision,
+		ChangedFields: changedFields,
+		Spec:          *workload.Spec.DeepCopy(),
+	}
+	for _, entry := range workload.ManagedFields {
+		if !managesWorkloadSpec(entry) {
+			continue
+		}
+		if entry.Time != nil && (r.Timestamp.IsZero() || r.Timestamp.Before(entry.Time)) {
+			r.Timestamp = *entry.Time
+			r.Manager = entry.Manager
+		}
+	}
+	return r
+}
+
+// managesWorkloadSpec returns true when the managed fields entry applied or updated fields of the
+// workload spec
+func managesWorkloadSpec(entry metav1.ManagedFieldsEntry) bool {
+	if entry.Subresource != "" || entry.FieldsV1 == nil {
+		return false
+	}
+	if entry.Operation != metav1.ManagedFieldsOperationApply && entry.Operation != metav1.ManagedFieldsOperationUpdate {
+		return false
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+		return false
+	}
+	_, ok := fields["f:spec"]
+	return ok
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	diecorev1 "dies.dev/apis/core/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+// workloadHistory returns the ConfigMap holding the given revisions for the workload
+func workloadHistory(workload *cartov1alpha1.Workload, revisions ...commands.WorkloadRevision) *corev1.ConfigMap {
+	data, _ := json.Marshal(revisions)
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: workload.Namespace,
+			Name:      workload.Name + ".workload-history",
+			Labels: map[string]string{
+				cartov1alpha1.WorkloadLabelName: workload.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(workload, cartov1alpha1.SchemeGroupVersion.WithKind("Workload")),
+			},
+		},
+		Data: map[string]string{
+			"revisions": string(data),
+		},
+	}
+}
+
+func TestWorkloadHistoryOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:        "empty",
+			Validatable: &commands.WorkloadHistoryOptions{},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMissingField(flags.NamespaceFlagName),
+				validation.ErrMissingField(cli.NameArgumentName),
+			),
+		},
+		{
+			Name: "valid",
+			Validatable: &commands.WorkloadHistoryOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid output",
+			Validatable: &commands.WorkloadHistoryOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Output:    "table",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("table", flags.OutputFlagName, []string{"json", "yaml", "yml"}),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestWorkloadHistoryCommand(t *testing.T) {
+	defaultNamespace := "default"
+	workloadName := "my-workload"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	givenNamespaceDefault := diecorev1.NamespaceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(defaultNamespace)
+		})
+
+	parent := diecartov1alpha1.WorkloadBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(workloadName)
+			d.Namespace(defaultNamespace)
+			d.UID(types.UID("my-workload-uid"))
+		}).
+		SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+			d.Image("registry.example/my-workload:v2")
+			d.Env(corev1.EnvVar{Name: "FOO", Value: "bar"})
+		})
+
+	history := workloadHistory(parent.DieReleasePtr(),
+		commands.WorkloadRevision{
+			Revision:  1,
+			Timestamp: metav1.NewTime(time.Date(2023, time.May, 4, 10, 30, 0, 0, time.UTC)),
+			Manager:   "tanzu",
+			Spec: cartov1alpha1.WorkloadSpec{
+				Image: "registry.example/my-workload:v1",
+			},
+		},
+		commands.WorkloadRevision{
+			Revision:      2,
+			Timestamp:     metav1.NewTime(time.Date(2023, time.May, 5, 8, 0, 0, 0, time.UTC)),
+			Manager:       "kubectl-edit",
+			ChangedFields: []string{"spec.env", "spec.image"},
+			Spec:          parent.DieRelease().Spec,
+		},
+	)
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "invalid args",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name:         "workload not found",
+			Args:         []string{workloadName},
+			GivenObjects: []client.Object{givenNamespaceDefault},
+			ShouldError:  true,
+			ExpectOutput: `
+Workload "default/my-workload" not found
+`,
+		},
+		{
+			Name:         "no history",
+			Args:         []string{workloadName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent},
+			ExpectOutput: `
+No revision history found for workload "my-workload"
+`,
+		},
+		{
+			Name:         "show history",
+			Args:         []string{workloadName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent, history},
+			ExpectOutput: `
+📡 History
+   REVISION   TIMESTAMP              MANAGER        CHANGED FIELDS
+   1          2023-05-04T10:30:00Z   tanzu          <empty>
+   2          2023-05-05T08:00:00Z   kubectl-edit   spec.env, spec.image
+
+To roll back to a revision run: tanzu apps workload rollback my-workload --to-revision <revision>
+`,
+		},
+		{
+			Name:         "show history as yaml",
+			Args:         []string{workloadName, flags.OutputFlagName, "yaml"},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent, history},
+			ExpectOutput: `
+---
+- manager: tanzu
+  revision: 1
+  spec:
+    image: registry.example/my-workload:v1
+  timestamp: "2023-05-04T10:30:00Z"
+- changedFields:
+  - spec.env
+  - spec.image
+  manager: kubectl-edit
+  revision: 2
+  spec:
+    env:
+    - name: FOO
+      value: bar
+    image: registry.example/my-workload:v2
+  timestamp: "2023-05-05T08:00:00Z"
+`,
+		},
+		{
+			Name:         "get history failed",
+			Args:         []string{workloadName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent, history},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "ConfigMap"),
+			},
+			ShouldError: true,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewWorkloadHistoryCommand)
+}
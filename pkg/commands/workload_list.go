@@ -17,33 +17,60 @@ limitations under the License.
 package commands
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
+	"os"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	apiwatch "k8s.io/apimachinery/pkg/watch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
 	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	knativeservingv1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/knative/serving/v1"
 	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/watch"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
 )
 
+const (
+	workloadSortByName  = "name"
+	workloadSortByAge   = "age"
+	workloadSortByReady = "ready"
+	workloadSortByType  = "type"
+)
+
 type WorkloadListOptions struct {
 	Namespace     string
 	AllNamespaces bool
 	App           string
+	Selector      string
+	Type          string
+	Ready         string
+	SupplyChain   string
+	SortBy        string
 	Output        string
+	Watch         bool
+	UntilAllReady bool
+
+	// urls of the knative services of each workload, only resolved for the wide output
+	urls map[types.NamespacedName][]string
 }
 
 var (
@@ -65,24 +92,64 @@ func (opts *WorkloadListOptions) Validate(ctx context.Context) validation.FieldE
 		errs = errs.Also(validation.K8sName(opts.App, flags.AppFlagName))
 	}
 
+	if opts.Selector != "" {
+		if _, err := labels.Parse(opts.Selector); err != nil {
+			errs = errs.Also(validation.ErrInvalidValue(opts.Selector, flags.SelectorFlagName))
+		}
+	}
+
+	if opts.Type != "" {
+		errs = errs.Also(validation.K8sName(opts.Type, flags.TypeFlagName))
+	}
+
+	if opts.Ready != "" {
+		errs = errs.Also(validation.Enum(opts.Ready, flags.ReadyFlagName, []string{"true", "false", "unknown"}))
+	}
+
+	if opts.SupplyChain != "" {
+		errs = errs.Also(validation.K8sName(opts.SupplyChain, flags.SupplyChainFlagName))
+	}
+
+	if opts.SortBy != "" {
+		errs = errs.Also(validation.Enum(opts.SortBy, flags.SortByFlagName, []string{workloadSortByName, workloadSortByAge, workloadSortByReady, workloadSortByType}))
+	}
+
 	if opts.Output != "" {
-		errs = errs.Also(validation.Enum(opts.Output, flags.OutputFlagName, []string{printer.OutputFormatJson, printer.OutputFormatYaml, printer.OutputFormatYml}))
+		errs = errs.Also(validation.OutputFormat(opts.Output, flags.OutputFlagName, printer.TableOutputFormats))
+	}
+
+	if opts.Watch && opts.Output != "" && opts.Output != printer.OutputFormatWide {
+		errs = errs.Also(validation.ErrMultipleOneOf(flags.WatchFlagName, flags.OutputFlagName))
+	}
+
+	if opts.UntilAllReady && !opts.Watch {
+		errs = errs.Also(validation.ErrMissingField(flags.WatchFlagName))
 	}
 
 	return errs
 }
 
 func (opts *WorkloadListOptions) Exec(ctx context.Context, c *cli.Config) error {
-	workloads := &cartov1alpha1.WorkloadList{}
-	labels := map[string]string{}
-	if opts.App != "" {
-		labels[apis.AppPartOfLabelName] = opts.App
+	selector, err := opts.labelSelector()
+	if err != nil {
+		return err
 	}
-	if err := c.List(ctx, workloads, client.InNamespace(opts.Namespace), client.MatchingLabels(labels)); err != nil {
+	list := &cartov1alpha1.WorkloadList{}
+	if err := c.List(ctx, list, client.InNamespace(opts.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
 		return err
 	}
 
-	if opts.Output != "" {
+	// the ready status and the supply chain are part of the status, which can not be selected
+	// by the api server
+	workloads := &cartov1alpha1.WorkloadList{}
+	for _, workload := range list.Items {
+		if opts.matchesStatus(&workload) {
+			workloads.Items = append(workloads.Items, workload)
+		}
+	}
+	opts.sort(workloads.Items)
+
+	if opts.Output != "" && opts.Output != printer.OutputFormatWide {
 		var list []printer.Object
 		for i := range workloads.Items {
 			list = append(list, &workloads.Items[i])
@@ -103,22 +170,255 @@ func (opts *WorkloadListOptions) Exec(ctx context.Context, c *cli.Config) error
 			c.Eprintf("%s %s\n", printer.Serrorf("Error:"), fmt.Sprintf("namespace %q not found, it may not exist or user does not have permissions to read it.", opts.Namespace))
 			return cli.SilenceError(getErr)
 		}
+	}
+
+	if opts.Watch {
+		return opts.watchWorkloads(ctx, c, selector, list.ResourceVersion, workloads)
+	}
+
+	if len(workloads.Items) == 0 {
 		c.Infof("No workloads found.\n")
 		return nil
 	}
 
+	return opts.printTable(ctx, c, c.Stdout, workloads, false)
+}
+
+// watchWorkloads keeps the table of workloads up to date as they are added, modified and
+// deleted, until the command is interrupted or, with --until-all-ready, every listed workload
+// is ready. The table is re-rendered in place when stdout is a terminal, otherwise the rows
+// that changed are appended.
+func (opts *WorkloadListOptions) watchWorkloads(ctx context.Context, c *cli.Config, selector labels.Selector, resourceVersion string, workloads *cartov1alpha1.WorkloadList) error {
+	clientWithWatch, err := watch.GetWatcher(ctx, c)
+	if err != nil {
+		return err
+	}
+	watcher, err := clientWithWatch.Watch(ctx, &cartov1alpha1.WorkloadList{}, client.InNamespace(opts.Namespace), client.MatchingLabelsSelector{Selector: selector}, &client.ListOptions{
+		Raw: &metav1.ListOptions{ResourceVersion: resourceVersion},
+	})
+	if err != nil {
+		return err
+	}
+	defer watcher.Stop()
+
+	current := map[types.NamespacedName]cartov1alpha1.Workload{}
+	for _, workload := range workloads.Items {
+		current[types.NamespacedName{Namespace: workload.Namespace, Name: workload.Name}] = workload
+	}
+	readyCondition, _ := parseWorkloadWaitCondition(waitForReady)
+	allReady := func() bool {
+		for _, workload := range current {
+			if ready, err := readyCondition.evaluate(&workload); !ready || err != nil {
+				return false
+			}
+		}
+		return len(current) != 0
+	}
+
+	inPlace := isTerminal(c.Stdout)
+	renderedLines := 0
+	headerPrinted := false
+	render := func(changed *cartov1alpha1.Workload) error {
+		if !inPlace && changed != nil {
+			// append the changed row, the header is only printed once
+			if err := opts.printTable(ctx, c, c.Stdout, &cartov1alpha1.WorkloadList{Items: []cartov1alpha1.Workload{*changed}}, headerPrinted); err != nil {
+				return err
+			}
+			headerPrinted = true
+			return nil
+		}
+
+		list := &cartov1alpha1.WorkloadList{}
+		for _, workload := range current {
+			list.Items = append(list.Items, workload)
+		}
+		opts.sort(list.Items)
+		buf := &bytes.Buffer{}
+		if len(list.Items) == 0 {
+			printer.InfoColor.Fprintf(buf, "No workloads found.\n")
+		} else {
+			if err := opts.printTable(ctx, c, buf, list, false); err != nil {
+				return err
+			}
+			headerPrinted = true
+		}
+		if renderedLines != 0 {
+			// move the cursor to the start of the previous table and clear it
+			c.Printf("\033[%dA\033[J", renderedLines)
+		}
+		renderedLines = strings.Count(buf.String(), "\n")
+		c.Printf("%s", buf.String())
+		return nil
+	}
+
+	if err := render(nil); err != nil {
+		return err
+	}
+	if opts.UntilAllReady && allReady() {
+		c.Successf("All workloads are ready\n")
+		return nil
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil
+			}
+			workload, ok := event.Object.(*cartov1alpha1.Workload)
+			if !ok {
+				continue
+			}
+			key := types.NamespacedName{Namespace: workload.Namespace, Name: workload.Name}
+			_, listed := current[key]
+			if event.Type != apiwatch.Deleted && opts.matchesStatus(workload) {
+				current[key] = *workload
+			} else if listed {
+				delete(current, key)
+			} else {
+				continue
+			}
+			if err := render(workload); err != nil {
+				return err
+			}
+			if opts.UntilAllReady && allReady() {
+				c.Successf("All workloads are ready\n")
+				return nil
+			}
+		}
+	}
+}
+
+// isTerminal returns true when w writes to a terminal
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(*os.File)
+	return ok && terminal.IsTerminal(int(f.Fd()))
+}
+
+// workloadURLs returns the urls of the Knative services of the workloads in the namespace, keyed
+// by the namespace and name of the workload. Knative may not be installed, in which case no url
+// is returned
+func workloadURLs(ctx context.Context, c *cli.Config, namespace string) map[types.NamespacedName][]string {
+	urls := map[types.NamespacedName][]string{}
+	ksvcs := &knativeservingv1.ServiceList{}
+	_ = c.List(ctx, ksvcs, client.InNamespace(namespace), client.HasLabels{cartov1alpha1.WorkloadLabelName})
+	printer.SortByNamespaceAndName(ksvcs.Items)
+	for _, ksvc := range ksvcs.Items {
+		if ksvc.Status.URL == "" {
+			continue
+		}
+		key := types.NamespacedName{Namespace: ksvc.Namespace, Name: ksvc.Labels[cartov1alpha1.WorkloadLabelName]}
+		urls[key] = append(urls[key], ksvc.Status.URL)
+	}
+	return urls
+}
+
+// printTable renders the workloads as a table, the wide output adds the source, supply
+// chain, reason and url of each workload
+func (opts *WorkloadListOptions) printTable(ctx context.Context, c *cli.Config, w io.Writer, workloads *cartov1alpha1.WorkloadList, noHeaders bool) error {
+	if opts.Output == printer.OutputFormatWide {
+		opts.urls = workloadURLs(ctx, c, opts.Namespace)
+	}
+
 	tablePrinter := table.NewTablePrinter(table.PrintOptions{
 		WithNamespace: opts.AllNamespaces,
+		NoHeaders:     noHeaders,
 	}).With(func(h table.PrintHandler) {
 		columns := opts.printColumns()
 		h.TableHandler(columns, opts.printList)
 		h.TableHandler(columns, opts.print)
 	})
 
-	workloads = workloads.DeepCopy()
-	printer.SortByNamespaceAndName(workloads.Items)
+	return tablePrinter.PrintObj(workloads, w)
+}
+
+// workloadSource describes where the workload is built from, the git repository and
+// ref, the source image, the pre-built image or the maven artifact
+func workloadSource(workload *cartov1alpha1.Workload) string {
+	if source := specSource(workload.Spec.Source); source != "" {
+		return source
+	}
+	if workload.Spec.Image != "" {
+		return workload.Spec.Image
+	}
+	if maven := workload.Spec.GetMavenSource(); maven != nil {
+		return fmt.Sprintf("%s:%s:%s", maven.GroupId, maven.ArtifactId, maven.Version)
+	}
+	return ""
+}
+
+// specSource describes the git repository and ref or the source image of the source
+func specSource(source *cartov1alpha1.Source) string {
+	if source == nil {
+		return ""
+	}
+	if source.Git != nil {
+		for _, ref := range []string{source.Git.Ref.Commit, source.Git.Ref.Tag, source.Git.Ref.Branch} {
+			if ref != "" {
+				return fmt.Sprintf("%s@%s", source.Git.URL, ref)
+			}
+		}
+		return source.Git.URL
+	}
+	return source.Image
+}
+
+// labelSelector combines the selector with the labels for the app and the workload type
+func (opts *WorkloadListOptions) labelSelector() (labels.Selector, error) {
+	selector, err := labels.Parse(opts.Selector)
+	if err != nil {
+		return nil, err
+	}
+	set := labels.Set{}
+	if opts.App != "" {
+		set[apis.AppPartOfLabelName] = opts.App
+	}
+	if opts.Type != "" {
+		set[apis.WorkloadTypeLabelName] = opts.Type
+	}
+	requirements, _ := labels.SelectorFromSet(set).Requirements()
+	return selector.Add(requirements...), nil
+}
 
-	return tablePrinter.PrintObj(workloads, c.Stdout)
+func (opts *WorkloadListOptions) matchesStatus(workload *cartov1alpha1.Workload) bool {
+	if opts.Ready != "" && strings.ToLower(workloadReadyStatus(workload)) != opts.Ready {
+		return false
+	}
+	if opts.SupplyChain != "" && workload.Status.SupplyChainRef.Name != opts.SupplyChain {
+		return false
+	}
+	return true
+}
+
+// sort orders the workloads by namespace and name, then by the sort-by key
+func (opts *WorkloadListOptions) sort(workloads []cartov1alpha1.Workload) {
+	printer.SortByNamespaceAndName(workloads)
+	switch opts.SortBy {
+	case workloadSortByAge:
+		sort.SliceStable(workloads, func(i, j int) bool {
+			return workloads[i].CreationTimestamp.Before(&workloads[j].CreationTimestamp)
+		})
+	case workloadSortByReady:
+		rank := map[string]int{string(metav1.ConditionFalse): 0, string(metav1.ConditionUnknown): 1, string(metav1.ConditionTrue): 2}
+		sort.SliceStable(workloads, func(i, j int) bool {
+			return rank[workloadReadyStatus(&workloads[i])] < rank[workloadReadyStatus(&workloads[j])]
+		})
+	case workloadSortByType:
+		sort.SliceStable(workloads, func(i, j int) bool {
+			return workloads[i].Labels[apis.WorkloadTypeLabelName] < workloads[j].Labels[apis.WorkloadTypeLabelName]
+		})
+	}
+}
+
+// workloadReadyStatus returns the status of the Ready condition, workloads without the
+// condition are considered Unknown
+func workloadReadyStatus(workload *cartov1alpha1.Workload) string {
+	if cond := printer.FindCondition(workload.Status.Conditions, cartov1alpha1.WorkloadConditionReady); cond != nil && cond.Status != "" {
+		return string(cond.Status)
+	}
+	return string(metav1.ConditionUnknown)
 }
 
 func NewWorkloadListCommand(ctx context.Context, c *cli.Config) *cobra.Command {
@@ -129,10 +429,29 @@ func NewWorkloadListCommand(ctx context.Context, c *cli.Config) *cobra.Command {
 		Short: "Table listing of workloads",
 		Long: strings.TrimSpace(`
 List workloads in a namespace or across all namespaces.
+
+Workloads can be filtered by their labels with ` + flags.SelectorFlagName + `, ` + flags.AppFlagName + ` and ` + flags.TypeFlagName + `, and by
+their status with ` + flags.ReadyFlagName + ` and ` + flags.SupplyChainFlagName + `. Filters are combined, only workloads
+matching all of them are listed.
+
+The wide output adds the source, supply chain, ready reason and url of each workload.
+Custom columns, JSONPath and go-template outputs are evaluated against the json array
+of the listed workloads.
+
+Use ` + flags.WatchFlagName + ` to keep the table up to date as workloads are created, updated and deleted
+until the command is interrupted. When the output is not a terminal the rows of the workloads
+that changed are appended instead. Add ` + flags.UntilAllReadyFlagName + ` to stop watching once every
+listed workload is ready.
 `),
 		Example: strings.Join([]string{
 			fmt.Sprintf("%s workload list", c.Name),
 			fmt.Sprintf("%s workload list %s", c.Name, flags.AllNamespacesFlagName),
+			fmt.Sprintf("%s workload list %s web %s false", c.Name, flags.TypeFlagName, flags.ReadyFlagName),
+			fmt.Sprintf("%s workload list %s 'app.kubernetes.io/part-of in (petclinic,store)' %s age", c.Name, flags.SelectorFlagName, flags.SortByFlagName),
+			fmt.Sprintf("%s workload list %s wide", c.Name, flags.OutputFlagName),
+			fmt.Sprintf("%s workload list %s custom-columns=NAME:.metadata.name,SUPPLY-CHAIN:.status.supplyChainRef.name", c.Name, flags.OutputFlagName),
+			fmt.Sprintf("%s workload list %s jsonpath='{range [*]}{.metadata.name}{\"\\n\"}{end}'", c.Name, flags.OutputFlagName),
+			fmt.Sprintf("%s workload list %s my-app %s %s", c.Name, flags.AppFlagName, flags.WatchFlagName, flags.UntilAllReadyFlagName),
 		}, "\n"),
 		PreRunE: cli.ValidateE(ctx, opts),
 		RunE:    cli.ExecE(ctx, c, opts),
@@ -140,7 +459,20 @@ List workloads in a namespace or across all namespaces.
 
 	cli.AllNamespacesFlag(ctx, cmd, c, &opts.Namespace, &opts.AllNamespaces)
 	cmd.Flags().StringVar(&opts.App, cli.StripDash(flags.AppFlagName), "", "application `name` the workload is a part of")
-	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the Workloads formatted. Supported formats: \"json\", \"yaml\", \"yml\"")
+	cmd.Flags().StringVarP(&opts.Selector, cli.StripDash(flags.SelectorFlagName), "l", "", "list the workloads matching the label `selector`")
+	cmd.Flags().StringVar(&opts.Type, cli.StripDash(flags.TypeFlagName), "", "list the workloads of the `type`")
+	cmd.Flags().StringVar(&opts.Ready, cli.StripDash(flags.ReadyFlagName), "", "list the workloads with the Ready condition in the `status`, one of \"true\", \"false\" or \"unknown\"")
+	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.ReadyFlagName), func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{"true", "false", "unknown"}, cobra.ShellCompDirectiveNoFileComp
+	})
+	cmd.Flags().StringVar(&opts.SupplyChain, cli.StripDash(flags.SupplyChainFlagName), "", "list the workloads selected by the supply chain `name`")
+	cmd.Flags().StringVar(&opts.SortBy, cli.StripDash(flags.SortByFlagName), workloadSortByName, fmt.Sprintf("sort the workloads by `key`, one of %q, %q, %q (not ready first) or %q", workloadSortByName, workloadSortByAge, workloadSortByReady, workloadSortByType))
+	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.SortByFlagName), func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{workloadSortByName, workloadSortByAge, workloadSortByReady, workloadSortByType}, cobra.ShellCompDirectiveNoFileComp
+	})
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the Workloads formatted. Supported formats: \"json\", \"yaml\", \"yml\", \"wide\", \"name\", \"custom-columns=<columns>\", \"jsonpath=<expression>\", \"go-template=<template>\", \"go-template-file=<path>\"")
+	cmd.Flags().BoolVarP(&opts.Watch, cli.StripDash(flags.WatchFlagName), "w", false, "watch for changes to the listed workloads")
+	cmd.Flags().BoolVar(&opts.UntilAllReady, cli.StripDash(flags.UntilAllReadyFlagName), false, "stop watching once all the listed workloads are ready, requires "+flags.WatchFlagName)
 
 	return cmd
 }
@@ -173,10 +505,23 @@ func (opts *WorkloadListOptions) print(workload *cartov1alpha1.Workload, _ table
 	if opts.App == "" {
 		row.Cells = append(row.Cells, printer.EmptyString(labels[apis.AppPartOfLabelName]))
 	}
+	ready := printer.FindCondition(workload.Status.Conditions, cartov1alpha1.WorkloadConditionReady)
 	row.Cells = append(row.Cells,
-		printer.ConditionStatus(printer.FindCondition(workload.Status.Conditions, cartov1alpha1.WorkloadConditionReady)),
+		printer.ConditionStatus(ready),
 		printer.TimestampSince(workload.CreationTimestamp, now),
 	)
+	if opts.Output == printer.OutputFormatWide {
+		reason := ""
+		if ready != nil {
+			reason = ready.Reason
+		}
+		row.Cells = append(row.Cells,
+			printer.EmptyString(workloadSource(workload)),
+			printer.EmptyString(workload.Status.SupplyChainRef.Name),
+			printer.EmptyString(reason),
+			printer.EmptyString(strings.Join(opts.urls[types.NamespacedName{Namespace: workload.Namespace, Name: workload.Name}], ",")),
+		)
+	}
 	return []metav1beta1.TableRow{row}, nil
 }
 
@@ -192,6 +537,14 @@ func (opts *WorkloadListOptions) printColumns() []metav1beta1.TableColumnDefinit
 		metav1beta1.TableColumnDefinition{Name: "Ready", Type: "string"},
 		metav1beta1.TableColumnDefinition{Name: "Age", Type: "string"},
 	)
+	if opts.Output == printer.OutputFormatWide {
+		cols = append(cols,
+			metav1beta1.TableColumnDefinition{Name: "Source", Type: "string"},
+			metav1beta1.TableColumnDefinition{Name: "Supply Chain", Type: "string"},
+			metav1beta1.TableColumnDefinition{Name: "Reason", Type: "string"},
+			metav1beta1.TableColumnDefinition{Name: "URL", Type: "string"},
+		)
+	}
 
 	return cols
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package commands_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -26,14 +27,20 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
 	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	knativeservingv1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/knative/serving/v1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
 	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	watchhelper "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/watch"
+	watchfakes "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/watch/fake"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
 	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	diev1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/knative/serving/v1"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
 )
 
@@ -82,7 +89,76 @@ func TestWorkloadListOptionsValidate(t *testing.T) {
 				Namespace: "default",
 				Output:    "myFormat",
 			},
-			ExpectFieldErrors: validation.EnumInvalidValue("myFormat", flags.OutputFlagName, []string{"json", "yaml", "yml"}),
+			ExpectFieldErrors: validation.EnumInvalidValue("myFormat", flags.OutputFlagName, []string{"json", "yaml", "yml", "wide", "name", "custom-columns", "jsonpath", "go-template", "go-template-file"}),
+		},
+		{
+			Name: "filters and sort",
+			Validatable: &commands.WorkloadListOptions{
+				Namespace:   "default",
+				Selector:    "app.kubernetes.io/part-of in (hello,world)",
+				Type:        "web",
+				Ready:       "false",
+				SupplyChain: "source-to-url",
+				SortBy:      "age",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid selector",
+			Validatable: &commands.WorkloadListOptions{
+				Namespace: "default",
+				Selector:  "app in hello",
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("app in hello", flags.SelectorFlagName),
+		},
+		{
+			Name: "invalid filters",
+			Validatable: &commands.WorkloadListOptions{
+				Namespace:   "default",
+				Type:        "web-",
+				Ready:       "yes",
+				SupplyChain: "source-to-url-",
+			},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrInvalidValue("web-", flags.TypeFlagName),
+				validation.EnumInvalidValue("yes", flags.ReadyFlagName, []string{"true", "false", "unknown"}),
+				validation.ErrInvalidValue("source-to-url-", flags.SupplyChainFlagName),
+			),
+		},
+		{
+			Name: "invalid sort",
+			Validatable: &commands.WorkloadListOptions{
+				Namespace: "default",
+				SortBy:    "status",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("status", flags.SortByFlagName, []string{"name", "age", "ready", "type"}),
+		},
+		{
+			Name: "watch wide",
+			Validatable: &commands.WorkloadListOptions{
+				Namespace:     "default",
+				Output:        "wide",
+				Watch:         true,
+				UntilAllReady: true,
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "watch with output",
+			Validatable: &commands.WorkloadListOptions{
+				Namespace: "default",
+				Output:    "json",
+				Watch:     true,
+			},
+			ExpectFieldErrors: validation.ErrMultipleOneOf(flags.WatchFlagName, flags.OutputFlagName),
+		},
+		{
+			Name: "until all ready without watch",
+			Validatable: &commands.WorkloadListOptions{
+				Namespace:     "default",
+				UntilAllReady: true,
+			},
+			ExpectFieldErrors: validation.ErrMissingField(flags.WatchFlagName),
 		},
 	}
 
@@ -98,6 +174,7 @@ func TestWorkloadListCommand(t *testing.T) {
 	scheme := runtime.NewScheme()
 	_ = cartov1alpha1.AddToScheme(scheme)
 	_ = corev1.AddToScheme(scheme)
+	_ = knativeservingv1.AddToScheme(scheme)
 	//timezone differences and daylight savings are causing issues
 	//and when comparing against time.now, the output is not always 2Y as expected.
 	//for example; when creating timestamp in time.Now will be in Central Standard Time,
@@ -113,6 +190,33 @@ func TestWorkloadListCommand(t *testing.T) {
 			d.CreationTimestamp(objTimeStamp)
 		})
 
+	filterWorkload := func(namespace, name, app, workloadType, supplyChain string, ready metav1.ConditionStatus, age int) *diecartov1alpha1.WorkloadDie {
+		return diecartov1alpha1.WorkloadBlank.
+			MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+				d.Name(name)
+				d.Namespace(namespace)
+				d.CreationTimestamp(metav1.NewTime(time.Now().Add(-time.Duration(age) * time.Hour)))
+				d.AddLabel(apis.AppPartOfLabelName, app)
+				d.AddLabel(apis.WorkloadTypeLabelName, workloadType)
+			}).
+			StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+				d.SupplyChainRef(cartov1alpha1.ObjectReference{Kind: "ClusterSupplyChain", Name: supplyChain})
+				if ready != "" {
+					d.ConditionsDie(diecartov1alpha1.WorkloadConditionReadyBlank.Status(ready).Reason(string(ready)))
+				}
+			})
+	}
+	filterWorkloads := []client.Object{
+		filterWorkload(defaultNamespace, "api", "hello", "web", "source-to-url", metav1.ConditionTrue, 3),
+		filterWorkload(defaultNamespace, "ui", "world", "web", "basic-image-to-url", metav1.ConditionFalse, 1),
+		filterWorkload(defaultNamespace, "jobs", "hello", "worker", "source-to-url", metav1.ConditionUnknown, 2).
+			MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+				d.AddLabel("skip", "true")
+			}),
+		filterWorkload(defaultNamespace, "batch", "other", "worker", "", "", 4),
+		filterWorkload(otherNamespace, "admin", "hello", "server", "source-to-url", metav1.ConditionFalse, 5),
+	}
+
 	otherNamespaceDie := diecorev1.NamespaceBlank.
 		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
 			d.Name(otherNamespace)
@@ -362,6 +466,253 @@ default           test-workload         <empty>   <empty>   <unknown>   2y
 other-namespace   test-other-workload   web       <empty>   <unknown>   2y
 `,
 		},
+		{
+			Name:         "filters by selector",
+			Args:         []string{flags.SelectorFlagName, "app.kubernetes.io/part-of in (hello,world),!skip"},
+			GivenObjects: filterWorkloads,
+			ExpectOutput: `
+NAME   TYPE   APP     READY   AGE
+api    web    hello   Ready   3h
+ui     web    world   False   60m
+`,
+		},
+		{
+			Name:         "filters by type",
+			Args:         []string{flags.TypeFlagName, "worker"},
+			GivenObjects: filterWorkloads,
+			ExpectOutput: `
+NAME    TYPE     APP     READY       AGE
+batch   worker   other   <unknown>   4h
+jobs    worker   hello   Unknown     120m
+`,
+		},
+		{
+			Name:         "filters by ready status",
+			Args:         []string{flags.ReadyFlagName, "unknown"},
+			GivenObjects: filterWorkloads,
+			ExpectOutput: `
+NAME    TYPE     APP     READY       AGE
+batch   worker   other   <unknown>   4h
+jobs    worker   hello   Unknown     120m
+`,
+		},
+		{
+			Name:         "filters by supply chain",
+			Args:         []string{flags.SupplyChainFlagName, "source-to-url"},
+			GivenObjects: filterWorkloads,
+			ExpectOutput: `
+NAME   TYPE     APP     READY     AGE
+api    web      hello   Ready     3h
+jobs   worker   hello   Unknown   120m
+`,
+		},
+		{
+			Name:         "no workloads match filters",
+			Args:         []string{flags.TypeFlagName, "worker", flags.ReadyFlagName, "true"},
+			GivenObjects: append([]client.Object{diecorev1.NamespaceBlank.MetadataDie(func(d *diemetav1.ObjectMetaDie) { d.Name(defaultNamespace) })}, filterWorkloads...),
+			ExpectOutput: `
+No workloads found.
+`,
+		},
+		{
+			Name:         "sort by age",
+			Args:         []string{flags.SortByFlagName, "age"},
+			GivenObjects: filterWorkloads,
+			ExpectOutput: `
+NAME    TYPE     APP     READY       AGE
+batch   worker   other   <unknown>   4h
+api     web      hello   Ready       3h
+jobs    worker   hello   Unknown     120m
+ui      web      world   False       60m
+`,
+		},
+		{
+			Name:         "sort by ready status",
+			Args:         []string{flags.SortByFlagName, "ready"},
+			GivenObjects: filterWorkloads,
+			ExpectOutput: `
+NAME    TYPE     APP     READY       AGE
+ui      web      world   False       60m
+batch   worker   other   <unknown>   4h
+jobs    worker   hello   Unknown     120m
+api     web      hello   Ready       3h
+`,
+		},
+		{
+			Name:         "sort by type across namespaces",
+			Args:         []string{flags.AllNamespacesFlagName, flags.SortByFlagName, "type"},
+			GivenObjects: filterWorkloads,
+			ExpectOutput: `
+NAMESPACE         NAME    TYPE     APP     READY       AGE
+other-namespace   admin   server   hello   False       5h
+default           api     web      hello   Ready       3h
+default           ui      web      world   False       60m
+default           batch   worker   other   <unknown>   4h
+default           jobs    worker   hello   Unknown     120m
+`,
+		},
+		{
+			Name: "lists items in wide format",
+			Args: []string{flags.AppFlagName, "hello", flags.OutputFlagName, "wide"},
+			GivenObjects: []client.Object{
+				filterWorkload(defaultNamespace, "api", "hello", "web", "source-to-url", metav1.ConditionTrue, 3).
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Source(&cartov1alpha1.Source{
+							Git: &cartov1alpha1.GitSource{
+								URL: "https://example.com/api.git",
+								Ref: cartov1alpha1.GitRef{Branch: "main", Commit: "abc123"},
+							},
+						})
+					}),
+				filterWorkload(defaultNamespace, "jobs", "hello", "worker", "source-to-url", metav1.ConditionUnknown, 2).
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Image("registry.example.com/jobs:latest")
+					}),
+				diev1.ServiceBlank.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.Name("api")
+						d.Namespace(defaultNamespace)
+						d.AddLabel(cartov1alpha1.WorkloadLabelName, "api")
+					}).
+					StatusDie(func(d *diev1.ServiceStatusDie) {
+						d.URL("https://api.default.example.com")
+					}),
+			},
+			ExpectOutput: `
+NAME   TYPE     READY     AGE    SOURCE                               SUPPLY CHAIN    REASON    URL
+api    web      Ready     3h     https://example.com/api.git@abc123   source-to-url   True      https://api.default.example.com
+jobs   worker   Unknown   120m   registry.example.com/jobs:latest     source-to-url   Unknown   <empty>
+`,
+		},
+		{
+			Name:         "lists items in name format",
+			Args:         []string{flags.TypeFlagName, "web", flags.OutputFlagName, "name"},
+			GivenObjects: filterWorkloads,
+			ExpectOutput: `
+workload.carto.run/api
+workload.carto.run/ui
+`,
+		},
+		{
+			Name:         "lists items with custom columns",
+			Args:         []string{flags.AllNamespacesFlagName, flags.OutputFlagName, "custom-columns=NAMESPACE:.metadata.namespace,NAME:.metadata.name,SUPPLY-CHAIN:.status.supplyChainRef.name"},
+			GivenObjects: filterWorkloads,
+			ExpectOutput: `
+NAMESPACE         NAME    SUPPLY-CHAIN
+default           api     source-to-url
+default           batch   <none>
+default           jobs    source-to-url
+default           ui      basic-image-to-url
+other-namespace   admin   source-to-url
+`,
+		},
+		{
+			Name:         "lists items with go-template",
+			Args:         []string{flags.SortByFlagName, "age", flags.OutputFlagName, `go-template={{range .}}{{.metadata.name}} {{index .metadata.labels "apps.tanzu.vmware.com/workload-type"}}{{"\n"}}{{end}}`},
+			GivenObjects: filterWorkloads,
+			ExpectOutput: `
+batch worker
+api web
+jobs worker
+ui web
+`,
+		},
+		{
+			Name:         "watch appends changed rows",
+			Args:         []string{flags.AppFlagName, "hello", flags.WatchFlagName},
+			GivenObjects: filterWorkloads,
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				fakeWatcher := watchfakes.NewFakeWithWatch(false, config.Client, []watch.Event{
+					{Type: watch.Modified, Object: filterWorkload(defaultNamespace, "jobs", "hello", "worker", "source-to-url", metav1.ConditionTrue, 2).DieReleasePtr()},
+					{Type: watch.Added, Object: filterWorkload(defaultNamespace, "web", "hello", "web", "", "", 0).DieReleasePtr()},
+					{Type: watch.Deleted, Object: filterWorkload(defaultNamespace, "api", "hello", "web", "source-to-url", metav1.ConditionTrue, 3).DieReleasePtr()},
+				})
+				ctx = watchhelper.WithWatcher(ctx, fakeWatcher)
+				ctx, cancel := context.WithCancel(ctx)
+				time.AfterFunc(100*time.Millisecond, cancel)
+				return ctx, nil
+			},
+			ExpectOutput: `
+NAME   TYPE     READY     AGE
+api    web      Ready     3h
+jobs   worker   Unknown   120m
+jobs   worker   Ready   120m
+web   web   <unknown>   0s
+api   web   Ready   3h
+`,
+		},
+		{
+			Name:         "watch skips workloads not matching the filters",
+			Args:         []string{flags.ReadyFlagName, "false", flags.WatchFlagName},
+			GivenObjects: filterWorkloads,
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				fakeWatcher := watchfakes.NewFakeWithWatch(false, config.Client, []watch.Event{
+					{Type: watch.Modified, Object: filterWorkload(defaultNamespace, "jobs", "hello", "worker", "source-to-url", metav1.ConditionTrue, 2).DieReleasePtr()},
+					{Type: watch.Modified, Object: filterWorkload(defaultNamespace, "ui", "world", "web", "basic-image-to-url", metav1.ConditionTrue, 1).DieReleasePtr()},
+				})
+				ctx = watchhelper.WithWatcher(ctx, fakeWatcher)
+				ctx, cancel := context.WithCancel(ctx)
+				time.AfterFunc(100*time.Millisecond, cancel)
+				return ctx, nil
+			},
+			ExpectOutput: `
+NAME   TYPE   APP     READY   AGE
+ui     web    world   False   60m
+ui    web   world   Ready   60m
+`,
+		},
+		{
+			Name: "watch until all ready",
+			Args: []string{flags.AppFlagName, "world", flags.WatchFlagName, flags.UntilAllReadyFlagName},
+			GivenObjects: append([]client.Object{
+				diecorev1.NamespaceBlank.MetadataDie(func(d *diemetav1.ObjectMetaDie) { d.Name(defaultNamespace) }),
+			}, filterWorkloads...),
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				fakeWatcher := watchfakes.NewFakeWithWatch(false, config.Client, []watch.Event{
+					{Type: watch.Modified, Object: filterWorkload(defaultNamespace, "ui", "world", "web", "basic-image-to-url", metav1.ConditionTrue, 1).DieReleasePtr()},
+					{Type: watch.Added, Object: filterWorkload(defaultNamespace, "other", "other", "web", "", "", 0).DieReleasePtr()},
+				})
+				ctx = watchhelper.WithWatcher(ctx, fakeWatcher)
+				return ctx, nil
+			},
+			ExpectOutput: `
+NAME   TYPE   READY   AGE
+ui     web    False   60m
+ui    web   Ready   60m
+All workloads are ready
+`,
+		},
+		{
+			Name: "watch empty list",
+			Args: []string{flags.AppFlagName, "new", flags.WatchFlagName, flags.UntilAllReadyFlagName},
+			GivenObjects: []client.Object{
+				diecorev1.NamespaceBlank.MetadataDie(func(d *diemetav1.ObjectMetaDie) { d.Name(defaultNamespace) }),
+			},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				fakeWatcher := watchfakes.NewFakeWithWatch(false, config.Client, []watch.Event{
+					{Type: watch.Added, Object: filterWorkload(defaultNamespace, "new", "new", "web", "source-to-url", metav1.ConditionTrue, 0).DieReleasePtr()},
+				})
+				ctx = watchhelper.WithWatcher(ctx, fakeWatcher)
+				return ctx, nil
+			},
+			ExpectOutput: `
+No workloads found.
+NAME   TYPE   READY   AGE
+new    web    Ready   0s
+All workloads are ready
+`,
+		},
+		{
+			Name:         "watcher error",
+			Args:         []string{flags.WatchFlagName},
+			GivenObjects: filterWorkloads,
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				fakeWatcher := watchfakes.NewFakeWithWatch(true, config.Client, []watch.Event{})
+				ctx = watchhelper.WithWatcher(ctx, fakeWatcher)
+				return ctx, nil
+			},
+			ShouldError: true,
+		},
 		{
 			Name: "list error",
 			Args: []string{},
@@ -0,0 +1,307 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	cliprinter "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/wait"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+type WorkloadRebuildOptions struct {
+	Namespace string
+	Names     []string
+	All       bool
+	App       string
+	Selector  string
+
+	Yes            bool
+	Wait           bool
+	WaitTimeout    time.Duration
+	Tail           bool
+	TailTimestamps bool
+	DelayTime      time.Duration
+}
+
+var (
+	_ validation.Validatable = (*WorkloadRebuildOptions)(nil)
+	_ cli.Executable         = (*WorkloadRebuildOptions)(nil)
+)
+
+func (opts *WorkloadRebuildOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	if opts.Namespace == "" {
+		errs = errs.Also(validation.ErrMissingField(flags.NamespaceFlagName))
+	}
+
+	selections := []string{}
+	if len(opts.Names) != 0 {
+		selections = append(selections, cli.NamesArgumentName)
+	}
+	if opts.All {
+		selections = append(selections, flags.AllFlagName)
+	}
+	if opts.App != "" {
+		selections = append(selections, flags.AppFlagName)
+	}
+	if opts.Selector != "" {
+		selections = append(selections, flags.SelectorFlagName)
+	}
+	switch {
+	case len(selections) == 0:
+		errs = errs.Also(validation.ErrMissingOneOf(cli.NamesArgumentName, flags.AllFlagName, flags.AppFlagName, flags.SelectorFlagName))
+	case len(selections) > 1:
+		errs = errs.Also(validation.ErrMultipleOneOf(selections...))
+	}
+
+	if opts.Selector != "" {
+		if _, err := labels.Parse(opts.Selector); err != nil {
+			errs = errs.Also(validation.ErrInvalidValue(opts.Selector, flags.SelectorFlagName))
+		}
+	}
+
+	if !opts.isSingleWorkload() {
+		if opts.Tail {
+			errs = errs.Also(validation.ErrForbiddenFieldWithDetail(flags.TailFlagName, multipleWorkloadsErrorDetail))
+		}
+		if opts.TailTimestamps {
+			errs = errs.Also(validation.ErrForbiddenFieldWithDetail(flags.TailTimestampFlagName, multipleWorkloadsErrorDetail))
+		}
+	}
+
+	return errs
+}
+
+func (opts *WorkloadRebuildOptions) Exec(ctx context.Context, c *cli.Config) error {
+	if opts.isSingleWorkload() {
+		currentWorkload, workload, err := opts.rebuildWorkload(ctx, c, opts.Names[0])
+		if err != nil {
+			return err
+		}
+		c.Printf("\n")
+		DisplayCommandNextSteps(c, workload)
+		c.Printf("\n")
+		if err := opts.waitForWorkload(ctx, c, currentWorkload, workload); err != nil {
+			return cli.SilenceError(err)
+		}
+		return nil
+	}
+
+	names := opts.Names
+	if len(names) == 0 {
+		var err error
+		if names, err = opts.selectWorkloads(ctx, c); err != nil {
+			return err
+		}
+		if len(names) == 0 {
+			c.Infof("No workloads found.\n")
+			return nil
+		}
+		if !opts.Yes {
+			okToRebuild := false
+			err := cli.NewConfirmSurvey(c, "Really rebuild %d workloads in the namespace %q?", len(names), opts.Namespace).Resolve(&okToRebuild)
+			if err != nil || !okToRebuild {
+				c.Infof("Skipping workloads in namespace %q\n", opts.Namespace)
+				return nil
+			}
+		}
+	}
+
+	workloads := &cartov1alpha1.WorkloadList{}
+	results := map[types.NamespacedName]string{}
+	rebuilt := [][2]*cartov1alpha1.Workload{}
+	for _, name := range names {
+		key := types.NamespacedName{Namespace: opts.Namespace, Name: name}
+		workloads.Items = append(workloads.Items, cartov1alpha1.Workload{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: opts.Namespace,
+				Name:      name,
+			},
+		})
+
+		currentWorkload, workload, err := opts.rebuildWorkload(ctx, c, name)
+		if err != nil {
+			if !errors.Is(err, cli.SilentError) {
+				c.Eprintf("%s %s\n", printer.Serrorf("Error:"), err)
+			}
+			results[key] = printer.WorkloadResultFailed
+			continue
+		}
+		results[key] = printer.WorkloadResultRebuilt
+		rebuilt = append(rebuilt, [2]*cartov1alpha1.Workload{currentWorkload, workload})
+	}
+
+	// builds run in parallel in the cluster, wait for them once all the workloads are updated
+	for _, r := range rebuilt {
+		if err := opts.waitForWorkload(ctx, c, r[0], r[1]); err != nil {
+			results[types.NamespacedName{Namespace: r[1].Namespace, Name: r[1].Name}] = printer.WorkloadResultFailed
+		}
+	}
+
+	return summarizeWorkloadResults(c, workloads, results, false)
+}
+
+// isSingleWorkload returns true when the rebuild targets a workload given by name
+func (opts *WorkloadRebuildOptions) isSingleWorkload() bool {
+	return len(opts.Names) == 1 && !opts.All && opts.App == "" && opts.Selector == ""
+}
+
+// selectWorkloads returns the names of the workloads in the namespace matching
+// the application or the label selector, or every workload when none is given
+func (opts *WorkloadRebuildOptions) selectWorkloads(ctx context.Context, c *cli.Config) ([]string, error) {
+	selector := labels.Everything()
+	if opts.App != "" {
+		selector = labels.SelectorFromSet(labels.Set{apis.AppPartOfLabelName: opts.App})
+	}
+	if opts.Selector != "" {
+		var err error
+		if selector, err = labels.Parse(opts.Selector); err != nil {
+			return nil, err
+		}
+	}
+
+	list := &cartov1alpha1.WorkloadList{}
+	if err := c.List(ctx, list, client.InNamespace(opts.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for _, workload := range list.Items {
+		names = append(names, workload.Name)
+	}
+	return names, nil
+}
+
+// rebuildWorkload bumps the rebuild param read by the build template of the supply chain, so a
+// new build is run even if the source of the workload did not change
+func (opts *WorkloadRebuildOptions) rebuildWorkload(ctx context.Context, c *cli.Config, name string) (*cartov1alpha1.Workload, *cartov1alpha1.Workload, error) {
+	currentWorkload, err := getWorkload(ctx, c, opts.Namespace, name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var counter string
+	currentWorkload.Spec.GetParam(cartov1alpha1.WorkloadRebuildParam, &counter)
+	rebuild, _ := strconv.Atoi(counter)
+
+	workload := currentWorkload.DeepCopy()
+	workload.Spec.MergeParams(cartov1alpha1.WorkloadRebuildParam, strconv.Itoa(rebuild+1))
+	if err := c.Update(ctx, workload); err != nil {
+		if apierrs.IsConflict(err) {
+			c.Printf("%s conflict updating workload, the object was modified by another user; please run the rebuild command again\n", printer.Serrorf("Error:"))
+			return nil, nil, cli.SilenceError(err)
+		}
+		return nil, nil, err
+	}
+	// the rebuild counter is not a change applied by the user, it is not recorded in the history
+	// so rebuilds do not push the revisions that changed the spec out of it
+	c.Emoji(cli.ThumbsUp, cliprinter.Ssuccessf("Triggered rebuild of workload %q\n", workload.Name))
+	return currentWorkload, workload, nil
+}
+
+// waitForWorkload waits for the rebuilt workload to be processed and become ready,
+// showing its logs while waiting when requested
+func (opts *WorkloadRebuildOptions) waitForWorkload(ctx context.Context, c *cli.Config, currentWorkload, workload *cartov1alpha1.Workload) error {
+	anyTail := opts.Tail || opts.TailTimestamps
+	if !opts.Wait && !anyTail {
+		return nil
+	}
+	c.Infof("Waiting for workload %q to become ready...\n", workload.Name)
+
+	statusChangeWorkers := []wait.Worker{getStatusChangeWorker(c, currentWorkload)}
+	if err := raceWithTimeout(ctx, c, workload, opts.WaitTimeout, true, waitErrorForStatusChange, statusChangeWorkers); err != nil {
+		return err
+	}
+
+	workers := []wait.Worker{getReadyConditionWorker(c, workload, opts.DelayTime)}
+	if anyTail {
+		workers = append(workers, getTailWorker(c, workload, opts.TailTimestamps))
+	}
+	if err := raceWithTimeout(ctx, c, workload, opts.WaitTimeout, true, waitErrorForReadyCondition, workers); err != nil {
+		return err
+	}
+	c.Infof("Workload %q is ready\n\n", workload.Name)
+	return nil
+}
+
+func NewWorkloadRebuildCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &WorkloadRebuildOptions{}
+
+	cmd := &cobra.Command{
+		Use:     "rebuild",
+		Aliases: []string{"restart"},
+		Short:   "Trigger a new build of workloads without changing their source",
+		Long: strings.TrimSpace(`
+Trigger a new run of the supply chain for workloads whose source did not change, for
+example to pick up a patched base image or buildpack.
+
+The rebuild is triggered by increasing the "` + cartov1alpha1.WorkloadRebuildParam + `" param of the workload. The image build
+template of the supply chain must read this param, for example setting it as an annotation of
+the image resource it stamps, for a new build to be run. Changes of the param are not recorded
+in the workload history. Several workloads can be rebuilt at once by name, or selecting all
+the workloads in the namespace, the workloads of an application or the workloads matching a
+label selector.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s workload rebuild my-workload %s", c.Name, flags.WaitFlagName),
+			fmt.Sprintf("%s workload rebuild %s spring-petclinic", c.Name, flags.AppFlagName),
+			fmt.Sprintf("%s workload rebuild %s %s", c.Name, flags.AllFlagName, flags.YesFlagName),
+		}, "\n"),
+		PreRunE:           cli.ValidateE(ctx, opts),
+		RunE:              cli.ExecE(ctx, c, opts),
+		ValidArgsFunction: completion.SuggestWorkloadNames(ctx, c),
+	}
+
+	cli.Args(cmd,
+		cli.NamesArg(&opts.Names),
+	)
+
+	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
+	cmd.Flags().BoolVar(&opts.All, cli.StripDash(flags.AllFlagName), false, "rebuild all workloads within the namespace")
+	cmd.Flags().StringVarP(&opts.App, cli.StripDash(flags.AppFlagName), "a", "", "rebuild the workloads of the application `name`")
+	cmd.Flags().StringVar(&opts.Selector, cli.StripDash(flags.SelectorFlagName), "", "rebuild the workloads matching the label `selector`")
+	cmd.Flags().BoolVarP(&opts.Yes, cli.StripDash(flags.YesFlagName), "y", false, "accept all prompts")
+	cmd.Flags().BoolVar(&opts.Wait, cli.StripDash(flags.WaitFlagName), false, "waits for workloads to become ready")
+	cmd.Flags().DurationVar(&opts.WaitTimeout, cli.StripDash(flags.WaitTimeoutFlagName), 10*time.Minute, "timeout for each workload to become ready when waiting")
+	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.WaitTimeoutFlagName), completion.SuggestDurationUnits(ctx, completion.CommonDurationUnits))
+	cmd.Flags().BoolVar(&opts.Tail, cli.StripDash(flags.TailFlagName), false, "show logs while waiting for workload to become ready")
+	cmd.Flags().BoolVar(&opts.TailTimestamps, cli.StripDash(flags.TailTimestampFlagName), false, "show logs and add timestamp to each log line while waiting for workload to become ready")
+	cmd.Flags().DurationVar(&opts.DelayTime, cli.StripDash(flags.DelayTimeFlagName), 30*time.Second, "delay set to prevent premature exit before supply chain step completion when waiting/tailing")
+	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.DelayTimeFlagName), completion.SuggestDurationUnits(ctx, completion.CommonDurationUnits))
+
+	return cmd
+}
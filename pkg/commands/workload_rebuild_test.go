@@ -0,0 +1,364 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	diecorev1 "dies.dev/apis/core/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	watchhelper "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/watch"
+	watchfakes "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/watch/fake"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+func TestWorkloadRebuildOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:        "empty",
+			Validatable: &commands.WorkloadRebuildOptions{},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMissingField(flags.NamespaceFlagName),
+				validation.ErrMissingOneOf(cli.NamesArgumentName, flags.AllFlagName, flags.AppFlagName, flags.SelectorFlagName),
+			),
+		},
+		{
+			Name: "name",
+			Validatable: &commands.WorkloadRebuildOptions{
+				Namespace: "default",
+				Names:     []string{"my-workload"},
+				Tail:      true,
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "app",
+			Validatable: &commands.WorkloadRebuildOptions{
+				Namespace: "default",
+				App:       "my-app",
+				Wait:      true,
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "names and all",
+			Validatable: &commands.WorkloadRebuildOptions{
+				Namespace: "default",
+				Names:     []string{"my-workload"},
+				All:       true,
+			},
+			ExpectFieldErrors: validation.ErrMultipleOneOf(cli.NamesArgumentName, flags.AllFlagName),
+		},
+		{
+			Name: "app and selector",
+			Validatable: &commands.WorkloadRebuildOptions{
+				Namespace: "default",
+				App:       "my-app",
+				Selector:  "team=a",
+			},
+			ExpectFieldErrors: validation.ErrMultipleOneOf(flags.AppFlagName, flags.SelectorFlagName),
+		},
+		{
+			Name: "invalid selector",
+			Validatable: &commands.WorkloadRebuildOptions{
+				Namespace: "default",
+				Selector:  "team in (",
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("team in (", flags.SelectorFlagName),
+		},
+		{
+			Name: "tail multiple workloads",
+			Validatable: &commands.WorkloadRebuildOptions{
+				Namespace:      "default",
+				All:            true,
+				Tail:           true,
+				TailTimestamps: true,
+			},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrForbiddenFieldWithDetail(flags.TailFlagName, "not supported when processing multiple workloads"),
+				validation.ErrForbiddenFieldWithDetail(flags.TailTimestampFlagName, "not supported when processing multiple workloads"),
+			),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestWorkloadRebuildCommand(t *testing.T) {
+	defaultNamespace := "default"
+	workloadName := "my-workload"
+	otherWorkloadName := "my-other-workload"
+	appName := "my-app"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	givenNamespaceDefault := diecorev1.NamespaceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(defaultNamespace)
+		})
+
+	parent := diecartov1alpha1.WorkloadBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(workloadName)
+			d.Namespace(defaultNamespace)
+			d.AddLabel(apis.AppPartOfLabelName, appName)
+		}).
+		SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+			d.Image("registry.example/my-workload:v1")
+		})
+	other := parent.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(otherWorkloadName)
+		})
+	unrelated := parent.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("unrelated")
+			d.Labels(map[string]string{})
+		})
+	rebuildParam := func(counter string) cartov1alpha1.Param {
+		return cartov1alpha1.Param{
+			Name:  cartov1alpha1.WorkloadRebuildParam,
+			Value: apiextensionsv1.JSON{Raw: []byte(`"` + counter + `"`)},
+		}
+	}
+	teamParam := cartov1alpha1.Param{
+		Name:  cartov1alpha1.WorkloadAnnotationParam,
+		Value: apiextensionsv1.JSON{Raw: []byte(`{"team":"a"}`)},
+	}
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "invalid args",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name:         "workload not found",
+			Args:         []string{workloadName},
+			GivenObjects: []client.Object{givenNamespaceDefault},
+			ShouldError:  true,
+			ExpectOutput: `
+Workload "default/my-workload" not found
+`,
+		},
+		{
+			Name:         "rebuild workload",
+			Args:         []string{workloadName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent},
+			ExpectUpdates: []client.Object{
+				parent.
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Params(rebuildParam("1"))
+					}),
+			},
+			ExpectOutput: `
+👍 Triggered rebuild of workload "my-workload"
+
+To see logs:   "tanzu apps workload tail my-workload --timestamp --since 1h"
+To get status: "tanzu apps workload get my-workload"
+
+`,
+		},
+		{
+			Name: "rebuild workload does not record a revision",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				givenNamespaceDefault,
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.UID(types.UID("my-workload-uid"))
+					}),
+			},
+			ExpectUpdates: []client.Object{
+				parent.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.UID(types.UID("my-workload-uid"))
+					}).
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Params(rebuildParam("1"))
+					}),
+			},
+			ExpectOutput: `
+👍 Triggered rebuild of workload "my-workload"
+
+To see logs:   "tanzu apps workload tail my-workload --timestamp --since 1h"
+To get status: "tanzu apps workload get my-workload"
+
+`,
+		},
+		{
+			Name: "rebuild workload again",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				givenNamespaceDefault,
+				parent.
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Params(teamParam, rebuildParam("2"))
+					}),
+			},
+			ExpectUpdates: []client.Object{
+				parent.
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Params(teamParam, rebuildParam("3"))
+					}),
+			},
+			ExpectOutput: `
+👍 Triggered rebuild of workload "my-workload"
+
+To see logs:   "tanzu apps workload tail my-workload --timestamp --since 1h"
+To get status: "tanzu apps workload get my-workload"
+
+`,
+		},
+		{
+			Name:         "rebuild workloads of an app",
+			Args:         []string{flags.AppFlagName, appName, flags.YesFlagName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent, other, unrelated},
+			ExpectUpdates: []client.Object{
+				other.
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Params(rebuildParam("1"))
+					}),
+				parent.
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Params(rebuildParam("1"))
+					}),
+			},
+			ExpectOutput: `
+👍 Triggered rebuild of workload "my-other-workload"
+👍 Triggered rebuild of workload "my-workload"
+
+📥 Summary
+   NAME                NAMESPACE   RESULT
+   my-other-workload   default     rebuilt
+   my-workload         default     rebuilt
+`,
+		},
+		{
+			Name:         "rebuild all workloads with a failure",
+			Args:         []string{flags.AllFlagName, flags.YesFlagName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent, other},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("update", "Workload", clitesting.InduceFailureOpts{
+					Name: otherWorkloadName,
+				}),
+			},
+			ExpectUpdates: []client.Object{
+				other.
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Params(rebuildParam("1"))
+					}),
+				parent.
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Params(rebuildParam("1"))
+					}),
+			},
+			ShouldError: true,
+			ExpectOutput: `
+Error: inducing failure for update Workload
+👍 Triggered rebuild of workload "my-workload"
+
+📥 Summary
+   NAME                NAMESPACE   RESULT
+   my-other-workload   default     failed
+   my-workload         default     rebuilt
+`,
+		},
+		{
+			Name:         "no workloads match the selector",
+			Args:         []string{flags.SelectorFlagName, "team=a", flags.YesFlagName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent},
+			ExpectOutput: `
+No workloads found.
+`,
+		},
+		{
+			Name: "rebuild workload and wait",
+			Args: []string{workloadName, flags.WaitFlagName, flags.DelayTimeFlagName, "0ns"},
+			GivenObjects: []client.Object{
+				givenNamespaceDefault,
+				parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.Conditions(metav1.Condition{
+							Type:               cartov1alpha1.WorkloadConditionReady,
+							Status:             metav1.ConditionTrue,
+							LastTransitionTime: metav1.NewTime(time.Date(2019, 6, 29, 01, 44, 05, 0, time.UTC)),
+						})
+					}),
+			},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				workload := parent.
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.Conditions(metav1.Condition{
+							Type:               cartov1alpha1.WorkloadConditionReady,
+							Status:             metav1.ConditionTrue,
+							LastTransitionTime: metav1.NewTime(time.Date(2019, 6, 29, 01, 44, 06, 0, time.UTC)),
+						})
+					}).DieReleasePtr()
+				fakeWatcher := watchfakes.NewFakeWithWatch(false, config.Client, []watch.Event{
+					{Type: watch.Modified, Object: workload},
+				})
+				ctx = watchhelper.WithWatcher(ctx, fakeWatcher)
+				return ctx, nil
+			},
+			ExpectUpdates: []client.Object{
+				parent.
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Params(rebuildParam("1"))
+					}).
+					StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+						d.Conditions(metav1.Condition{
+							Type:               cartov1alpha1.WorkloadConditionReady,
+							Status:             metav1.ConditionTrue,
+							LastTransitionTime: metav1.NewTime(time.Date(2019, 6, 29, 01, 44, 05, 0, time.UTC)),
+						})
+					}),
+			},
+			ExpectOutput: `
+👍 Triggered rebuild of workload "my-workload"
+
+To see logs:   "tanzu apps workload tail my-workload --timestamp --since 1h"
+To get status: "tanzu apps workload get my-workload"
+
+Waiting for workload "my-workload" to become ready...
+Workload "my-workload" is ready
+
+`,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewWorkloadRebuildCommand)
+}
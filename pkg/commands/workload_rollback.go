@@ -0,0 +1,158 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+type WorkloadRollbackOptions struct {
+	Namespace string
+	Name      string
+
+	ToRevision int
+	Yes        bool
+}
+
+var (
+	_ validation.Validatable = (*WorkloadRollbackOptions)(nil)
+	_ cli.Executable         = (*WorkloadRollbackOptions)(nil)
+)
+
+func (opts *WorkloadRollbackOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	if opts.Namespace == "" {
+		errs = errs.Also(validation.ErrMissingField(flags.NamespaceFlagName))
+	}
+
+	if opts.Name == "" {
+		errs = errs.Also(validation.ErrMissingField(cli.NameArgumentName))
+	}
+
+	if opts.ToRevision < 0 {
+		errs = errs.Also(validation.ErrInvalidValue(strconv.Itoa(opts.ToRevision), flags.ToRevisionFlagName))
+	}
+
+	return errs
+}
+
+func (opts *WorkloadRollbackOptions) Exec(ctx context.Context, c *cli.Config) error {
+	currentWorkload, err := getWorkload(ctx, c, opts.Namespace, opts.Name)
+	if err != nil {
+		return err
+	}
+
+	_, revisions, err := loadWorkloadHistory(ctx, c, currentWorkload)
+	if err != nil {
+		return err
+	}
+	if len(revisions) == 0 {
+		return fmt.Errorf("no revision history found for workload %q", currentWorkload.Name)
+	}
+
+	var revision *WorkloadRevision
+	if opts.ToRevision == 0 {
+		// without an explicit revision, roll back to the revision before the current spec
+		last := len(revisions) - 1
+		if equality.Semantic.DeepEqual(revisions[last].Spec, currentWorkload.Spec) {
+			last--
+		}
+		if last < 0 {
+			return fmt.Errorf("no previous revision found for workload %q", currentWorkload.Name)
+		}
+		revision = &revisions[last]
+	} else {
+		for i := range revisions {
+			if revisions[i].Revision == opts.ToRevision {
+				revision = &revisions[i]
+			}
+		}
+		if revision == nil {
+			return fmt.Errorf("revision %d not found in the history of workload %q", opts.ToRevision, currentWorkload.Name)
+		}
+	}
+
+	workload := currentWorkload.DeepCopy()
+	workload.Spec = *revision.Spec.DeepCopy()
+
+	c.Infof("Rolling back workload %q to revision %d\n", workload.Name, revision.Revision)
+	updateOpts := &WorkloadOptions{
+		Namespace: opts.Namespace,
+		Name:      opts.Name,
+		Yes:       opts.Yes,
+	}
+	if err := updateOpts.checkSupplyChainParams(ctx, c, workload, true); err != nil {
+		return err
+	}
+	okToUpdate, err := updateOpts.Update(ctx, c, currentWorkload, workload)
+	if err != nil {
+		return err
+	}
+	if okToUpdate {
+		c.Printf("\n")
+		DisplayCommandNextSteps(c, workload)
+		c.Printf("\n")
+	}
+
+	return nil
+}
+
+func NewWorkloadRollbackCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &WorkloadRollbackOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Roll back a workload to a previous revision",
+		Long: strings.TrimSpace(`
+Roll back a workload to a revision recorded in its history. The spec of the revision
+is applied to the workload the same way as an update, showing the changes before
+applying them.
+
+Without ` + flags.ToRevisionFlagName + `, the workload is rolled back to the revision before the
+current one. Use "tanzu apps workload history" to list the recorded revisions.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s workload rollback my-workload", c.Name),
+			fmt.Sprintf("%s workload rollback my-workload %s 2", c.Name, flags.ToRevisionFlagName),
+		}, "\n"),
+		PreRunE:           cli.ValidateE(ctx, opts),
+		RunE:              cli.ExecE(ctx, c, opts),
+		ValidArgsFunction: completion.SuggestWorkloadNames(ctx, c),
+	}
+
+	cli.Args(cmd,
+		cli.NameArg(&opts.Name),
+	)
+
+	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
+	cmd.Flags().IntVar(&opts.ToRevision, cli.StripDash(flags.ToRevisionFlagName), 0, "`revision` to roll back to, defaults to the revision before the current one")
+	cmd.Flags().BoolVarP(&opts.Yes, cli.StripDash(flags.YesFlagName), "y", false, "accept all prompts")
+
+	return cmd
+}
@@ -0,0 +1,289 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	diecorev1 "dies.dev/apis/core/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+func TestWorkloadRollbackOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:        "empty",
+			Validatable: &commands.WorkloadRollbackOptions{},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMissingField(flags.NamespaceFlagName),
+				validation.ErrMissingField(cli.NameArgumentName),
+			),
+		},
+		{
+			Name: "valid",
+			Validatable: &commands.WorkloadRollbackOptions{
+				Namespace:  "default",
+				Name:       "my-workload",
+				ToRevision: 2,
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid revision",
+			Validatable: &commands.WorkloadRollbackOptions{
+				Namespace:  "default",
+				Name:       "my-workload",
+				ToRevision: -1,
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("-1", flags.ToRevisionFlagName),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestWorkloadRollbackCommand(t *testing.T) {
+	defaultNamespace := "default"
+	workloadName := "my-workload"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	givenNamespaceDefault := diecorev1.NamespaceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(defaultNamespace)
+		})
+
+	parent := diecartov1alpha1.WorkloadBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(workloadName)
+			d.Namespace(defaultNamespace)
+			d.UID(types.UID("my-workload-uid"))
+		}).
+		SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+			d.Image("registry.example/my-workload:v2")
+			d.Env(corev1.EnvVar{Name: "FOO", Value: "bar"})
+		})
+
+	revision1 := commands.WorkloadRevision{
+		Revision:  1,
+		Timestamp: metav1.NewTime(time.Date(2023, time.May, 4, 10, 30, 0, 0, time.UTC)),
+		Manager:   "tanzu",
+		Spec: cartov1alpha1.WorkloadSpec{
+			Image: "registry.example/my-workload:v1",
+		},
+	}
+	revision2 := commands.WorkloadRevision{
+		Revision:      2,
+		Timestamp:     metav1.NewTime(time.Date(2023, time.May, 5, 8, 0, 0, 0, time.UTC)),
+		Manager:       "tanzu",
+		ChangedFields: []string{"spec.env", "spec.image"},
+		Spec:          parent.DieRelease().Spec,
+	}
+	history := workloadHistory(parent.DieReleasePtr(), revision1, revision2)
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "invalid args",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name:         "roll back to previous revision",
+			Args:         []string{workloadName, flags.YesFlagName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent, history},
+			ExpectUpdates: []client.Object{
+				parent.
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Image("registry.example/my-workload:v1")
+						d.Env()
+					}),
+				workloadHistory(parent.DieReleasePtr(), revision1, revision2, commands.WorkloadRevision{
+					Revision:      3,
+					ChangedFields: []string{"spec.env", "spec.image"},
+					Spec:          revision1.Spec,
+				}),
+			},
+			ExpectOutput: `
+Rolling back workload "my-workload" to revision 1
+🔎 Update workload:
+...
+  4,  4   |metadata:
+  5,  5   |  name: my-workload
+  6,  6   |  namespace: default
+  7,  7   |spec:
+  8     - |  env:
+  9     - |  - name: FOO
+ 10     - |    value: bar
+ 11     - |  image: registry.example/my-workload:v2
+      8 + |  image: registry.example/my-workload:v1
+👍 Updated workload "my-workload"
+
+To see logs:   "tanzu apps workload tail my-workload --timestamp --since 1h"
+To get status: "tanzu apps workload get my-workload"
+
+`,
+		},
+		{
+			Name:         "roll back to revision",
+			Args:         []string{workloadName, flags.ToRevisionFlagName, "1", flags.YesFlagName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent, history},
+			ExpectUpdates: []client.Object{
+				parent.
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Image("registry.example/my-workload:v1")
+						d.Env()
+					}),
+				workloadHistory(parent.DieReleasePtr(), revision1, revision2, commands.WorkloadRevision{
+					Revision:      3,
+					ChangedFields: []string{"spec.env", "spec.image"},
+					Spec:          revision1.Spec,
+				}),
+			},
+			ExpectOutput: `
+Rolling back workload "my-workload" to revision 1
+🔎 Update workload:
+...
+  4,  4   |metadata:
+  5,  5   |  name: my-workload
+  6,  6   |  namespace: default
+  7,  7   |spec:
+  8     - |  env:
+  9     - |  - name: FOO
+ 10     - |    value: bar
+ 11     - |  image: registry.example/my-workload:v2
+      8 + |  image: registry.example/my-workload:v1
+👍 Updated workload "my-workload"
+
+To see logs:   "tanzu apps workload tail my-workload --timestamp --since 1h"
+To get status: "tanzu apps workload get my-workload"
+
+`,
+		},
+		{
+			Name:         "roll back to current revision",
+			Args:         []string{workloadName, flags.ToRevisionFlagName, "2", flags.YesFlagName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent, history},
+			ExpectOutput: `
+Rolling back workload "my-workload" to revision 2
+Workload is unchanged, skipping update
+`,
+		},
+		{
+			Name:         "revision not found",
+			Args:         []string{workloadName, flags.ToRevisionFlagName, "5", flags.YesFlagName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent, history},
+			ShouldError:  true,
+			Verify: func(t *testing.T, output string, err error) {
+				msg := `revision 5 not found in the history of workload "my-workload"`
+				if err.Error() != msg {
+					t.Errorf("Expected error to be %q but got %q", msg, err.Error())
+				}
+			},
+		},
+		{
+			Name:         "no previous revision",
+			Args:         []string{workloadName, flags.YesFlagName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent, workloadHistory(parent.DieReleasePtr(), revision2)},
+			ShouldError:  true,
+			Verify: func(t *testing.T, output string, err error) {
+				msg := `no previous revision found for workload "my-workload"`
+				if err.Error() != msg {
+					t.Errorf("Expected error to be %q but got %q", msg, err.Error())
+				}
+			},
+		},
+		{
+			Name:         "no history",
+			Args:         []string{workloadName, flags.YesFlagName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent},
+			ShouldError:  true,
+			Verify: func(t *testing.T, output string, err error) {
+				msg := `no revision history found for workload "my-workload"`
+				if err.Error() != msg {
+					t.Errorf("Expected error to be %q but got %q", msg, err.Error())
+				}
+			},
+		},
+		{
+			Name:         "workload not found",
+			Args:         []string{workloadName, flags.YesFlagName},
+			GivenObjects: []client.Object{givenNamespaceDefault},
+			ShouldError:  true,
+			ExpectOutput: `
+Workload "default/my-workload" not found
+`,
+		},
+		{
+			Name:         "history update failed",
+			Args:         []string{workloadName, flags.YesFlagName},
+			GivenObjects: []client.Object{givenNamespaceDefault, parent, history},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("update", "ConfigMap"),
+			},
+			ExpectUpdates: []client.Object{
+				parent.
+					SpecDie(func(d *diecartov1alpha1.WorkloadSpecDie) {
+						d.Image("registry.example/my-workload:v1")
+						d.Env()
+					}),
+				workloadHistory(parent.DieReleasePtr(), revision1, revision2, commands.WorkloadRevision{
+					Revision:      3,
+					ChangedFields: []string{"spec.env", "spec.image"},
+					Spec:          revision1.Spec,
+				}),
+			},
+			ExpectOutput: `
+Rolling back workload "my-workload" to revision 1
+🔎 Update workload:
+...
+  4,  4   |metadata:
+  5,  5   |  name: my-workload
+  6,  6   |  namespace: default
+  7,  7   |spec:
+  8     - |  env:
+  9     - |  - name: FOO
+ 10     - |    value: bar
+ 11     - |  image: registry.example/my-workload:v2
+      8 + |  image: registry.example/my-workload:v1
+👍 Updated workload "my-workload"
+❗ WARNING: unable to record workload revision: inducing failure for update ConfigMap
+
+To see logs:   "tanzu apps workload tail my-workload --timestamp --since 1h"
+To get status: "tanzu apps workload get my-workload"
+
+`,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewWorkloadRollbackCommand)
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	cliprinter "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+// workloadRunSucceededCondition is the condition reporting the result of a run, as set by Tekton
+// on TaskRuns and PipelineRuns
+const workloadRunSucceededCondition = "Succeeded"
+
+// defaultWorkloadRunKinds are the kinds searched for the runs of a runnable when the kind stamped
+// by its run template can not be read
+var defaultWorkloadRunKinds = []schema.GroupVersionKind{
+	{Group: "tekton.dev", Version: "v1beta1", Kind: "PipelineRun"},
+	{Group: "tekton.dev", Version: "v1beta1", Kind: "TaskRun"},
+}
+
+type WorkloadRunsOptions struct {
+	Namespace string
+	Name      string
+
+	Output string
+}
+
+var (
+	_ validation.Validatable = (*WorkloadRunsOptions)(nil)
+	_ cli.Executable         = (*WorkloadRunsOptions)(nil)
+)
+
+func (opts *WorkloadRunsOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	if opts.Namespace == "" {
+		errs = errs.Also(validation.ErrMissingField(flags.NamespaceFlagName))
+	}
+
+	if opts.Name == "" {
+		errs = errs.Also(validation.ErrMissingField(cli.NameArgumentName))
+	}
+
+	if opts.Output != "" {
+		errs = errs.Also(validation.Enum(opts.Output, flags.OutputFlagName, []string{printer.OutputFormatJson, printer.OutputFormatYaml, printer.OutputFormatYml}))
+	}
+
+	return errs
+}
+
+func (opts *WorkloadRunsOptions) Exec(ctx context.Context, c *cli.Config) error {
+	workload, err := getWorkload(ctx, c, opts.Namespace, opts.Name)
+	if err != nil {
+		return err
+	}
+
+	runs := []printer.WorkloadRun{}
+	for _, runnable := range getWorkloadRunnables(ctx, c, workload) {
+		runnableRuns, err := listRunnableRuns(ctx, c, &runnable)
+		if err != nil {
+			return err
+		}
+		runs = append(runs, runnableRuns...)
+	}
+	sortWorkloadRuns(runs)
+
+	if opts.Output != "" {
+		export, err := printer.OutputObject(runs, printer.OutputFormat(opts.Output))
+		if err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Failed to output workload runs:"), err)
+			return cli.SilenceError(err)
+		}
+		c.Printf("%s\n", export)
+		return nil
+	}
+
+	if len(runs) == 0 {
+		c.Infof("No runs found for workload %q\n", workload.Name)
+		return nil
+	}
+
+	c.Emoji(cli.Antenna, cliprinter.Sboldf("Runs\n"))
+	return printer.WorkloadRunsPrinter(c.Stdout, workload, runs)
+}
+
+func NewWorkloadRunsCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &WorkloadRunsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "runs",
+		Short: "List the runs stamped for a workload",
+		Long: strings.TrimSpace(`
+List the runs stamped by the runnables of a workload, such as the Tekton TaskRuns or
+PipelineRuns testing the workload source, most recent first.
+
+The result of each run is read from its Succeeded condition, runs that did not complete
+yet are shown as Running along with the time they have been running for.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s workload runs my-workload", c.Name),
+			fmt.Sprintf("%s workload runs my-workload %s json", c.Name, flags.OutputFlagName),
+		}, "\n"),
+		PreRunE:           cli.ValidateE(ctx, opts),
+		RunE:              cli.ExecE(ctx, c, opts),
+		ValidArgsFunction: completion.SuggestWorkloadNames(ctx, c),
+	}
+
+	cli.Args(cmd,
+		cli.NameArg(&opts.Name),
+	)
+
+	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the runs formatted. Supported formats: \"json\", \"yaml\", \"yml\"")
+
+	return cmd
+}
+
+// getWorkloadRunnables returns the runnables stamped by the workload, runnables that can not be
+// read are left out
+func getWorkloadRunnables(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload) []cartov1alpha1.Runnable {
+	runnables := []cartov1alpha1.Runnable{}
+	for _, resource := range workload.Status.Resources {
+		ref := resource.StampedRef
+		if ref == nil || ref.ObjectReference == nil || ref.Kind != cartov1alpha1.RunnableKind {
+			continue
+		}
+		namespace := ref.Namespace
+		if namespace == "" {
+			namespace = workload.Namespace
+		}
+		runnable := cartov1alpha1.Runnable{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: ref.Name}, &runnable); err != nil {
+			continue
+		}
+		runnables = append(runnables, runnable)
+	}
+	return runnables
+}
+
+// listRunnableRuns returns the runs stamped for the runnable, most recent first. Kinds that are
+// not installed in the cluster, or that can not be read, are skipped
+func listRunnableRuns(ctx context.Context, c *cli.Config, runnable *cartov1alpha1.Runnable) ([]printer.WorkloadRun, error) {
+	runs := []printer.WorkloadRun{}
+	for _, gvk := range runnableRunKinds(ctx, c, runnable) {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := c.List(ctx, list, client.InNamespace(runnable.Namespace), client.MatchingLabels{cartov1alpha1.RunnableLabelName: runnable.Name}); err != nil {
+			if isResourceUnavailable(err) {
+				continue
+			}
+			return nil, err
+		}
+		for i := range list.Items {
+			list.Items[i].SetGroupVersionKind(gvk)
+			runs = append(runs, newWorkloadRun(runnable, &list.Items[i]))
+		}
+	}
+	sortWorkloadRuns(runs)
+	return runs, nil
+}
+
+// runnableRunKinds returns the kind stamped by the run template of the runnable, or the Tekton
+// run kinds when the run template can not be read
+func runnableRunKinds(ctx context.Context, c *cli.Config, runnable *cartov1alpha1.Runnable) []schema.GroupVersionKind {
+	template := &cartov1alpha1.ClusterRunTemplate{}
+	if err := c.Get(ctx, client.ObjectKey{Name: runnable.Spec.RunTemplateRef.Name}, template); err != nil {
+		return defaultWorkloadRunKinds
+	}
+	typeMeta := metav1.TypeMeta{}
+	if err := json.Unmarshal(template.Spec.Template.Raw, &typeMeta); err != nil || typeMeta.APIVersion == "" || typeMeta.Kind == "" {
+		return defaultWorkloadRunKinds
+	}
+	return []schema.GroupVersionKind{typeMeta.GroupVersionKind()}
+}
+
+// newWorkloadRun reads the result and timing of a run from its status
+func newWorkloadRun(runnable *cartov1alpha1.Runnable, obj *unstructured.Unstructured) printer.WorkloadRun {
+	run := printer.WorkloadRun{
+		Runnable:   runnable.Name,
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Name:       obj.GetName(),
+		Result:     printer.WorkloadRunResultRunning,
+		StartTime:  obj.GetCreationTimestamp(),
+	}
+
+	status := struct {
+		StartTime      *metav1.Time       `json:"startTime,omitempty"`
+		CompletionTime *metav1.Time       `json:"completionTime,omitempty"`
+		Conditions     []metav1.Condition `json:"conditions,omitempty"`
+	}{}
+	if content, ok, _ := unstructured.NestedMap(obj.Object, "status"); ok {
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(content, &status); err != nil {
+			return run
+		}
+	}
+	if status.StartTime != nil {
+		run.StartTime = *status.StartTime
+	}
+	run.CompletionTime = status.CompletionTime
+
+	if cond := cliprinter.FindCondition(status.Conditions, workloadRunSucceededCondition); cond != nil {
+		run.Reason = cond.Reason
+		run.Message = cond.Message
+		switch cond.Status {
+		case metav1.ConditionTrue:
+			run.Result = printer.WorkloadRunResultSucceeded
+		case metav1.ConditionFalse:
+			run.Result = printer.WorkloadRunResultFailed
+		}
+	}
+	return run
+}
+
+// sortWorkloadRuns sorts the runs most recent first
+func sortWorkloadRuns(runs []printer.WorkloadRun) {
+	sort.SliceStable(runs, func(i, j int) bool {
+		if !runs[i].StartTime.Equal(&runs[j].StartTime) {
+			return runs[j].StartTime.Before(&runs[i].StartTime)
+		}
+		return runs[i].Name < runs[j].Name
+	})
+}
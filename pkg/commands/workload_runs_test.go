@@ -0,0 +1,274 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"testing"
+
+	diecorev1 "dies.dev/apis/core/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+// runnableRun returns a run stamped for the runnable, the run succeeded when succeeded is "True",
+// failed when it is "False" and is still running otherwise
+func runnableRun(kind, name, runnable, succeeded, startTime, completionTime string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("tekton.dev/v1beta1")
+	obj.SetKind(kind)
+	obj.SetNamespace("default")
+	obj.SetName(name)
+	obj.SetLabels(map[string]string{cartov1alpha1.RunnableLabelName: runnable})
+	if startTime != "" {
+		_ = unstructured.SetNestedField(obj.Object, startTime, "status", "startTime")
+	}
+	if completionTime != "" {
+		_ = unstructured.SetNestedField(obj.Object, completionTime, "status", "completionTime")
+	}
+	condition := map[string]interface{}{"type": "Succeeded", "status": "Unknown", "reason": "Running"}
+	switch succeeded {
+	case "True":
+		condition = map[string]interface{}{"type": "Succeeded", "status": "True", "reason": "Succeeded", "message": "All Steps have completed executing"}
+	case "False":
+		condition = map[string]interface{}{"type": "Succeeded", "status": "False", "reason": "Failed", "message": "\"step-test\" exited with code 1 (image: \"docker.io/library/gradle\")"}
+	}
+	_ = unstructured.SetNestedSlice(obj.Object, []interface{}{condition}, "status", "conditions")
+	return obj
+}
+
+func TestWorkloadRunsOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:        "empty",
+			Validatable: &commands.WorkloadRunsOptions{},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMissingField(flags.NamespaceFlagName),
+				validation.ErrMissingField(cli.NameArgumentName),
+			),
+		},
+		{
+			Name: "valid",
+			Validatable: &commands.WorkloadRunsOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid output",
+			Validatable: &commands.WorkloadRunsOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Output:    "table",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("table", flags.OutputFlagName, []string{"json", "yaml", "yml"}),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestWorkloadRunsCommand(t *testing.T) {
+	defaultNamespace := "default"
+	workloadName := "my-workload"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	givenNamespaceDefault := diecorev1.NamespaceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(defaultNamespace)
+		})
+
+	parent := diecartov1alpha1.WorkloadBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(workloadName)
+			d.Namespace(defaultNamespace)
+		})
+	withRunnable := parent.
+		StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+			d.Resources(
+				diecartov1alpha1.RealizedResourceBlank.
+					Name("source-tester").
+					StampedRef(&cartov1alpha1.StampedRef{
+						ObjectReference: &corev1.ObjectReference{APIVersion: "carto.run/v1alpha1", Kind: cartov1alpha1.RunnableKind, Namespace: defaultNamespace, Name: workloadName},
+						Resource:        "runnables.carto.run",
+					}).
+					DieRelease(),
+			)
+		})
+	runnable := &cartov1alpha1.Runnable{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: defaultNamespace,
+			Name:      workloadName,
+		},
+		Spec: cartov1alpha1.RunnableSpec{
+			RunTemplateRef: cartov1alpha1.RunTemplateReference{Kind: "ClusterRunTemplate", Name: "tekton-taskrun"},
+		},
+	}
+	runTemplate := &cartov1alpha1.ClusterRunTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tekton-taskrun",
+		},
+		Spec: cartov1alpha1.RunTemplateSpec{
+			Template: runtime.RawExtension{Raw: []byte(`{"apiVersion":"tekton.dev/v1beta1","kind":"TaskRun","metadata":{"generateName":"$(runnable.metadata.name)$-"}}`)},
+		},
+	}
+	failedRun := runnableRun("TaskRun", workloadName+"-x8f2k", workloadName, "False", "2023-05-04T10:30:00Z", "2023-05-04T10:31:35Z")
+	succeededRun := runnableRun("TaskRun", workloadName+"-p4hzn", workloadName, "True", "2023-05-04T09:30:00Z", "2023-05-04T09:33:00Z")
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "empty",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name: "workload not found",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				givenNamespaceDefault,
+			},
+			ExpectOutput: `
+Workload "default/my-workload" not found
+`,
+			ShouldError: true,
+		},
+		{
+			Name: "no runnable",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ExpectOutput: `
+No runs found for workload "my-workload"
+`,
+		},
+		{
+			Name: "runs of the run template kind",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				withRunnable,
+				runnable,
+				runTemplate,
+				succeededRun,
+				failedRun,
+				runnableRun("TaskRun", "other-workload-6wv9s", "other-workload", "True", "2023-05-04T11:30:00Z", "2023-05-04T11:33:00Z"),
+				runnableRun("PipelineRun", workloadName+"-tmtqq", workloadName, "True", "2023-05-04T11:30:00Z", "2023-05-04T11:33:00Z"),
+			},
+			ExpectOutput: `
+📡 Runs
+   NAME                KIND      RESULT      STARTED                DURATION
+   my-workload-x8f2k   TaskRun   Failed      2023-05-04T10:30:00Z   95s
+   my-workload-p4hzn   TaskRun   Succeeded   2023-05-04T09:30:00Z   3m
+`,
+		},
+		{
+			Name: "tekton runs when the run template is not found",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				withRunnable,
+				runnable,
+				succeededRun,
+				runnableRun("PipelineRun", workloadName+"-tmtqq", workloadName, "False", "2023-05-04T11:30:00Z", "2023-05-04T11:42:10Z"),
+			},
+			ExpectOutput: `
+📡 Runs
+   NAME                KIND          RESULT      STARTED                DURATION
+   my-workload-tmtqq   PipelineRun   Failed      2023-05-04T11:30:00Z   12m
+   my-workload-p4hzn   TaskRun       Succeeded   2023-05-04T09:30:00Z   3m
+`,
+		},
+		{
+			Name: "no runs",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				withRunnable,
+				runnable,
+				runTemplate,
+			},
+			ExpectOutput: `
+No runs found for workload "my-workload"
+`,
+		},
+		{
+			Name: "json output",
+			Args: []string{workloadName, flags.OutputFlagName, "json"},
+			GivenObjects: []client.Object{
+				withRunnable,
+				runnable,
+				runTemplate,
+				succeededRun,
+				failedRun,
+			},
+			ExpectOutput: `
+[
+	{
+		"runnable": "my-workload",
+		"apiVersion": "tekton.dev/v1beta1",
+		"kind": "TaskRun",
+		"name": "my-workload-x8f2k",
+		"result": "Failed",
+		"reason": "Failed",
+		"message": "\"step-test\" exited with code 1 (image: \"docker.io/library/gradle\")",
+		"startTime": "2023-05-04T10:30:00Z",
+		"completionTime": "2023-05-04T10:31:35Z"
+	},
+	{
+		"runnable": "my-workload",
+		"apiVersion": "tekton.dev/v1beta1",
+		"kind": "TaskRun",
+		"name": "my-workload-p4hzn",
+		"result": "Succeeded",
+		"reason": "Succeeded",
+		"message": "All Steps have completed executing",
+		"startTime": "2023-05-04T09:30:00Z",
+		"completionTime": "2023-05-04T09:33:00Z"
+	}
+]
+`,
+		},
+		{
+			Name: "list runs error",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				withRunnable,
+				runnable,
+				runTemplate,
+			},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("list", "TaskRunList"),
+			},
+			ShouldError: true,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewWorkloadRunsCommand)
+}
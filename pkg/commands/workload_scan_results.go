@@ -0,0 +1,227 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	scanningv1beta1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/scanning/v1beta1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	cliprinter "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+type WorkloadScanResultsOptions struct {
+	Namespace string
+	Name      string
+
+	Output string
+}
+
+var (
+	_ validation.Validatable = (*WorkloadScanResultsOptions)(nil)
+	_ cli.Executable         = (*WorkloadScanResultsOptions)(nil)
+)
+
+func (opts *WorkloadScanResultsOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	if opts.Namespace == "" {
+		errs = errs.Also(validation.ErrMissingField(flags.NamespaceFlagName))
+	}
+
+	if opts.Name == "" {
+		errs = errs.Also(validation.ErrMissingField(cli.NameArgumentName))
+	}
+
+	if opts.Output != "" {
+		errs = errs.Also(validation.Enum(opts.Output, flags.OutputFlagName, []string{printer.OutputFormatJson, printer.OutputFormatYaml, printer.OutputFormatYml}))
+	}
+
+	return errs
+}
+
+func (opts *WorkloadScanResultsOptions) Exec(ctx context.Context, c *cli.Config) error {
+	workload, err := getWorkload(ctx, c, opts.Namespace, opts.Name)
+	if err != nil {
+		return err
+	}
+
+	results, err := getWorkloadScanResults(ctx, c, workload)
+	if err != nil {
+		return err
+	}
+
+	if opts.Output != "" {
+		export, err := printer.OutputObject(results, printer.OutputFormat(opts.Output))
+		if err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Failed to output workload scan results:"), err)
+			return cli.SilenceError(err)
+		}
+		c.Printf("%s\n", export)
+		return nil
+	}
+
+	if len(results) == 0 {
+		c.Infof("No scans found for workload %q\n", workload.Name)
+		return nil
+	}
+
+	return printWorkloadScanResults(c, workload, results)
+}
+
+func NewWorkloadScanResultsCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &WorkloadScanResultsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "scan-results",
+		Short: "Show the results of the source and image scans of a workload",
+		Long: strings.TrimSpace(`
+Show the results of the SourceScans and ImageScans stamped by the supply chain of a
+workload: the number of critical, high and medium vulnerabilities found, whether the
+scanned artifact complies with the scan policy and, when it does not, the reason reported
+by the policy.
+
+The ` + flags.OutputFlagName + ` formats include the scanned artifact, the scanner, the number of low and
+unknown vulnerabilities and the location of the scan report in the metadata store.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s workload scan-results my-workload", c.Name),
+			fmt.Sprintf("%s workload scan-results my-workload %s json", c.Name, flags.OutputFlagName),
+		}, "\n"),
+		PreRunE:           cli.ValidateE(ctx, opts),
+		RunE:              cli.ExecE(ctx, c, opts),
+		ValidArgsFunction: completion.SuggestWorkloadNames(ctx, c),
+	}
+
+	cli.Args(cmd,
+		cli.NameArg(&opts.Name),
+	)
+
+	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the scan results formatted. Supported formats: \"json\", \"yaml\", \"yml\"")
+
+	return cmd
+}
+
+// getWorkloadScanResults returns the results of the scans stamped by the workload in the order of
+// the supply chain resources. Scans that are not found, or whose kind is not installed in the
+// cluster, are left out
+func getWorkloadScanResults(ctx context.Context, c *cli.Config, workload *cartov1alpha1.Workload) ([]printer.ScanResult, error) {
+	results := []printer.ScanResult{}
+	for _, resource := range workload.Status.Resources {
+		ref := resource.StampedRef
+		if ref == nil || ref.ObjectReference == nil {
+			continue
+		}
+		namespace := ref.Namespace
+		if namespace == "" {
+			namespace = workload.Namespace
+		}
+		key := client.ObjectKey{Namespace: namespace, Name: ref.Name}
+
+		var result printer.ScanResult
+		switch ref.Kind {
+		case scanningv1beta1.SourceScanKind:
+			scan := &scanningv1beta1.SourceScan{}
+			if err := c.Get(ctx, key, scan); err != nil {
+				if isResourceUnavailable(err) {
+					continue
+				}
+				return nil, err
+			}
+			result = newScanResult(resource.Name, ref.Kind, scan.Name, scan.Spec.ScanPolicy, scan.Spec.ScanTemplate, &scan.Status)
+			if result.Artifact.Blob == nil && result.Artifact.Registry == nil {
+				result.Artifact.Blob = scan.Spec.Blob.DeepCopy()
+			}
+		case scanningv1beta1.ImageScanKind:
+			scan := &scanningv1beta1.ImageScan{}
+			if err := c.Get(ctx, key, scan); err != nil {
+				if isResourceUnavailable(err) {
+					continue
+				}
+				return nil, err
+			}
+			result = newScanResult(resource.Name, ref.Kind, scan.Name, scan.Spec.ScanPolicy, scan.Spec.ScanTemplate, &scan.Status)
+			if result.Artifact.Blob == nil && result.Artifact.Registry == nil {
+				result.Artifact.Registry = scan.Spec.Registry.DeepCopy()
+			}
+		default:
+			continue
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func newScanResult(resource, kind, name, scanPolicy, scanTemplate string, status *scanningv1beta1.ScanStatus) printer.ScanResult {
+	result := printer.ScanResult{
+		Resource:     resource,
+		Kind:         kind,
+		Name:         name,
+		Phase:        status.Phase,
+		ScanPolicy:   scanPolicy,
+		ScanTemplate: scanTemplate,
+		Artifact:     *status.Artifact.DeepCopy(),
+		ScannedBy:    status.ScannedBy,
+		CVECount:     status.Summary.CVECount,
+		MetadataURL:  status.MetadataURL,
+	}
+	if !status.ScannedAt.IsZero() {
+		result.ScannedAt = status.ScannedAt.DeepCopy()
+	}
+	if cond := cliprinter.FindCondition(status.Conditions, scanningv1beta1.ConditionPolicySucceeded); cond != nil {
+		result.PolicyCompliant = cond.Status
+		result.PolicyReason = cond.Reason
+		result.PolicyMessage = cond.Message
+	}
+	return result
+}
+
+// printWorkloadScanResults prints the Scanning section shown by the scan-results and get commands
+func printWorkloadScanResults(c *cli.Config, workload *cartov1alpha1.Workload, results []printer.ScanResult) error {
+	c.Emoji(cli.Magnifying, cliprinter.Sboldf("Scanning\n"))
+	if err := printer.WorkloadScanResultsPrinter(c.Stdout, workload, results); err != nil {
+		return err
+	}
+	if hasScanPolicyIssues(results) {
+		c.Printf("\n")
+		if err := printer.ScanPolicyIssuesPrinter(c.Stdout, workload, results); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func hasScanPolicyIssues(results []printer.ScanResult) bool {
+	for _, r := range results {
+		if r.PolicyCompliant == metav1.ConditionFalse && strings.TrimSpace(r.PolicyMessage) != "" {
+			return true
+		}
+	}
+	return false
+}
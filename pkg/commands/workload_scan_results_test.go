@@ -0,0 +1,316 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	diecorev1 "dies.dev/apis/core/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	scanningv1beta1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/scanning/v1beta1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+// workloadScans returns a source scan that complies with the scan policy and an image scan that
+// does not, both named after the workload
+func workloadScans(namespace, workloadName string) (*scanningv1beta1.SourceScan, *scanningv1beta1.ImageScan) {
+	scannedAt := metav1.NewTime(time.Date(2023, time.May, 4, 10, 30, 0, 0, time.UTC))
+	sourceScan := &scanningv1beta1.SourceScan{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: workloadName},
+		Spec: scanningv1beta1.SourceScanSpec{
+			Blob:         scanningv1beta1.Blob{URL: "http://source-controller.flux-system.svc.cluster.local./gitrepository/default/my-workload/3d42c19a.tar.gz", Revision: "main@sha1:3d42c19a"},
+			ScanTemplate: "blob-source-scan-template",
+			ScanPolicy:   "scan-policy",
+		},
+		Status: scanningv1beta1.ScanStatus{
+			Conditions: []metav1.Condition{
+				{Type: scanningv1beta1.ConditionScanCompleted, Status: metav1.ConditionTrue, Reason: "JobFinished", Message: "The scan job finished"},
+				{Type: scanningv1beta1.ConditionPolicySucceeded, Status: metav1.ConditionTrue, Reason: "EvaluationPassed", Message: "No CVEs were found that violated the policy"},
+			},
+			Phase:       "Completed",
+			ScannedBy:   scanningv1beta1.ScannedBy{Scanner: scanningv1beta1.Scanner{Name: "Grype", Vendor: "Anchore", Version: "v0.60.0"}},
+			ScannedAt:   scannedAt,
+			MetadataURL: "http://metadata-store-app.metadata-store.svc.cluster.local:8080/api/sources?repo=my-workload&sha=3d42c19a",
+			Summary:     scanningv1beta1.Summary{CVECount: scanningv1beta1.CVECount{Medium: 3, Low: 8}},
+		},
+	}
+	imageScan := &scanningv1beta1.ImageScan{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: workloadName},
+		Spec: scanningv1beta1.ImageScanSpec{
+			Registry:     scanningv1beta1.Registry{Image: "registry.example/my-workload@sha256:978be33a7f0cbe89bf48fbb438846047a28e1298d6d10d0de2d64bdc102a9e69"},
+			ScanTemplate: "private-image-scan-template",
+			ScanPolicy:   "scan-policy",
+		},
+		Status: scanningv1beta1.ScanStatus{
+			Conditions: []metav1.Condition{
+				{Type: scanningv1beta1.ConditionScanCompleted, Status: metav1.ConditionTrue, Reason: "JobFinished", Message: "The scan job finished"},
+				{Type: scanningv1beta1.ConditionPolicySucceeded, Status: metav1.ConditionFalse, Reason: "EvaluationFailed", Message: "Policy violated because of 1 CVEs: CVE-2023-24538 (Critical)"},
+			},
+			Phase:     "Failed",
+			ScannedBy: scanningv1beta1.ScannedBy{Scanner: scanningv1beta1.Scanner{Name: "Grype", Vendor: "Anchore", Version: "v0.60.0"}},
+			ScannedAt: scannedAt,
+			Artifact: scanningv1beta1.Artifact{
+				Registry: &scanningv1beta1.Registry{Image: "registry.example/my-workload@sha256:978be33a7f0cbe89bf48fbb438846047a28e1298d6d10d0de2d64bdc102a9e69"},
+			},
+			Summary: scanningv1beta1.Summary{CVECount: scanningv1beta1.CVECount{Critical: 1, High: 2, Medium: 4, Low: 11, Unknown: 1}},
+		},
+	}
+	return sourceScan, imageScan
+}
+
+func TestWorkloadScanResultsOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:        "empty",
+			Validatable: &commands.WorkloadScanResultsOptions{},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMissingField(flags.NamespaceFlagName),
+				validation.ErrMissingField(cli.NameArgumentName),
+			),
+		},
+		{
+			Name: "valid",
+			Validatable: &commands.WorkloadScanResultsOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Output:    "json",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid output",
+			Validatable: &commands.WorkloadScanResultsOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Output:    "wide",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("wide", flags.OutputFlagName, []string{"json", "yaml", "yml"}),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestWorkloadScanResultsCommand(t *testing.T) {
+	defaultNamespace := "default"
+	workloadName := "my-workload"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	_ = scanningv1beta1.AddToScheme(scheme)
+
+	givenNamespaceDefault := diecorev1.NamespaceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(defaultNamespace)
+		})
+
+	parent := diecartov1alpha1.WorkloadBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(workloadName)
+			d.Namespace(defaultNamespace)
+		})
+	withScans := parent.
+		StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+			d.Resources(
+				diecartov1alpha1.RealizedResourceBlank.
+					Name("source-provider").
+					StampedRef(&cartov1alpha1.StampedRef{
+						ObjectReference: &corev1.ObjectReference{APIVersion: "source.toolkit.fluxcd.io/v1beta1", Kind: "GitRepository", Namespace: defaultNamespace, Name: workloadName},
+					}).
+					DieRelease(),
+				diecartov1alpha1.RealizedResourceBlank.
+					Name("source-scanner").
+					StampedRef(&cartov1alpha1.StampedRef{
+						ObjectReference: &corev1.ObjectReference{APIVersion: "scanning.apps.tanzu.vmware.com/v1beta1", Kind: scanningv1beta1.SourceScanKind, Namespace: defaultNamespace, Name: workloadName},
+						Resource:        "sourcescans.scanning.apps.tanzu.vmware.com",
+					}).
+					DieRelease(),
+				diecartov1alpha1.RealizedResourceBlank.
+					Name("image-scanner").
+					StampedRef(&cartov1alpha1.StampedRef{
+						ObjectReference: &corev1.ObjectReference{APIVersion: "scanning.apps.tanzu.vmware.com/v1beta1", Kind: scanningv1beta1.ImageScanKind, Namespace: defaultNamespace, Name: workloadName},
+						Resource:        "imagescans.scanning.apps.tanzu.vmware.com",
+					}).
+					DieRelease(),
+			)
+		})
+	sourceScan, imageScan := workloadScans(defaultNamespace, workloadName)
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "empty",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name: "workload not found",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				givenNamespaceDefault,
+			},
+			ExpectOutput: `
+Workload "default/my-workload" not found
+`,
+			ShouldError: true,
+		},
+		{
+			Name: "no scans",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ExpectOutput: `
+No scans found for workload "my-workload"
+`,
+		},
+		{
+			Name: "scan results",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				withScans,
+				sourceScan,
+				imageScan,
+			},
+			ExpectOutput: `
+🔎 Scanning
+   NAME             KIND         POLICY        COMPLIANT   CRITICAL   HIGH   MEDIUM
+   source-scanner   SourceScan   scan-policy   True        0          0      3
+   image-scanner    ImageScan    scan-policy   False       1          2      4
+
+   ImageScan [EvaluationFailed]:   Policy violated because of 1 CVEs: CVE-2023-24538 (Critical)
+`,
+		},
+		{
+			Name: "scan not found",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				withScans,
+				sourceScan,
+			},
+			ExpectOutput: `
+🔎 Scanning
+   NAME             KIND         POLICY        COMPLIANT   CRITICAL   HIGH   MEDIUM
+   source-scanner   SourceScan   scan-policy   True        0          0      3
+`,
+		},
+		{
+			Name: "json output",
+			Args: []string{workloadName, flags.OutputFlagName, "json"},
+			GivenObjects: []client.Object{
+				withScans,
+				sourceScan,
+				imageScan,
+			},
+			ExpectOutput: `
+[
+	{
+		"resource": "source-scanner",
+		"kind": "SourceScan",
+		"name": "my-workload",
+		"phase": "Completed",
+		"scanPolicy": "scan-policy",
+		"scanTemplate": "blob-source-scan-template",
+		"artifact": {
+			"blob": {
+				"url": "http://source-controller.flux-system.svc.cluster.local./gitrepository/default/my-workload/3d42c19a.tar.gz",
+				"revision": "main@sha1:3d42c19a"
+			}
+		},
+		"scannedBy": {
+			"scanner": {
+				"name": "Grype",
+				"vendor": "Anchore",
+				"version": "v0.60.0"
+			}
+		},
+		"scannedAt": "2023-05-04T10:30:00Z",
+		"policyCompliant": "True",
+		"policyReason": "EvaluationPassed",
+		"policyMessage": "No CVEs were found that violated the policy",
+		"cveCount": {
+			"critical": 0,
+			"high": 0,
+			"medium": 3,
+			"low": 8,
+			"unknown": 0
+		},
+		"metadataUrl": "http://metadata-store-app.metadata-store.svc.cluster.local:8080/api/sources?repo=my-workload\u0026sha=3d42c19a"
+	},
+	{
+		"resource": "image-scanner",
+		"kind": "ImageScan",
+		"name": "my-workload",
+		"phase": "Failed",
+		"scanPolicy": "scan-policy",
+		"scanTemplate": "private-image-scan-template",
+		"artifact": {
+			"registry": {
+				"image": "registry.example/my-workload@sha256:978be33a7f0cbe89bf48fbb438846047a28e1298d6d10d0de2d64bdc102a9e69"
+			}
+		},
+		"scannedBy": {
+			"scanner": {
+				"name": "Grype",
+				"vendor": "Anchore",
+				"version": "v0.60.0"
+			}
+		},
+		"scannedAt": "2023-05-04T10:30:00Z",
+		"policyCompliant": "False",
+		"policyReason": "EvaluationFailed",
+		"policyMessage": "Policy violated because of 1 CVEs: CVE-2023-24538 (Critical)",
+		"cveCount": {
+			"critical": 1,
+			"high": 2,
+			"medium": 4,
+			"low": 11,
+			"unknown": 1
+		}
+	}
+]
+`,
+		},
+		{
+			Name: "get scan error",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				withScans,
+				sourceScan,
+				imageScan,
+			},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "ImageScan"),
+			},
+			ShouldError: true,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewWorkloadScanResultsCommand)
+}
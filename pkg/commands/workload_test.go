@@ -657,6 +657,40 @@ func TestWorkloadOptionsValidate(t *testing.T) {
 			},
 			ShouldValidate: true,
 		},
+		{
+			Name: "recursive without file path",
+			Validatable: &commands.WorkloadOptions{
+				Namespace: "default",
+				Name:      "my-resource",
+				Recursive: true,
+			},
+			ExpectFieldErrors: validation.ErrMissingField(flags.FilePathFlagName),
+		},
+		{
+			Name: "directory file path",
+			Validatable: &commands.WorkloadOptions{
+				Namespace: "default",
+				FilePath:  "testdata/multiple-workloads",
+				Recursive: true,
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "single workload flags with directory file path",
+			Validatable: &commands.WorkloadOptions{
+				Namespace:   "default",
+				Name:        "my-resource",
+				FilePath:    "testdata/multiple-workloads",
+				LocalPath:   localRepo,
+				SourceImage: "repo.example/image:tag",
+				Tail:        true,
+			},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrForbiddenFieldWithDetail(cli.NameArgumentName, "not supported when processing multiple workloads"),
+				validation.ErrForbiddenFieldWithDetail(flags.LocalPathFlagName, "not supported when processing multiple workloads"),
+				validation.ErrForbiddenFieldWithDetail(flags.TailFlagName, "not supported when processing multiple workloads"),
+			),
+		},
 		{
 			Name: "valid output format",
 			Validatable: &commands.WorkloadGetOptions{
@@ -673,7 +707,7 @@ func TestWorkloadOptionsValidate(t *testing.T) {
 				Name:      "my-workload",
 				Output:    "myFormat",
 			},
-			ExpectFieldErrors: validation.EnumInvalidValue("myFormat", flags.OutputFlagName, []string{"json", "yaml", "yml"}),
+			ExpectFieldErrors: validation.EnumInvalidValue("myFormat", flags.OutputFlagName, []string{"json", "yaml", "yml", "wide", "name", "custom-columns", "jsonpath", "go-template", "go-template-file"}),
 		},
 	}
 
@@ -3764,3 +3798,97 @@ spec:
 		})
 	}
 }
+
+func TestLoadInputWorkloads(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	c := cli.NewDefaultConfig("test", scheme)
+
+	tests := []struct {
+		name          string
+		file          string
+		recursive     bool
+		stdin         io.Reader
+		expectedNames []string
+		shouldError   bool
+	}{
+		{
+			name:          "loads single workload from file",
+			file:          "testdata/workload.yaml",
+			stdin:         c.Stdin,
+			expectedNames: []string{"spring-petclinic"},
+		},
+		{
+			name:          "loads multiple workloads from file",
+			file:          "testdata/multiple-workloads/workloads.yaml",
+			stdin:         c.Stdin,
+			expectedNames: []string{"spring-petclinic", "tanzu-java-web-app"},
+		},
+		{
+			name:          "loads workloads from directory",
+			file:          "testdata/multiple-workloads",
+			stdin:         c.Stdin,
+			expectedNames: []string{"spring-petclinic", "tanzu-java-web-app"},
+		},
+		{
+			name:          "loads workloads from directory recursively",
+			file:          "testdata/multiple-workloads",
+			recursive:     true,
+			stdin:         c.Stdin,
+			expectedNames: []string{"node-express", "spring-petclinic", "tanzu-java-web-app"},
+		},
+		{
+			name: "loads multiple workloads from stdin",
+			file: "-",
+			stdin: strings.NewReader(`
+apiVersion: carto.run/v1alpha1
+kind: Workload
+metadata:
+  name: spring-petclinic
+---
+apiVersion: carto.run/v1alpha1
+kind: Workload
+metadata:
+  name: tanzu-java-web-app
+`,
+			),
+			expectedNames: []string{"spring-petclinic", "tanzu-java-web-app"},
+		},
+		{
+			name:        "error loading non-existent file",
+			file:        "testdata/workload1.yaml",
+			stdin:       c.Stdin,
+			shouldError: true,
+		},
+		{
+			name:        "error loading directory without workloads",
+			file:        "testdata/local-source",
+			stdin:       c.Stdin,
+			shouldError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := &commands.WorkloadOptions{
+				FilePath:  test.file,
+				Recursive: test.recursive,
+			}
+
+			workloads, err := opts.LoadInputWorkloads(test.stdin)
+
+			if (err == nil) == test.shouldError {
+				t.Errorf("LoadInputWorkloads() shouldErr %t, got %v", test.shouldError, err)
+			} else if test.shouldError {
+				return
+			}
+
+			names := []string{}
+			for _, w := range workloads {
+				names = append(names, w.Name)
+			}
+			if diff := cmp.Diff(test.expectedNames, names); diff != "" {
+				t.Errorf("LoadInputWorkloads() (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,413 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	knativeservingv1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/knative/serving/v1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	cliprinter "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+// workloadTreeOwnedKinds are the kinds searched for objects owned by the objects in the tree,
+// kinds that are not installed in the cluster are skipped
+var workloadTreeOwnedKinds = []schema.GroupVersionKind{
+	{Group: "serving.knative.dev", Version: "v1", Kind: "Configuration"},
+	{Group: "serving.knative.dev", Version: "v1", Kind: "Route"},
+	{Group: "serving.knative.dev", Version: "v1", Kind: "Revision"},
+	{Group: "apps", Version: "v1", Kind: "Deployment"},
+	{Group: "apps", Version: "v1", Kind: "ReplicaSet"},
+	{Group: "", Version: "v1", Kind: "Pod"},
+	{Group: "", Version: "v1", Kind: "Service"},
+	{Group: "", Version: "v1", Kind: "ConfigMap"},
+	{Group: "kpack.io", Version: "v1alpha2", Kind: "Build"},
+	{Group: "tekton.dev", Version: "v1beta1", Kind: "TaskRun"},
+	{Group: "tekton.dev", Version: "v1beta1", Kind: "PipelineRun"},
+}
+
+type WorkloadTreeOptions struct {
+	Namespace string
+	Name      string
+
+	Output   string
+	MaxDepth int
+}
+
+var (
+	_ validation.Validatable = (*WorkloadTreeOptions)(nil)
+	_ cli.Executable         = (*WorkloadTreeOptions)(nil)
+)
+
+func (opts *WorkloadTreeOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	if opts.Namespace == "" {
+		errs = errs.Also(validation.ErrMissingField(flags.NamespaceFlagName))
+	}
+
+	if opts.Name == "" {
+		errs = errs.Also(validation.ErrMissingField(cli.NameArgumentName))
+	}
+
+	if opts.Output != "" {
+		errs = errs.Also(validation.Enum(opts.Output, flags.OutputFlagName, []string{printer.OutputFormatJson, printer.OutputFormatYaml, printer.OutputFormatYml}))
+	}
+
+	if opts.MaxDepth < 0 {
+		errs = errs.Also(validation.ErrInvalidValue(opts.MaxDepth, flags.MaxDepthFlagName))
+	}
+
+	return errs
+}
+
+func (opts *WorkloadTreeOptions) Exec(ctx context.Context, c *cli.Config) error {
+	workload, err := getWorkload(ctx, c, opts.Namespace, opts.Name)
+	if err != nil {
+		return err
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(workload)
+	if err != nil {
+		return err
+	}
+	obj := &unstructured.Unstructured{Object: content}
+	obj.SetGroupVersionKind(cartov1alpha1.SchemeGroupVersion.WithKind(cartov1alpha1.WorkloadKind))
+
+	b := &workloadTreeBuilder{
+		c:        c,
+		maxDepth: opts.MaxDepth,
+		visited:  map[string]bool{},
+		lists:    map[string][]unstructured.Unstructured{},
+	}
+	root, err := b.node(ctx, obj, 0)
+	if err != nil {
+		return err
+	}
+	if err := b.addKnativeServices(ctx, workload, root); err != nil {
+		return err
+	}
+
+	if opts.Output != "" {
+		export, err := printer.OutputObject(root, printer.OutputFormat(opts.Output))
+		if err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Failed to output workload tree:"), err)
+			return cli.SilenceError(err)
+		}
+		c.Printf("%s\n", export)
+		return nil
+	}
+
+	return printer.WorkloadTreePrinter(c.Stdout, workload, root)
+}
+
+// workloadTreeBuilder walks the objects stamped for a workload and the objects they own
+type workloadTreeBuilder struct {
+	c        *cli.Config
+	maxDepth int
+	// visited holds the objects already in the tree, so each object is shown only once
+	visited map[string]bool
+	// lists caches the objects of a kind in a namespace, keyed by namespace and kind
+	lists map[string][]unstructured.Unstructured
+}
+
+// node creates the tree node for obj and, unless the max depth is reached, the nodes for
+// the objects it stamped and the objects it owns
+func (b *workloadTreeBuilder) node(ctx context.Context, obj *unstructured.Unstructured, depth int) (*printer.WorkloadTreeNode, error) {
+	b.visited[workloadTreeObjectKey(obj.GroupVersionKind(), obj.GetNamespace(), obj.GetName())] = true
+	node := &printer.WorkloadTreeNode{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+	if cond := cliprinter.FindCondition(unstructuredConditions(obj), cartov1alpha1.ConditionReady); cond != nil {
+		node.Ready = string(cond.Status)
+		node.Reason = cond.Reason
+	}
+
+	if b.maxDepth != 0 && depth >= b.maxDepth {
+		return node, nil
+	}
+
+	stamped, err := b.stampedChildren(ctx, obj, depth)
+	if err != nil {
+		return nil, err
+	}
+	node.Children = append(node.Children, stamped...)
+
+	owned, err := b.ownedChildren(ctx, obj, depth)
+	if err != nil {
+		return nil, err
+	}
+	node.Children = append(node.Children, owned...)
+
+	return node, nil
+}
+
+// stampedChildren returns the nodes for the objects referenced from the status resources of
+// a workload or deliverable
+func (b *workloadTreeBuilder) stampedChildren(ctx context.Context, obj *unstructured.Unstructured, depth int) ([]*printer.WorkloadTreeNode, error) {
+	status := struct {
+		Resources []cartov1alpha1.RealizedResource `json:"resources,omitempty"`
+	}{}
+	if content, ok, _ := unstructured.NestedMap(obj.Object, "status"); ok {
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(content, &status); err != nil {
+			return nil, err
+		}
+	}
+
+	children := []*printer.WorkloadTreeNode{}
+	for _, resource := range status.Resources {
+		ref := resource.StampedRef
+		if ref == nil || ref.ObjectReference == nil {
+			continue
+		}
+		gvk, err := b.stampedRefKind(ref)
+		if err != nil {
+			return nil, err
+		}
+		if gvk.Empty() {
+			continue
+		}
+		namespace := ref.Namespace
+		if namespace == "" {
+			namespace = obj.GetNamespace()
+		}
+		if b.visited[workloadTreeObjectKey(gvk, namespace, ref.Name)] {
+			continue
+		}
+
+		child := &unstructured.Unstructured{}
+		child.SetGroupVersionKind(gvk)
+		if err := b.c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: ref.Name}, child); err != nil {
+			if !isResourceUnavailable(err) {
+				return nil, err
+			}
+			b.visited[workloadTreeObjectKey(gvk, namespace, ref.Name)] = true
+			apiVersion, kind := gvk.ToAPIVersionAndKind()
+			children = append(children, &printer.WorkloadTreeNode{
+				APIVersion: apiVersion,
+				Kind:       kind,
+				Namespace:  namespace,
+				Name:       ref.Name,
+				Reason:     string(metav1.StatusReasonNotFound),
+			})
+			continue
+		}
+		node, err := b.node(ctx, child, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, node)
+	}
+	return children, nil
+}
+
+// stampedRefKind returns the kind of a stamped object, the resource name is resolved with
+// the cluster rest mapper when the reference has no api version
+func (b *workloadTreeBuilder) stampedRefKind(ref *cartov1alpha1.StampedRef) (schema.GroupVersionKind, error) {
+	if ref.APIVersion != "" {
+		return schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind), nil
+	}
+	if ref.Resource == "" {
+		return schema.GroupVersionKind{}, nil
+	}
+	mapper, err := b.c.ToRESTMapper()
+	if err != nil {
+		return schema.GroupVersionKind{}, err
+	}
+	gvk, err := mapper.KindFor(schema.ParseGroupResource(ref.Resource).WithVersion(""))
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			return schema.GroupVersionKind{}, nil
+		}
+		return schema.GroupVersionKind{}, err
+	}
+	return gvk, nil
+}
+
+// ownedChildren returns the nodes for the objects in the same namespace with an owner
+// reference to obj
+func (b *workloadTreeBuilder) ownedChildren(ctx context.Context, obj *unstructured.Unstructured, depth int) ([]*printer.WorkloadTreeNode, error) {
+	children := []*printer.WorkloadTreeNode{}
+	if obj.GetUID() == "" {
+		return children, nil
+	}
+	for _, gvk := range workloadTreeOwnedKinds {
+		items, err := b.list(ctx, obj.GetNamespace(), gvk)
+		if err != nil {
+			return nil, err
+		}
+		for i := range items {
+			child := &items[i]
+			if b.visited[workloadTreeObjectKey(gvk, child.GetNamespace(), child.GetName())] || !isOwnedBy(child, obj.GetUID()) {
+				continue
+			}
+			node, err := b.node(ctx, child, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			children = append(children, node)
+		}
+	}
+	return children, nil
+}
+
+// addKnativeServices adds the knative services created for the workload that are not already
+// in the tree, below the deliverable when there is one
+func (b *workloadTreeBuilder) addKnativeServices(ctx context.Context, workload *cartov1alpha1.Workload, root *printer.WorkloadTreeNode) error {
+	parent, depth := root, 1
+	for _, child := range root.Children {
+		if child.Kind == cartov1alpha1.DeliverableKind {
+			parent, depth = child, 2
+			break
+		}
+	}
+	if b.maxDepth != 0 && depth > b.maxDepth {
+		return nil
+	}
+
+	gvk := knativeservingv1.SchemeGroupVersion.WithKind("Service")
+	ksvcs := &unstructured.UnstructuredList{}
+	ksvcs.SetGroupVersionKind(gvk.GroupVersion().WithKind("ServiceList"))
+	if err := b.c.List(ctx, ksvcs, client.InNamespace(workload.Namespace), client.MatchingLabels{cartov1alpha1.WorkloadLabelName: workload.Name}); err != nil {
+		if isResourceUnavailable(err) {
+			return nil
+		}
+		return err
+	}
+	for i := range ksvcs.Items {
+		ksvc := &ksvcs.Items[i]
+		ksvc.SetGroupVersionKind(gvk)
+		if b.visited[workloadTreeObjectKey(gvk, ksvc.GetNamespace(), ksvc.GetName())] {
+			continue
+		}
+		node, err := b.node(ctx, ksvc, depth)
+		if err != nil {
+			return err
+		}
+		parent.Children = append(parent.Children, node)
+	}
+	return nil
+}
+
+// list returns the objects of a kind in a namespace, the result is cached for the next calls
+func (b *workloadTreeBuilder) list(ctx context.Context, namespace string, gvk schema.GroupVersionKind) ([]unstructured.Unstructured, error) {
+	key := fmt.Sprintf("%s/%s", namespace, gvk)
+	if items, ok := b.lists[key]; ok {
+		return items, nil
+	}
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	if err := b.c.List(ctx, list, client.InNamespace(namespace)); err != nil {
+		if !isResourceUnavailable(err) {
+			return nil, err
+		}
+		list.Items = nil
+	}
+	for i := range list.Items {
+		list.Items[i].SetGroupVersionKind(gvk)
+	}
+	b.lists[key] = list.Items
+	return list.Items, nil
+}
+
+func workloadTreeObjectKey(gvk schema.GroupVersionKind, namespace, name string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", gvk.Group, gvk.Kind, namespace, name)
+}
+
+func isOwnedBy(obj *unstructured.Unstructured, uid types.UID) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// isResourceUnavailable returns true for errors caused by kinds that are not installed in
+// the cluster or that the user is not allowed to read
+func isResourceUnavailable(err error) bool {
+	return meta.IsNoMatchError(err) || runtime.IsNotRegisteredError(err) || apierrs.IsNotFound(err) || apierrs.IsForbidden(err)
+}
+
+// unstructuredConditions returns the status conditions of an object of any kind
+func unstructuredConditions(obj *unstructured.Unstructured) []metav1.Condition {
+	status := struct {
+		Conditions []metav1.Condition `json:"conditions,omitempty"`
+	}{}
+	if content, ok, _ := unstructured.NestedMap(obj.Object, "status"); ok {
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(content, &status); err != nil {
+			return nil
+		}
+	}
+	return status.Conditions
+}
+
+func NewWorkloadTreeCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &WorkloadTreeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "tree",
+		Short: "Show the tree of resources created for a workload",
+		Long: strings.TrimSpace(`
+Show the resources created for a workload as a tree. The tree starts with the resources
+stamped by the supply chain, followed by the resources they own, down to the deliverable,
+the Knative service, its revisions and pods.
+
+The Ready column shows the status of the Ready condition of each resource, resources
+without a Ready condition are shown with "-". Resources of kinds that are not installed
+in the cluster, or that cannot be read, are left out of the tree.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s workload tree my-workload", c.Name),
+			fmt.Sprintf("%s workload tree my-workload %s 2", c.Name, flags.MaxDepthFlagName),
+			fmt.Sprintf("%s workload tree my-workload %s json", c.Name, flags.OutputFlagName),
+		}, "\n"),
+		PreRunE:           cli.ValidateE(ctx, opts),
+		RunE:              cli.ExecE(ctx, c, opts),
+		ValidArgsFunction: completion.SuggestWorkloadNames(ctx, c),
+	}
+
+	cli.Args(cmd,
+		cli.NameArg(&opts.Name),
+	)
+
+	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the tree formatted. Supported formats: \"json\", \"yaml\", \"yml\"")
+	cmd.Flags().IntVar(&opts.MaxDepth, cli.StripDash(flags.MaxDepthFlagName), 0, "maximum `depth` of the tree below the workload, 0 shows the full tree")
+
+	return cmd
+}
@@ -0,0 +1,330 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"testing"
+
+	diecorev1 "dies.dev/apis/core/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	knativeservingv1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/knative/serving/v1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+func TestWorkloadTreeOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:        "empty",
+			Validatable: &commands.WorkloadTreeOptions{},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMissingField(flags.NamespaceFlagName),
+				validation.ErrMissingField(cli.NameArgumentName),
+			),
+		},
+		{
+			Name: "valid",
+			Validatable: &commands.WorkloadTreeOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				MaxDepth:  2,
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid output",
+			Validatable: &commands.WorkloadTreeOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Output:    "table",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("table", flags.OutputFlagName, []string{"json", "yaml", "yml"}),
+		},
+		{
+			Name: "negative max depth",
+			Validatable: &commands.WorkloadTreeOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				MaxDepth:  -1,
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue(-1, flags.MaxDepthFlagName),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestWorkloadTreeCommand(t *testing.T) {
+	defaultNamespace := "default"
+	workloadName := "my-workload"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	_ = appsv1.AddToScheme(scheme)
+	_ = knativeservingv1.AddToScheme(scheme)
+
+	givenNamespaceDefault := diecorev1.NamespaceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(defaultNamespace)
+		})
+
+	object := func(apiVersion, kind, name string, uid, owner types.UID, ready string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetNamespace(defaultNamespace)
+		obj.SetName(name)
+		obj.SetUID(uid)
+		if owner != "" {
+			obj.SetOwnerReferences([]metav1.OwnerReference{{Name: "owner", UID: owner}})
+		}
+		if ready != "" {
+			_ = unstructured.SetNestedSlice(obj.Object, []interface{}{
+				map[string]interface{}{"type": "Ready", "status": ready, "reason": "Reason" + ready, "lastTransitionTime": "2023-01-01T00:00:00Z"},
+			}, "status", "conditions")
+		}
+		return obj
+	}
+
+	stampedResources := []cartov1alpha1.RealizedResource{
+		diecartov1alpha1.RealizedResourceBlank.
+			Name("source-provider").
+			StampedRef(&cartov1alpha1.StampedRef{
+				ObjectReference: &corev1.ObjectReference{APIVersion: "source.toolkit.fluxcd.io/v1beta1", Kind: "GitRepository", Namespace: defaultNamespace, Name: workloadName},
+			}).
+			DieRelease(),
+		diecartov1alpha1.RealizedResourceBlank.
+			Name("image-provider").
+			StampedRef(&cartov1alpha1.StampedRef{
+				ObjectReference: &corev1.ObjectReference{APIVersion: "kpack.io/v1alpha2", Kind: "Image", Namespace: defaultNamespace, Name: workloadName},
+			}).
+			DieRelease(),
+		diecartov1alpha1.RealizedResourceBlank.
+			Name("config-writer").
+			StampedRef(&cartov1alpha1.StampedRef{
+				ObjectReference: &corev1.ObjectReference{Kind: "ConfigMap", Namespace: defaultNamespace, Name: workloadName},
+				Resource:        "configmaps",
+			}).
+			DieRelease(),
+		diecartov1alpha1.RealizedResourceBlank.
+			Name("deliverable").
+			StampedRef(&cartov1alpha1.StampedRef{
+				ObjectReference: &corev1.ObjectReference{APIVersion: "carto.run/v1alpha1", Kind: cartov1alpha1.DeliverableKind, Namespace: defaultNamespace, Name: workloadName},
+			}).
+			DieRelease(),
+	}
+	withResources := func(resources ...cartov1alpha1.RealizedResource) *diecartov1alpha1.WorkloadDie {
+		return diecartov1alpha1.WorkloadBlank.
+			MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+				d.Name(workloadName)
+				d.Namespace(defaultNamespace)
+				d.UID("workload-uid")
+			}).
+			StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+				d.ConditionsDie(
+					diecartov1alpha1.WorkloadConditionReadyBlank.Status(metav1.ConditionFalse).Reason("MissingValueAtPath"),
+				)
+				d.Resources(resources...)
+			})
+	}
+	parent := withResources(stampedResources...)
+	// the last stamped resource is the deliverable
+	parentWithoutDeliverable := withResources(stampedResources[:len(stampedResources)-1]...)
+	gitRepository := object("source.toolkit.fluxcd.io/v1beta1", "GitRepository", workloadName, "git-uid", "workload-uid", "True")
+	image := object("kpack.io/v1alpha2", "Image", workloadName, "image-uid", "workload-uid", "True")
+	build := object("kpack.io/v1alpha2", "Build", workloadName+"-build-1", "build-uid", "image-uid", "True")
+	configMap := object("v1", "ConfigMap", workloadName, "configmap-uid", "workload-uid", "")
+	deliverable := object("carto.run/v1alpha1", cartov1alpha1.DeliverableKind, workloadName, "deliverable-uid", "workload-uid", "True")
+	ksvc := object("serving.knative.dev/v1", "Service", workloadName, "ksvc-uid", "", "False")
+	ksvc.SetLabels(map[string]string{cartov1alpha1.WorkloadLabelName: workloadName})
+	configuration := object("serving.knative.dev/v1", "Configuration", workloadName, "configuration-uid", "ksvc-uid", "True")
+	route := object("serving.knative.dev/v1", "Route", workloadName, "route-uid", "ksvc-uid", "False")
+	revision := object("serving.knative.dev/v1", "Revision", workloadName+"-00001", "revision-uid", "configuration-uid", "True")
+	deployment := object("apps/v1", "Deployment", workloadName+"-00001-deployment", "deployment-uid", "revision-uid", "")
+	replicaSet := object("apps/v1", "ReplicaSet", workloadName+"-00001-deployment-5f4b7", "replicaset-uid", "deployment-uid", "")
+	pod := object("v1", "Pod", workloadName+"-00001-deployment-5f4b7-x2z8k", "pod-uid", "replicaset-uid", "True")
+	otherPod := object("v1", "Pod", "other-pod", "other-pod-uid", "other-uid", "True")
+	related := []client.Object{
+		gitRepository, image, build, configMap, deliverable,
+		ksvc, configuration, route, revision, deployment, replicaSet, pod, otherPod,
+	}
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "empty",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name:         "workload not found",
+			Args:         []string{workloadName},
+			GivenObjects: []client.Object{givenNamespaceDefault},
+			ShouldError:  true,
+			ExpectOutput: `
+Workload "default/my-workload" not found
+`,
+		},
+		{
+			Name:         "full tree",
+			Args:         []string{workloadName},
+			GivenObjects: append([]client.Object{parent}, related...),
+			ExpectOutput: `
+   NAME                                                         READY   REASON
+   Workload/my-workload                                         False   MissingValueAtPath
+   ├─GitRepository/my-workload                                  True    ReasonTrue
+   ├─Image/my-workload                                          True    ReasonTrue
+   │ └─Build/my-workload-build-1                                True    ReasonTrue
+   ├─ConfigMap/my-workload                                      -       
+   └─Deliverable/my-workload                                    True    ReasonTrue
+     └─Service/my-workload                                      False   ReasonFalse
+       ├─Configuration/my-workload                              True    ReasonTrue
+       │ └─Revision/my-workload-00001                           True    ReasonTrue
+       │   └─Deployment/my-workload-00001-deployment            -       
+       │     └─ReplicaSet/my-workload-00001-deployment-5f4b7    -       
+       │       └─Pod/my-workload-00001-deployment-5f4b7-x2z8k   True    ReasonTrue
+       └─Route/my-workload                                      False   ReasonFalse
+`,
+		},
+		{
+			Name:         "stamped object not found",
+			Args:         []string{workloadName},
+			GivenObjects: []client.Object{parent, image, build, configMap, deliverable},
+			ExpectOutput: `
+   NAME                            READY   REASON
+   Workload/my-workload            False   MissingValueAtPath
+   ├─GitRepository/my-workload     -       NotFound
+   ├─Image/my-workload             True    ReasonTrue
+   │ └─Build/my-workload-build-1   True    ReasonTrue
+   ├─ConfigMap/my-workload         -       
+   └─Deliverable/my-workload       True    ReasonTrue
+`,
+		},
+		{
+			Name:         "max depth",
+			Args:         []string{workloadName, flags.MaxDepthFlagName, "2"},
+			GivenObjects: append([]client.Object{parent}, related...),
+			ExpectOutput: `
+   NAME                            READY   REASON
+   Workload/my-workload            False   MissingValueAtPath
+   ├─GitRepository/my-workload     True    ReasonTrue
+   ├─Image/my-workload             True    ReasonTrue
+   │ └─Build/my-workload-build-1   True    ReasonTrue
+   ├─ConfigMap/my-workload         -       
+   └─Deliverable/my-workload       True    ReasonTrue
+     └─Service/my-workload         False   ReasonFalse
+`,
+		},
+		{
+			Name:         "max depth of the workload resources",
+			Args:         []string{workloadName, flags.MaxDepthFlagName, "1"},
+			GivenObjects: append([]client.Object{parent}, related...),
+			ExpectOutput: `
+   NAME                          READY   REASON
+   Workload/my-workload          False   MissingValueAtPath
+   ├─GitRepository/my-workload   True    ReasonTrue
+   ├─Image/my-workload           True    ReasonTrue
+   ├─ConfigMap/my-workload       -       
+   └─Deliverable/my-workload     True    ReasonTrue
+`,
+		},
+		{
+			Name:         "max depth without deliverable",
+			Args:         []string{workloadName, flags.MaxDepthFlagName, "1"},
+			GivenObjects: append([]client.Object{parentWithoutDeliverable}, related...),
+			ExpectOutput: `
+   NAME                          READY   REASON
+   Workload/my-workload          False   MissingValueAtPath
+   ├─GitRepository/my-workload   True    ReasonTrue
+   ├─Image/my-workload           True    ReasonTrue
+   ├─ConfigMap/my-workload       -       
+   └─Service/my-workload         False   ReasonFalse
+`,
+		},
+		{
+			Name:         "output tree as json",
+			Args:         []string{workloadName, flags.MaxDepthFlagName, "1", flags.OutputFlagName, "json"},
+			GivenObjects: append([]client.Object{parent}, related...),
+			ExpectOutput: `
+{
+	"apiVersion": "carto.run/v1alpha1",
+	"kind": "Workload",
+	"namespace": "default",
+	"name": "my-workload",
+	"ready": "False",
+	"reason": "MissingValueAtPath",
+	"children": [
+		{
+			"apiVersion": "source.toolkit.fluxcd.io/v1beta1",
+			"kind": "GitRepository",
+			"namespace": "default",
+			"name": "my-workload",
+			"ready": "True",
+			"reason": "ReasonTrue"
+		},
+		{
+			"apiVersion": "kpack.io/v1alpha2",
+			"kind": "Image",
+			"namespace": "default",
+			"name": "my-workload",
+			"ready": "True",
+			"reason": "ReasonTrue"
+		},
+		{
+			"apiVersion": "v1",
+			"kind": "ConfigMap",
+			"namespace": "default",
+			"name": "my-workload"
+		},
+		{
+			"apiVersion": "carto.run/v1alpha1",
+			"kind": "Deliverable",
+			"namespace": "default",
+			"name": "my-workload",
+			"ready": "True",
+			"reason": "ReasonTrue"
+		}
+	]
+}
+`,
+		},
+		{
+			Name:         "get failed",
+			Args:         []string{workloadName},
+			GivenObjects: append([]client.Object{parent}, related...),
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("get", "GitRepository"),
+			},
+			ShouldError: true,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewWorkloadTreeCommand)
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	knativeservingv1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/knative/serving/v1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	cliprinter "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+type WorkloadURLsOptions struct {
+	Namespace string
+	Name      string
+
+	Output string
+}
+
+var (
+	_ validation.Validatable = (*WorkloadURLsOptions)(nil)
+	_ cli.Executable         = (*WorkloadURLsOptions)(nil)
+)
+
+func (opts *WorkloadURLsOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	if opts.Namespace == "" {
+		errs = errs.Also(validation.ErrMissingField(flags.NamespaceFlagName))
+	}
+
+	if opts.Name == "" {
+		errs = errs.Also(validation.ErrMissingField(cli.NameArgumentName))
+	}
+
+	if opts.Output != "" {
+		errs = errs.Also(validation.Enum(opts.Output, flags.OutputFlagName, []string{printer.OutputFormatJson, printer.OutputFormatYaml, printer.OutputFormatYml}))
+	}
+
+	return errs
+}
+
+func (opts *WorkloadURLsOptions) Exec(ctx context.Context, c *cli.Config) error {
+	workload, err := getWorkload(ctx, c, opts.Namespace, opts.Name)
+	if err != nil {
+		return err
+	}
+
+	ksvcs := listWorkloadKnativeServices(ctx, c, workload)
+	urls := knativeServiceURLs(ksvcs)
+
+	if opts.Output != "" {
+		export, err := printer.OutputObject(urls, printer.OutputFormat(opts.Output))
+		if err != nil {
+			c.Eprintf("%s %s\n", printer.Serrorf("Failed to output workload urls:"), err)
+			return cli.SilenceError(err)
+		}
+		c.Printf("%s\n", export)
+		return nil
+	}
+
+	if len(urls) == 0 {
+		c.Infof("No urls found for workload %q\n", workload.Name)
+		return nil
+	}
+
+	c.Emoji(cli.Link, cliprinter.Sboldf("URLs\n"))
+	return printer.KnativeServiceURLsPrinter(c.Stdout, ksvcs, urls)
+}
+
+func NewWorkloadURLsCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &WorkloadURLsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "urls",
+		Short: "Show the urls a workload is reachable at",
+		Long: strings.TrimSpace(`
+Show the urls of the Knative services of a workload together with the urls of their
+tagged traffic targets, the revision each tag routes to and the percentage of the traffic
+of the service it receives.
+
+Use "` + c.Name + ` workload get" to see the revisions of the Knative services and whether
+the latest created revision became ready.
+`),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s workload urls my-workload", c.Name),
+			fmt.Sprintf("%s workload urls my-workload %s json", c.Name, flags.OutputFlagName),
+		}, "\n"),
+		PreRunE:           cli.ValidateE(ctx, opts),
+		RunE:              cli.ExecE(ctx, c, opts),
+		ValidArgsFunction: completion.SuggestWorkloadNames(ctx, c),
+	}
+
+	cli.Args(cmd,
+		cli.NameArg(&opts.Name),
+	)
+
+	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
+	cmd.Flags().StringVarP(&opts.Output, cli.StripDash(flags.OutputFlagName), "o", "", "output the urls formatted. Supported formats: \"json\", \"yaml\", \"yml\"")
+
+	return cmd
+}
+
+// knativeServiceURLs returns the urls of the Knative services followed by the urls of their
+// tagged traffic targets. Targets following the latest revision are resolved to the latest ready
+// revision when they do not name a revision
+func knativeServiceURLs(ksvcs *knativeservingv1.ServiceList) []printer.ServiceURL {
+	urls := []printer.ServiceURL{}
+	for i := range ksvcs.Items {
+		ksvc := &ksvcs.Items[i]
+		if ksvc.Status.URL != "" {
+			urls = append(urls, printer.ServiceURL{
+				Service: ksvc.Name,
+				URL:     ksvc.Status.URL,
+			})
+		}
+		for _, target := range ksvc.Status.Traffic {
+			if target.Tag == "" || target.URL == "" {
+				continue
+			}
+			revisionName := target.RevisionName
+			if revisionName == "" && target.LatestRevision != nil && *target.LatestRevision {
+				revisionName = ksvc.Status.LatestReadyRevisionName
+			}
+			url := printer.ServiceURL{
+				Service:      ksvc.Name,
+				Tag:          target.Tag,
+				RevisionName: revisionName,
+				URL:          target.URL,
+			}
+			if target.Percent != nil {
+				percent := *target.Percent
+				url.Percent = &percent
+			}
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
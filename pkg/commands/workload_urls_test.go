@@ -0,0 +1,255 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"testing"
+	"time"
+
+	diecorev1 "dies.dev/apis/core/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	knativeservingv1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/knative/serving/v1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	diev1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/knative/serving/v1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+// knativeServiceRollout returns a Knative service of the workload whose latest created revision
+// is not ready, with the traffic split between its previous revisions, and those revisions
+func knativeServiceRollout(namespace, workloadName string) (*diev1.ServiceDie, *diev1.RevisionDie, *diev1.RevisionDie, *diev1.RevisionDie) {
+	latest := true
+	ninety, ten := int64(90), int64(10)
+	ksvc := diev1.ServiceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(workloadName)
+			d.Namespace(namespace)
+			d.AddLabel(cartov1alpha1.WorkloadLabelName, workloadName)
+		}).
+		StatusDie(func(d *diev1.ServiceStatusDie) {
+			d.Conditions(
+				metav1.Condition{
+					Type:   knativeservingv1.ServiceConditionReady,
+					Status: metav1.ConditionFalse,
+					Reason: "RevisionFailed",
+				},
+			)
+			d.URL("https://my-workload.default.example.com")
+			d.LatestCreatedRevisionName("my-workload-00003")
+			d.LatestReadyRevisionName("my-workload-00002")
+			d.Traffic(
+				knativeservingv1.TrafficTarget{
+					RevisionName:   "my-workload-00002",
+					LatestRevision: &latest,
+					Percent:        &ninety,
+				},
+				knativeservingv1.TrafficTarget{
+					Tag:          "previous",
+					RevisionName: "my-workload-00001",
+					Percent:      &ten,
+					URL:          "https://previous-my-workload.default.example.com",
+				},
+			)
+		})
+	revision := diev1.RevisionBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Namespace(namespace)
+			d.AddLabel(knativeservingv1.ServiceLabelKey, workloadName)
+		})
+	revision1 := revision.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("my-workload-00001")
+			d.CreationTimestamp(metav1.NewTime(time.Now().AddDate(0, 0, -3)))
+		}).
+		StatusDie(func(d *diev1.RevisionStatusDie) {
+			d.Conditions(
+				metav1.Condition{
+					Type:   knativeservingv1.RevisionConditionReady,
+					Status: metav1.ConditionTrue,
+				},
+			)
+		})
+	revision2 := revision.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("my-workload-00002")
+			d.CreationTimestamp(metav1.NewTime(time.Now().AddDate(0, 0, -2)))
+		}).
+		StatusDie(func(d *diev1.RevisionStatusDie) {
+			d.Conditions(
+				metav1.Condition{
+					Type:   knativeservingv1.RevisionConditionReady,
+					Status: metav1.ConditionTrue,
+				},
+			)
+		})
+	revision3 := revision.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("my-workload-00003")
+			d.CreationTimestamp(metav1.NewTime(time.Now().AddDate(0, 0, -1)))
+		}).
+		StatusDie(func(d *diev1.RevisionStatusDie) {
+			d.Conditions(
+				metav1.Condition{
+					Type:   knativeservingv1.RevisionConditionReady,
+					Status: metav1.ConditionFalse,
+					Reason: "ContainerMissing",
+				},
+			)
+		})
+	return ksvc, revision1, revision2, revision3
+}
+
+func TestWorkloadURLsOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:        "empty",
+			Validatable: &commands.WorkloadURLsOptions{},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMissingField(flags.NamespaceFlagName),
+				validation.ErrMissingField(cli.NameArgumentName),
+			),
+		},
+		{
+			Name: "valid",
+			Validatable: &commands.WorkloadURLsOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Output:    "yaml",
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "invalid output",
+			Validatable: &commands.WorkloadURLsOptions{
+				Namespace: "default",
+				Name:      "my-workload",
+				Output:    "wide",
+			},
+			ExpectFieldErrors: validation.EnumInvalidValue("wide", flags.OutputFlagName, []string{"json", "yaml", "yml"}),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestWorkloadURLsCommand(t *testing.T) {
+	defaultNamespace := "default"
+	workloadName := "my-workload"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+	_ = knativeservingv1.AddToScheme(scheme)
+
+	givenNamespaceDefault := diecorev1.NamespaceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(defaultNamespace)
+		})
+
+	parent := diecartov1alpha1.WorkloadBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(workloadName)
+			d.Namespace(defaultNamespace)
+		})
+	ksvc, _, _, _ := knativeServiceRollout(defaultNamespace, workloadName)
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "empty",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name: "workload not found",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				givenNamespaceDefault,
+			},
+			ExpectOutput: `
+Workload "default/my-workload" not found
+`,
+			ShouldError: true,
+		},
+		{
+			Name: "no knative services",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				parent,
+			},
+			ExpectOutput: `
+No urls found for workload "my-workload"
+`,
+		},
+		{
+			Name: "urls",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				parent,
+				ksvc,
+			},
+			ExpectOutput: `
+🔗 URLs
+   SERVICE       TAG        REVISION            TRAFFIC   URL
+   my-workload   <empty>    <empty>             <empty>   https://my-workload.default.example.com
+   my-workload   previous   my-workload-00001   10%       https://previous-my-workload.default.example.com
+`,
+		},
+		{
+			Name: "knative service of another workload",
+			Args: []string{workloadName},
+			GivenObjects: []client.Object{
+				parent,
+				ksvc.
+					MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+						d.AddLabel(cartov1alpha1.WorkloadLabelName, "other-workload")
+					}),
+			},
+			ExpectOutput: `
+No urls found for workload "my-workload"
+`,
+		},
+		{
+			Name: "yaml output",
+			Args: []string{workloadName, flags.OutputFlagName, "yaml"},
+			GivenObjects: []client.Object{
+				parent,
+				ksvc,
+			},
+			ExpectOutput: `
+---
+- service: my-workload
+  url: https://my-workload.default.example.com
+- percent: 10
+  revisionName: my-workload-00001
+  service: my-workload
+  tag: previous
+  url: https://previous-my-workload.default.example.com
+`,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewWorkloadURLsCommand)
+}
@@ -0,0 +1,357 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	cliprinter "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/wait"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/watch"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/completion"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+const (
+	waitForReady              = "ready"
+	waitForDeleted            = "deleted"
+	waitForGenerationObserved = "generation-observed"
+	waitForConditionPrefix    = "condition="
+)
+
+// exit codes of the wait command, 0 is used when every workload met the condition and 1 for
+// any other error
+const (
+	WaitConditionFailedExitCode = 3
+	WaitTimeoutExitCode         = 4
+)
+
+type WorkloadWaitOptions struct {
+	Namespace string
+	Names     []string
+	App       string
+
+	For       string
+	Timeout   time.Duration
+	DelayTime time.Duration
+}
+
+var (
+	_ validation.Validatable = (*WorkloadWaitOptions)(nil)
+	_ cli.Executable         = (*WorkloadWaitOptions)(nil)
+)
+
+// workloadWaitCondition is the parsed value of the --for flag
+type workloadWaitCondition struct {
+	For    string
+	Type   string
+	Status metav1.ConditionStatus
+}
+
+// workloadWaitFailedError is returned when a workload reached a state where the condition
+// will not be met without further changes
+type workloadWaitFailedError struct {
+	message string
+}
+
+func (e *workloadWaitFailedError) Error() string {
+	return e.message
+}
+
+func parseWorkloadWaitCondition(value string) (workloadWaitCondition, error) {
+	switch value {
+	case waitForReady:
+		return workloadWaitCondition{For: waitForReady, Type: cartov1alpha1.ConditionReady, Status: metav1.ConditionTrue}, nil
+	case waitForDeleted, waitForGenerationObserved:
+		return workloadWaitCondition{For: value}, nil
+	}
+	if !strings.HasPrefix(value, waitForConditionPrefix) {
+		return workloadWaitCondition{}, fmt.Errorf("unknown condition %q", value)
+	}
+	parts := strings.SplitN(strings.TrimPrefix(value, waitForConditionPrefix), "=", 2)
+	condition := workloadWaitCondition{For: value, Type: parts[0], Status: metav1.ConditionTrue}
+	if condition.Type == "" {
+		return workloadWaitCondition{}, fmt.Errorf("missing condition type in %q", value)
+	}
+	if len(parts) == 2 {
+		switch status := strings.ToLower(parts[1]); status {
+		case "true":
+			condition.Status = metav1.ConditionTrue
+		case "false":
+			condition.Status = metav1.ConditionFalse
+		case "unknown":
+			condition.Status = metav1.ConditionUnknown
+		default:
+			return workloadWaitCondition{}, fmt.Errorf("unknown condition status %q", parts[1])
+		}
+	}
+	return condition, nil
+}
+
+// evaluate returns true when the workload meets the condition, or an error when the condition
+// failed. Conditions are only considered once the latest generation of the workload was observed
+func (wc workloadWaitCondition) evaluate(workload *cartov1alpha1.Workload) (bool, error) {
+	if workload.Status.ObservedGeneration < workload.Generation {
+		return false, nil
+	}
+	if wc.For == waitForGenerationObserved {
+		return true, nil
+	}
+	cond := cliprinter.FindCondition(workload.Status.Conditions, wc.Type)
+	if cond == nil {
+		return false, nil
+	}
+	if cond.Status == wc.Status {
+		return true, nil
+	}
+	if wc.Status != metav1.ConditionUnknown && cond.Status != metav1.ConditionUnknown {
+		message := fmt.Sprintf("condition %s is %s", cond.Type, cond.Status)
+		if cond.Reason != "" {
+			message = fmt.Sprintf("%s, %s", message, cond.Reason)
+		}
+		if cond.Message != "" {
+			message = fmt.Sprintf("%s: %s", message, cond.Message)
+		}
+		return true, &workloadWaitFailedError{message: message}
+	}
+	return false, nil
+}
+
+func (wc workloadWaitCondition) describe(workload *cartov1alpha1.Workload) string {
+	switch wc.For {
+	case waitForReady:
+		return "is ready"
+	case waitForDeleted:
+		return "is deleted"
+	case waitForGenerationObserved:
+		return fmt.Sprintf("observed generation %d", workload.Generation)
+	default:
+		return fmt.Sprintf("has condition %s=%s", wc.Type, wc.Status)
+	}
+}
+
+func (opts *WorkloadWaitOptions) Validate(ctx context.Context) validation.FieldErrors {
+	errs := validation.FieldErrors{}
+
+	if opts.Namespace == "" {
+		errs = errs.Also(validation.ErrMissingField(flags.NamespaceFlagName))
+	}
+
+	if len(opts.Names) == 0 && opts.App == "" {
+		errs = errs.Also(validation.ErrMissingOneOf(cli.NamesArgumentName, flags.AppFlagName))
+	}
+	if len(opts.Names) != 0 && opts.App != "" {
+		errs = errs.Also(validation.ErrMultipleOneOf(cli.NamesArgumentName, flags.AppFlagName))
+	}
+	if opts.App != "" {
+		errs = errs.Also(validation.K8sName(opts.App, flags.AppFlagName))
+	}
+
+	if opts.For == "" {
+		errs = errs.Also(validation.ErrMissingField(flags.ForFlagName))
+	} else if _, err := parseWorkloadWaitCondition(opts.For); err != nil {
+		errs = errs.Also(validation.ErrInvalidValue(opts.For, flags.ForFlagName))
+	}
+
+	if opts.Timeout <= 0 {
+		errs = errs.Also(validation.ErrInvalidValue(opts.Timeout, flags.TimeoutFlagName))
+	}
+
+	return errs
+}
+
+type workloadWaitResult struct {
+	workload *cartov1alpha1.Workload
+	err      error
+}
+
+func (opts *WorkloadWaitOptions) Exec(ctx context.Context, c *cli.Config) error {
+	condition, err := parseWorkloadWaitCondition(opts.For)
+	if err != nil {
+		return err
+	}
+
+	names := opts.Names
+	if opts.App != "" {
+		list := &cartov1alpha1.WorkloadList{}
+		if err := c.List(ctx, list, client.InNamespace(opts.Namespace), client.MatchingLabels{apis.AppPartOfLabelName: opts.App}); err != nil {
+			return err
+		}
+		for _, workload := range list.Items {
+			names = append(names, workload.Name)
+		}
+		sort.Strings(names)
+		if len(names) == 0 {
+			c.Infof("No workloads found.\n")
+			if condition.For == waitForDeleted {
+				return nil
+			}
+			return cli.SilenceError(fmt.Errorf("no workloads found for app %q", opts.App))
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+	results := make([]workloadWaitResult, len(names))
+	var wg sync.WaitGroup
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, name string) {
+			defer wg.Done()
+			workload, err := opts.waitForWorkload(ctx, c, condition, name)
+			results[i] = workloadWaitResult{workload: workload, err: err}
+		}(i, name)
+	}
+	wg.Wait()
+
+	exitCode := 0
+	for i, result := range results {
+		var failedErr *workloadWaitFailedError
+		switch {
+		case result.err == nil:
+			c.Successf("Workload %q %s\n", names[i], condition.describe(result.workload))
+		case errors.As(result.err, &failedErr):
+			c.Eprintf("%s workload %q failed to meet condition %s: %s\n", printer.Serrorf("Error:"), names[i], condition.For, failedErr.message)
+			exitCode = WaitConditionFailedExitCode
+		case errors.Is(result.err, context.DeadlineExceeded):
+			c.Eprintf("%s timeout after %s waiting for workload %q to meet condition %s\n", printer.Serrorf("Error:"), opts.Timeout, names[i], condition.For)
+			if exitCode == 0 {
+				exitCode = WaitTimeoutExitCode
+			}
+		case apierrs.IsNotFound(result.err):
+			// a missing workload will never meet the condition, report it and keep going with the rest
+			c.Errorf("Workload %q not found\n", fmt.Sprintf("%s/%s", opts.Namespace, names[i]))
+			exitCode = WaitConditionFailedExitCode
+		default:
+			return result.err
+		}
+	}
+
+	switch exitCode {
+	case WaitConditionFailedExitCode:
+		return cli.SilenceError(cli.WithExitCode(fmt.Errorf("condition %s failed", condition.For), exitCode))
+	case WaitTimeoutExitCode:
+		return cli.SilenceError(cli.WithExitCode(fmt.Errorf("timeout waiting for condition %s", condition.For), exitCode))
+	}
+	return nil
+}
+
+// waitForWorkload blocks until the workload meets the condition, the condition fails or the
+// context is done
+func (opts *WorkloadWaitOptions) waitForWorkload(ctx context.Context, c *cli.Config, condition workloadWaitCondition, name string) (*cartov1alpha1.Workload, error) {
+	workload := &cartov1alpha1.Workload{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: opts.Namespace, Name: name}, workload); err != nil {
+		if apierrs.IsNotFound(err) && condition.For == waitForDeleted {
+			return workload, nil
+		}
+		return nil, err
+	}
+
+	if condition.For == waitForDeleted {
+		return workload, wait.UntilDelete(ctx, c.Client, workload)
+	}
+
+	// conditions already met are only trusted right away when no delay is requested
+	if met, err := condition.evaluate(workload); err != nil || (met && opts.DelayTime == 0) {
+		return workload, err
+	}
+
+	clientWithWatch, err := watch.GetWatcher(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	err = wait.UntilCondition(ctx, clientWithWatch, types.NamespacedName{Namespace: opts.Namespace, Name: name}, &cartov1alpha1.WorkloadList{}, func(target client.Object) (bool, error) {
+		obj, ok := target.(*cartov1alpha1.Workload)
+		if !ok {
+			return false, nil
+		}
+		met, err := condition.evaluate(obj)
+		if met {
+			obj.DeepCopyInto(workload)
+		}
+		return met, err
+	}, opts.DelayTime)
+	return workload, err
+}
+
+func NewWorkloadWaitCommand(ctx context.Context, c *cli.Config) *cobra.Command {
+	opts := &WorkloadWaitOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "wait",
+		Short: "Wait for workloads to meet a condition",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+Wait for one or more workloads to meet a condition. Workloads are selected by name, or
+by the app they are part of with %s. The condition is set with %s:
+
+  ready                         the Ready condition of the workload is True
+  deleted                       the workload no longer exists
+  condition=<type>[=<status>]   the condition of the given type has the status, True by default
+  generation-observed           the latest generation of the workload was observed
+
+Conditions are only considered once the latest generation of the workload is observed.
+A condition fails when it reaches the opposite status, for example when waiting for a
+workload to be ready and its Ready condition becomes False.
+
+The command exits with 0 when every workload met the condition, %d when the condition
+failed for a workload or a workload was not found and %d when the timeout was reached
+before the condition was met.
+`, flags.AppFlagName, flags.ForFlagName, WaitConditionFailedExitCode, WaitTimeoutExitCode)),
+		Example: strings.Join([]string{
+			fmt.Sprintf("%s workload wait my-workload %s ready", c.Name, flags.ForFlagName),
+			fmt.Sprintf("%s workload wait %s spring-petclinic %s condition=ResourcesHealthy %s 5m", c.Name, flags.AppFlagName, flags.ForFlagName, flags.TimeoutFlagName),
+			fmt.Sprintf("%s workload wait my-workload other-workload %s deleted", c.Name, flags.ForFlagName),
+		}, "\n"),
+		PreRunE:           cli.ValidateE(ctx, opts),
+		RunE:              cli.ExecE(ctx, c, opts),
+		ValidArgsFunction: completion.SuggestWorkloadNames(ctx, c),
+	}
+
+	cli.Args(cmd,
+		cli.NamesArg(&opts.Names),
+	)
+
+	cli.NamespaceFlag(ctx, cmd, c, &opts.Namespace)
+	cmd.Flags().StringVar(&opts.App, cli.StripDash(flags.AppFlagName), "", "wait for the workloads part of the `application`")
+	cmd.Flags().StringVar(&opts.For, cli.StripDash(flags.ForFlagName), waitForReady, fmt.Sprintf("`condition` to wait for, one of %q, %q, %q or %q", waitForReady, waitForDeleted, waitForConditionPrefix+"<type>[=<status>]", waitForGenerationObserved))
+	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.ForFlagName), func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return []string{waitForReady, waitForDeleted, waitForConditionPrefix, waitForGenerationObserved}, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+	})
+	cmd.Flags().DurationVar(&opts.Timeout, cli.StripDash(flags.TimeoutFlagName), 10*time.Minute, "maximum time to wait for the condition")
+	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.TimeoutFlagName), completion.SuggestDurationUnits(ctx, completion.CommonDurationUnits))
+	cmd.Flags().DurationVar(&opts.DelayTime, cli.StripDash(flags.DelayTimeFlagName), 0, "time the condition must hold before the wait ends, to prevent premature exit while the supply chain is running")
+	cmd.RegisterFlagCompletionFunc(cli.StripDash(flags.DelayTimeFlagName), completion.SuggestDurationUnits(ctx, completion.CommonDurationUnits))
+
+	return cmd
+}
@@ -0,0 +1,342 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package commands_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	diecorev1 "dies.dev/apis/core/v1"
+	diemetav1 "dies.dev/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	cli "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime"
+	clitesting "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/testing"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/validation"
+	watchhelper "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/watch"
+	watchfakes "github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/watch/fake"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/commands"
+	diecartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/dies/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/flags"
+)
+
+func TestWorkloadWaitOptionsValidate(t *testing.T) {
+	table := clitesting.ValidatableTestSuite{
+		{
+			Name:        "empty",
+			Validatable: &commands.WorkloadWaitOptions{},
+			ExpectFieldErrors: validation.FieldErrors{}.Also(
+				validation.ErrMissingField(flags.NamespaceFlagName),
+				validation.ErrMissingOneOf(cli.NamesArgumentName, flags.AppFlagName),
+				validation.ErrMissingField(flags.ForFlagName),
+				validation.ErrInvalidValue(time.Duration(0), flags.TimeoutFlagName),
+			),
+		},
+		{
+			Name: "valid",
+			Validatable: &commands.WorkloadWaitOptions{
+				Namespace: "default",
+				Names:     []string{"my-workload"},
+				For:       "ready",
+				Timeout:   time.Minute,
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "valid condition",
+			Validatable: &commands.WorkloadWaitOptions{
+				Namespace: "default",
+				App:       "my-app",
+				For:       "condition=ResourcesHealthy=false",
+				Timeout:   time.Minute,
+			},
+			ShouldValidate: true,
+		},
+		{
+			Name: "names and app",
+			Validatable: &commands.WorkloadWaitOptions{
+				Namespace: "default",
+				Names:     []string{"my-workload"},
+				App:       "my-app",
+				For:       "deleted",
+				Timeout:   time.Minute,
+			},
+			ExpectFieldErrors: validation.ErrMultipleOneOf(cli.NamesArgumentName, flags.AppFlagName),
+		},
+		{
+			Name: "unknown condition",
+			Validatable: &commands.WorkloadWaitOptions{
+				Namespace: "default",
+				Names:     []string{"my-workload"},
+				For:       "healthy",
+				Timeout:   time.Minute,
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("healthy", flags.ForFlagName),
+		},
+		{
+			Name: "missing condition type",
+			Validatable: &commands.WorkloadWaitOptions{
+				Namespace: "default",
+				Names:     []string{"my-workload"},
+				For:       "condition=",
+				Timeout:   time.Minute,
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("condition=", flags.ForFlagName),
+		},
+		{
+			Name: "unknown condition status",
+			Validatable: &commands.WorkloadWaitOptions{
+				Namespace: "default",
+				Names:     []string{"my-workload"},
+				For:       "condition=Ready=maybe",
+				Timeout:   time.Minute,
+			},
+			ExpectFieldErrors: validation.ErrInvalidValue("condition=Ready=maybe", flags.ForFlagName),
+		},
+	}
+
+	table.Run(t)
+}
+
+func TestWorkloadWaitCommand(t *testing.T) {
+	defaultNamespace := "default"
+	workloadName := "my-workload"
+	appName := "my-app"
+
+	scheme := runtime.NewScheme()
+	_ = cartov1alpha1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	givenNamespaceDefault := diecorev1.NamespaceBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(defaultNamespace)
+		})
+
+	workload := diecartov1alpha1.WorkloadBlank.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name(workloadName)
+			d.Namespace(defaultNamespace)
+			d.Generation(2)
+			d.AddLabel(apis.AppPartOfLabelName, appName)
+		})
+	withStatus := func(d *diecartov1alpha1.WorkloadDie, observedGeneration int64, ready metav1.ConditionStatus) *diecartov1alpha1.WorkloadDie {
+		return d.StatusDie(func(d *diecartov1alpha1.WorkloadStatusDie) {
+			d.DieStamp(func(r *cartov1alpha1.WorkloadStatus) {
+				r.ObservedGeneration = observedGeneration
+			})
+			d.ConditionsDie(
+				diecartov1alpha1.WorkloadConditionReadyBlank.Status(ready).Reason("Reason"+string(ready)).Message("the workload is "+string(ready)),
+				diecartov1alpha1.WorkloadConditionHealthyBlank.Status(metav1.ConditionFalse),
+			)
+		})
+	}
+	readyWorkload := withStatus(workload, 2, metav1.ConditionTrue)
+	failedWorkload := withStatus(workload, 2, metav1.ConditionFalse)
+	unknownWorkload := withStatus(workload, 2, metav1.ConditionUnknown)
+	staleWorkload := withStatus(workload, 1, metav1.ConditionTrue)
+	otherWorkload := readyWorkload.
+		MetadataDie(func(d *diemetav1.ObjectMetaDie) {
+			d.Name("other-workload")
+		})
+
+	expectExitCode := func(code int) func(t *testing.T, output string, err error) {
+		return func(t *testing.T, output string, err error) {
+			if expected, actual := code, cli.ExitCode(err); expected != actual {
+				t.Errorf("expected exit code %d, got %d", expected, actual)
+			}
+		}
+	}
+
+	table := clitesting.CommandTestSuite{
+		{
+			Name:        "empty",
+			Args:        []string{},
+			ShouldError: true,
+		},
+		{
+			Name:         "workload is ready",
+			Args:         []string{workloadName},
+			GivenObjects: []client.Object{readyWorkload},
+			ExpectOutput: `
+Workload "my-workload" is ready
+`,
+		},
+		{
+			Name:         "workload failed",
+			Args:         []string{workloadName, flags.ForFlagName, "ready"},
+			GivenObjects: []client.Object{failedWorkload},
+			ShouldError:  true,
+			Verify:       expectExitCode(commands.WaitConditionFailedExitCode),
+			ExpectOutput: `
+Error: workload "my-workload" failed to meet condition ready: condition Ready is False, ReasonFalse: the workload is False
+`,
+		},
+		{
+			Name:         "workload becomes ready",
+			Args:         []string{workloadName},
+			GivenObjects: []client.Object{unknownWorkload},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				fakeWatcher := watchfakes.NewFakeWithWatch(false, config.Client, []watch.Event{
+					{Type: watch.Modified, Object: unknownWorkload.DieReleasePtr()},
+					{Type: watch.Modified, Object: readyWorkload.DieReleasePtr()},
+				})
+				ctx = watchhelper.WithWatcher(ctx, fakeWatcher)
+				return ctx, nil
+			},
+			ExpectOutput: `
+Workload "my-workload" is ready
+`,
+		},
+		{
+			Name:         "timeout waiting for ready",
+			Args:         []string{workloadName, flags.TimeoutFlagName, "100ms"},
+			GivenObjects: []client.Object{staleWorkload},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				fakeWatcher := watchfakes.NewFakeWithWatch(false, config.Client, []watch.Event{
+					{Type: watch.Modified, Object: unknownWorkload.DieReleasePtr()},
+				})
+				ctx = watchhelper.WithWatcher(ctx, fakeWatcher)
+				return ctx, nil
+			},
+			ShouldError: true,
+			Verify:      expectExitCode(commands.WaitTimeoutExitCode),
+			ExpectOutput: `
+Error: timeout after 100ms waiting for workload "my-workload" to meet condition ready
+`,
+		},
+		{
+			Name:         "watch failed",
+			Args:         []string{workloadName},
+			GivenObjects: []client.Object{unknownWorkload},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				fakeWatcher := watchfakes.NewFakeWithWatch(true, config.Client, []watch.Event{})
+				ctx = watchhelper.WithWatcher(ctx, fakeWatcher)
+				return ctx, nil
+			},
+			ShouldError: true,
+			Verify:      expectExitCode(1),
+		},
+		{
+			Name:         "condition with status",
+			Args:         []string{workloadName, flags.ForFlagName, "condition=ResourcesHealthy=False"},
+			GivenObjects: []client.Object{unknownWorkload},
+			ExpectOutput: `
+Workload "my-workload" has condition ResourcesHealthy=False
+`,
+		},
+		{
+			Name:         "generation observed",
+			Args:         []string{workloadName, flags.ForFlagName, "generation-observed"},
+			GivenObjects: []client.Object{staleWorkload},
+			Prepare: func(t *testing.T, ctx context.Context, config *cli.Config, tc *clitesting.CommandTestCase) (context.Context, error) {
+				fakeWatcher := watchfakes.NewFakeWithWatch(false, config.Client, []watch.Event{
+					{Type: watch.Modified, Object: failedWorkload.DieReleasePtr()},
+				})
+				ctx = watchhelper.WithWatcher(ctx, fakeWatcher)
+				return ctx, nil
+			},
+			ExpectOutput: `
+Workload "my-workload" observed generation 2
+`,
+		},
+		{
+			Name:         "workload deleted",
+			Args:         []string{workloadName, flags.ForFlagName, "deleted"},
+			GivenObjects: []client.Object{givenNamespaceDefault},
+			ExpectOutput: `
+Workload "my-workload" is deleted
+`,
+		},
+		{
+			Name:         "timeout waiting for delete",
+			Args:         []string{workloadName, flags.ForFlagName, "deleted", flags.TimeoutFlagName, "100ms"},
+			GivenObjects: []client.Object{readyWorkload},
+			ShouldError:  true,
+			Verify:       expectExitCode(commands.WaitTimeoutExitCode),
+			ExpectOutput: `
+Error: timeout after 100ms waiting for workload "my-workload" to meet condition deleted
+`,
+		},
+		{
+			Name:         "workload not found",
+			Args:         []string{workloadName},
+			GivenObjects: []client.Object{givenNamespaceDefault},
+			ShouldError:  true,
+			Verify:       expectExitCode(commands.WaitConditionFailedExitCode),
+			ExpectOutput: `
+Workload "default/my-workload" not found
+`,
+		},
+		{
+			Name:         "one of several workloads not found",
+			Args:         []string{"missing-workload", workloadName},
+			GivenObjects: []client.Object{readyWorkload},
+			ShouldError:  true,
+			Verify:       expectExitCode(commands.WaitConditionFailedExitCode),
+			ExpectOutput: `
+Workload "default/missing-workload" not found
+Workload "my-workload" is ready
+`,
+		},
+		{
+			Name:         "workloads of an app",
+			Args:         []string{flags.AppFlagName, appName},
+			GivenObjects: []client.Object{otherWorkload, failedWorkload},
+			ShouldError:  true,
+			Verify:       expectExitCode(commands.WaitConditionFailedExitCode),
+			ExpectOutput: `
+Error: workload "my-workload" failed to meet condition ready: condition Ready is False, ReasonFalse: the workload is False
+Workload "other-workload" is ready
+`,
+		},
+		{
+			Name:         "no workloads for app",
+			Args:         []string{flags.AppFlagName, "other-app"},
+			GivenObjects: []client.Object{readyWorkload},
+			ShouldError:  true,
+			ExpectOutput: `
+No workloads found.
+`,
+		},
+		{
+			Name:         "no workloads for app to delete",
+			Args:         []string{flags.AppFlagName, "other-app", flags.ForFlagName, "deleted"},
+			GivenObjects: []client.Object{readyWorkload},
+			ExpectOutput: `
+No workloads found.
+`,
+		},
+		{
+			Name:         "list failed",
+			Args:         []string{flags.AppFlagName, appName},
+			GivenObjects: []client.Object{readyWorkload},
+			WithReactors: []clitesting.ReactionFunc{
+				clitesting.InduceFailure("list", "WorkloadList"),
+			},
+			ShouldError: true,
+		},
+	}
+
+	table.Run(t, scheme, commands.NewWorkloadWaitCommand)
+}
@@ -26,5 +26,6 @@ func (d *SupplyChainStatusDie) ConditionsDie(conditions ...*diemetav1.ConditionD
 }
 
 var (
-	ClusterSupplyChainConditionReadyBlank = diemetav1.ConditionBlank.Type(cartov1alpha1.SupplyChainReady)
+	ClusterSupplyChainConditionReadyBlank          = diemetav1.ConditionBlank.Type(cartov1alpha1.SupplyChainReady)
+	ClusterSupplyChainConditionTemplatesReadyBlank = diemetav1.ConditionBlank.Type(cartov1alpha1.SupplyChainTemplatesReady)
 )
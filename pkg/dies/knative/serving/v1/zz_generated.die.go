@@ -34,6 +34,273 @@ import (
 	servingv1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/knative/serving/v1"
 )
 
+var RevisionBlank = (&RevisionDie{}).DieFeed(servingv1.Revision{})
+
+type RevisionDie struct {
+	metav1.FrozenObjectMeta
+	mutable bool
+	r       servingv1.Revision
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *RevisionDie) DieImmutable(immutable bool) *RevisionDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *RevisionDie) DieFeed(r servingv1.Revision) *RevisionDie {
+	if d.mutable {
+		d.FrozenObjectMeta = metav1.FreezeObjectMeta(r.ObjectMeta)
+		d.r = r
+		return d
+	}
+	return &RevisionDie{
+		FrozenObjectMeta: metav1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *RevisionDie) DieFeedPtr(r *servingv1.Revision) *RevisionDie {
+	if r == nil {
+		r = &servingv1.Revision{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *RevisionDie) DieFeedRawExtension(raw runtime.RawExtension) *RevisionDie {
+	b, _ := json.Marshal(raw)
+	r := servingv1.Revision{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *RevisionDie) DieRelease() servingv1.Revision {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *RevisionDie) DieReleasePtr() *servingv1.Revision {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseUnstructured returns the resource managed by the die as an unstructured object.
+func (d *RevisionDie) DieReleaseUnstructured() *unstructured.Unstructured {
+	r := d.DieReleasePtr()
+	u, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(r)
+	return &unstructured.Unstructured{
+		Object: u,
+	}
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *RevisionDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *RevisionDie) DieStamp(fn func(r *servingv1.Revision)) *RevisionDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *RevisionDie) DeepCopy() *RevisionDie {
+	r := *d.r.DeepCopy()
+	return &RevisionDie{
+		FrozenObjectMeta: metav1.FreezeObjectMeta(r.ObjectMeta),
+		mutable:          d.mutable,
+		r:                r,
+	}
+}
+
+var _ runtime.Object = (*RevisionDie)(nil)
+
+func (d *RevisionDie) DeepCopyObject() runtime.Object {
+	return d.r.DeepCopy()
+}
+
+func (d *RevisionDie) GetObjectKind() schema.ObjectKind {
+	r := d.DieRelease()
+	return r.GetObjectKind()
+}
+
+func (d *RevisionDie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.r)
+}
+
+func (d *RevisionDie) UnmarshalJSON(b []byte) error {
+	if d == RevisionBlank {
+		return fmtx.Errorf("cannot unmarshal into the blank die, create a copy first")
+	}
+	if !d.mutable {
+		return fmtx.Errorf("cannot unmarshal into immutable dies, create a mutable version first")
+	}
+	r := &servingv1.Revision{}
+	err := json.Unmarshal(b, r)
+	*d = *d.DieFeed(*r)
+	return err
+}
+
+// APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+func (d *RevisionDie) APIVersion(v string) *RevisionDie {
+	return d.DieStamp(func(r *servingv1.Revision) {
+		r.APIVersion = v
+	})
+}
+
+// Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+func (d *RevisionDie) Kind(v string) *RevisionDie {
+	return d.DieStamp(func(r *servingv1.Revision) {
+		r.Kind = v
+	})
+}
+
+// MetadataDie stamps the resource's ObjectMeta field with a mutable die.
+func (d *RevisionDie) MetadataDie(fn func(d *metav1.ObjectMetaDie)) *RevisionDie {
+	return d.DieStamp(func(r *servingv1.Revision) {
+		d := metav1.ObjectMetaBlank.DieImmutable(false).DieFeed(r.ObjectMeta)
+		fn(d)
+		r.ObjectMeta = d.DieRelease()
+	})
+}
+
+// StatusDie stamps the resource's status field with a mutable die.
+func (d *RevisionDie) StatusDie(fn func(d *RevisionStatusDie)) *RevisionDie {
+	return d.DieStamp(func(r *servingv1.Revision) {
+		d := RevisionStatusBlank.DieImmutable(false).DieFeed(r.Status)
+		fn(d)
+		r.Status = d.DieRelease()
+	})
+}
+
+func (d *RevisionDie) Status(v servingv1.RevisionStatus) *RevisionDie {
+	return d.DieStamp(func(r *servingv1.Revision) {
+		r.Status = v
+	})
+}
+
+var RevisionStatusBlank = (&RevisionStatusDie{}).DieFeed(servingv1.RevisionStatus{})
+
+type RevisionStatusDie struct {
+	mutable bool
+	r       servingv1.RevisionStatus
+}
+
+// DieImmutable returns a new die for the current die's state that is either mutable (`false`) or immutable (`true`).
+func (d *RevisionStatusDie) DieImmutable(immutable bool) *RevisionStatusDie {
+	if d.mutable == !immutable {
+		return d
+	}
+	d = d.DeepCopy()
+	d.mutable = !immutable
+	return d
+}
+
+// DieFeed returns a new die with the provided resource.
+func (d *RevisionStatusDie) DieFeed(r servingv1.RevisionStatus) *RevisionStatusDie {
+	if d.mutable {
+		d.r = r
+		return d
+	}
+	return &RevisionStatusDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+// DieFeedPtr returns a new die with the provided resource pointer. If the resource is nil, the empty value is used instead.
+func (d *RevisionStatusDie) DieFeedPtr(r *servingv1.RevisionStatus) *RevisionStatusDie {
+	if r == nil {
+		r = &servingv1.RevisionStatus{}
+	}
+	return d.DieFeed(*r)
+}
+
+// DieFeedRawExtension returns the resource managed by the die as an raw extension.
+func (d *RevisionStatusDie) DieFeedRawExtension(raw runtime.RawExtension) *RevisionStatusDie {
+	b, _ := json.Marshal(raw)
+	r := servingv1.RevisionStatus{}
+	_ = json.Unmarshal(b, &r)
+	return d.DieFeed(r)
+}
+
+// DieRelease returns the resource managed by the die.
+func (d *RevisionStatusDie) DieRelease() servingv1.RevisionStatus {
+	if d.mutable {
+		return d.r
+	}
+	return *d.r.DeepCopy()
+}
+
+// DieReleasePtr returns a pointer to the resource managed by the die.
+func (d *RevisionStatusDie) DieReleasePtr() *servingv1.RevisionStatus {
+	r := d.DieRelease()
+	return &r
+}
+
+// DieReleaseRawExtension returns the resource managed by the die as an raw extension.
+func (d *RevisionStatusDie) DieReleaseRawExtension() runtime.RawExtension {
+	r := d.DieReleasePtr()
+	b, _ := json.Marshal(r)
+	raw := runtime.RawExtension{}
+	_ = json.Unmarshal(b, &raw)
+	return raw
+}
+
+// DieStamp returns a new die with the resource passed to the callback function. The resource is mutable.
+func (d *RevisionStatusDie) DieStamp(fn func(r *servingv1.RevisionStatus)) *RevisionStatusDie {
+	r := d.DieRelease()
+	fn(&r)
+	return d.DieFeed(r)
+}
+
+// DeepCopy returns a new die with equivalent state. Useful for snapshotting a mutable die.
+func (d *RevisionStatusDie) DeepCopy() *RevisionStatusDie {
+	r := *d.r.DeepCopy()
+	return &RevisionStatusDie{
+		mutable: d.mutable,
+		r:       r,
+	}
+}
+
+func (d *RevisionStatusDie) Conditions(v ...apismetav1.Condition) *RevisionStatusDie {
+	return d.DieStamp(func(r *servingv1.RevisionStatus) {
+		r.Conditions = v
+	})
+}
+
+// ActualReplicas reflects the amount of ready pods running this revision.
+func (d *RevisionStatusDie) ActualReplicas(v *int32) *RevisionStatusDie {
+	return d.DieStamp(func(r *servingv1.RevisionStatus) {
+		r.ActualReplicas = v
+	})
+}
+
+// DesiredReplicas reflects the desired amount of pods running this revision.
+func (d *RevisionStatusDie) DesiredReplicas(v *int32) *RevisionStatusDie {
+	return d.DieStamp(func(r *servingv1.RevisionStatus) {
+		r.DesiredReplicas = v
+	})
+}
+
 var ServiceBlank = (&ServiceDie{}).DieFeed(servingv1.Service{})
 
 type ServiceDie struct {
@@ -293,3 +560,24 @@ func (d *ServiceStatusDie) URL(v string) *ServiceStatusDie {
 		r.URL = v
 	})
 }
+
+// LatestReadyRevisionName holds the name of the latest Revision stamped out from this Service's Configuration that has had its "Ready" condition become "True".
+func (d *ServiceStatusDie) LatestReadyRevisionName(v string) *ServiceStatusDie {
+	return d.DieStamp(func(r *servingv1.ServiceStatus) {
+		r.LatestReadyRevisionName = v
+	})
+}
+
+// LatestCreatedRevisionName is the last revision that was created from this Service's Configuration. It might not be ready yet, for that use LatestReadyRevisionName.
+func (d *ServiceStatusDie) LatestCreatedRevisionName(v string) *ServiceStatusDie {
+	return d.DieStamp(func(r *servingv1.ServiceStatus) {
+		r.LatestCreatedRevisionName = v
+	})
+}
+
+// Traffic holds the configured traffic distribution. These entries will always contain RevisionName references. When ConfigurationName appears in the spec, this will hold the LatestReadyRevisionName that we last observed.
+func (d *ServiceStatusDie) Traffic(v ...servingv1.TrafficTarget) *ServiceStatusDie {
+	return d.DieStamp(func(r *servingv1.ServiceStatus) {
+		r.Traffic = v
+	})
+}
@@ -27,6 +27,24 @@ import (
 	testing "dies.dev/testing"
 )
 
+func TestRevisionDie_MissingMethods(t *testingx.T) {
+	die := RevisionBlank
+	ignore := []string{"TypeMeta", "ObjectMeta"}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for RevisionDie: %s", diff.List())
+	}
+}
+
+func TestRevisionStatusDie_MissingMethods(t *testingx.T) {
+	die := RevisionStatusBlank
+	ignore := []string{}
+	diff := testing.DieFieldDiff(die).Delete(ignore...)
+	if diff.Len() != 0 {
+		t.Errorf("found missing fields for RevisionStatusDie: %s", diff.List())
+	}
+}
+
 func TestServiceDie_MissingMethods(t *testingx.T) {
 	die := ServiceBlank
 	ignore := []string{"TypeMeta", "ObjectMeta"}
@@ -35,6 +35,7 @@ const (
 	EnvFlagName              = "--env"
 	ExportFlagName           = "--export"
 	FilePathFlagName         = "--file"
+	ForFlagName              = "--for"
 	GitBranchFlagName        = "--git-branch"
 	GitCommitFlagName        = "--git-commit"
 	GitFlagWildcard          = "--git-*"
@@ -47,6 +48,7 @@ const (
 	LimitMemoryFlagName      = "--limit-memory"
 	LiveUpdateFlagName       = "--live-update"
 	LocalPathFlagName        = "--local-path"
+	MaxDepthFlagName         = "--max-depth"
 	MavenArtifactFlagName    = "--maven-artifact"
 	MavenGroupFlagName       = "--maven-group"
 	MavenTypeFlagName        = "--maven-type"
@@ -54,26 +56,42 @@ const (
 	NamespaceFlagName        = cli.NamespaceFlagName
 	NoColorFlagName          = cli.NoColorFlagName
 	OutputFlagName           = "--output"
+	OutputsFlagName          = "--outputs"
 	ParamFlagName            = "--param"
 	ParamYamlFlagName        = "--param-yaml"
+	PruneFlagName            = "--prune"
+	ReadyFlagName            = "--ready"
+	RecursiveFlagName        = "--recursive"
 	RegistryCertFlagName     = "--registry-ca-cert"
 	RegistryPasswordFlagName = "--registry-password"
 	RegistryTokenFlagName    = "--registry-token"
 	RegistryUsernameFlagName = "--registry-username"
+	RelatedFlagName          = "--related"
 	RequestCPUFlagName       = "--request-cpu"
 	RequestMemoryFlagName    = "--request-memory"
+	SelectorFlagName         = "--selector"
 	ServiceAccountFlagName   = "--service-account"
 	ServiceRefFlagName       = "--service-ref"
 	SinceFlagName            = "--since"
+	SortByFlagName           = "--sort-by"
 	SourceImageFlagName      = "--source-image"
+	StrictFlagName           = "--strict"
 	SubPathFlagName          = "--sub-path"
+	SupplyChainFlagName      = "--supply-chain"
 	TailFlagName             = "--tail"
 	TimestampFlagName        = "--timestamp"
+	TimeoutFlagName          = "--timeout"
 	TailTimestampFlagName    = "--tail-timestamp"
+	ToContextFlagName        = "--to-context"
+	ToNamespaceFlagName      = "--to-namespace"
+	ToRevisionFlagName       = "--to-revision"
 	TypeFlagName             = "--type"
+	UntilAllReadyFlagName    = "--until-all-ready"
 	UpdateStrategyFlagName   = "--update-strategy"
 	VerboseLevelFlagName     = "--verbose"
+	WatchFlagName            = "--watch"
 	WaitFlagName             = "--wait"
 	WaitTimeoutFlagName      = "--wait-timeout"
+	WorkloadFlagName         = "--workload"
 	YesFlagName              = "--yes"
 )
@@ -21,13 +21,23 @@ import (
 )
 
 type Object = printer.Object
+type FieldChange = printer.FieldChange
 
 var ExportResource = printer.ExportResource
 var OutputResource = printer.OutputResource
+var OutputResources = printer.OutputResources
+var OutputObject = printer.OutputObject
+var UnstructuredResource = printer.UnstructuredResource
 var FindCondition = printer.FindCondition
+var ConditionStatus = printer.ConditionStatus
+var TimestampSince = printer.TimestampSince
+var EmptyString = printer.EmptyString
+var Labels = printer.Labels
 var ResourceDiff = printer.ResourceDiff
+var ResourceDiffFields = printer.ResourceDiffFields
 var ResourceStatus = printer.ResourceStatus
 var Serrorf = printer.Serrorf
+var Sfaintf = printer.Sfaintf
 var SortByNamespaceAndName = printer.SortByNamespaceAndName
 
 type OutputFormat = printer.OutputFormat
@@ -35,3 +45,6 @@ type OutputFormat = printer.OutputFormat
 var OutputFormatJson = printer.OutputFormatJson
 var OutputFormatYaml = printer.OutputFormatYaml
 var OutputFormatYml = printer.OutputFormatYml
+var OutputFormatWide = printer.OutputFormatWide
+var ObjectOutputFormats = printer.ObjectOutputFormats
+var TableOutputFormats = printer.TableOutputFormats
@@ -0,0 +1,138 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
+)
+
+// AppOverviewPrinter prints the name and namespace of an application, and how many of its
+// workloads are ready
+func AppOverviewPrinter(w io.Writer, name string, namespace string, workloads *cartov1alpha1.WorkloadList, ready int) error {
+	printAppOverview := func(workloads *cartov1alpha1.WorkloadList, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		return []metav1beta1.TableRow{
+			{Cells: []interface{}{"name:", name}},
+			{Cells: []interface{}{"namespace:", namespace}},
+			{Cells: []interface{}{"workloads:", fmt.Sprintf("%d/%d ready", ready, len(workloads.Items))}},
+		}, nil
+	}
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{NoHeaders: true, PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		h.TableHandler(nil, printAppOverview)
+	})
+
+	return tablePrinter.PrintObj(workloads, w)
+}
+
+// AppWorkloadsPrinter prints the readiness, supply chain and urls of the workloads of an
+// application. The readiness and urls are keyed by the namespace and name of the workload, a
+// workload is ready when it and its deliverable are
+func AppWorkloadsPrinter(w io.Writer, workloads *cartov1alpha1.WorkloadList, ready map[types.NamespacedName]bool, urls map[types.NamespacedName][]string) error {
+	printWorkloadRow := func(workload *cartov1alpha1.Workload, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		key := types.NamespacedName{Namespace: workload.Namespace, Name: workload.Name}
+		row := metav1beta1.TableRow{
+			Object: runtime.RawExtension{Object: workload},
+			Cells: []interface{}{
+				workload.Name,
+				printer.EmptyString(workload.Labels[apis.WorkloadTypeLabelName]),
+				appWorkloadReadiness(workload, ready[key]),
+				printer.EmptyString(workload.Status.SupplyChainRef.Name),
+				printer.EmptyString(strings.Join(urls[key], ",")),
+			},
+		}
+		return []metav1beta1.TableRow{row}, nil
+	}
+	printWorkloadList := func(workloads *cartov1alpha1.WorkloadList, printOpts table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		rows := make([]metav1beta1.TableRow, 0, len(workloads.Items))
+		for i := range workloads.Items {
+			r, err := printWorkloadRow(&workloads.Items[i], printOpts)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, r...)
+		}
+		return rows, nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		columns := []metav1beta1.TableColumnDefinition{
+			{Name: "Name", Type: "string"},
+			{Name: "Type", Type: "string"},
+			{Name: "Ready", Type: "string"},
+			{Name: "Supply Chain", Type: "string"},
+			{Name: "URL", Type: "string"},
+		}
+		h.TableHandler(columns, printWorkloadList)
+		h.TableHandler(columns, printWorkloadRow)
+	})
+
+	return tablePrinter.PrintObj(workloads, w)
+}
+
+// AppMessagesPrinter prints the messages reported by the workloads of an application and their
+// deliverables, keyed by the name of the workload
+func AppMessagesPrinter(w io.Writer, issues map[string][]ConditionIssue) error {
+	names := make([]string, 0, len(issues))
+	for name := range issues {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	messages := &metav1beta1.Table{
+		ColumnDefinitions: []metav1beta1.TableColumnDefinition{
+			{Name: "Workload", Type: "string"},
+			{Name: "Kind", Type: "string"},
+			{Name: "Message", Type: "string"},
+		},
+	}
+	for _, name := range names {
+		for _, issue := range issues[name] {
+			messages.Rows = append(messages.Rows, metav1beta1.TableRow{
+				Cells: []interface{}{
+					name,
+					fmt.Sprintf("%s %s:", issue.Kind, printer.Sfaintf("[%s]", issue.Reason)),
+					issue.Message,
+				},
+			})
+		}
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{NoHeaders: true, PaddingStart: paddingStart})
+	return tablePrinter.PrintObj(messages, w)
+}
+
+// appWorkloadReadiness returns the Ready condition of the workload, or not-Ready when the workload
+// is ready but its deliverable is not
+func appWorkloadReadiness(workload *cartov1alpha1.Workload, ready bool) string {
+	cond := printer.FindCondition(workload.Status.Conditions, cartov1alpha1.WorkloadConditionReady)
+	if !ready && cond != nil && cond.Status == metav1.ConditionTrue {
+		return printer.Serrorf("not-" + cartov1alpha1.WorkloadConditionReady)
+	}
+	return printer.ConditionStatus(cond)
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+func TestAppOverviewPrinter(t *testing.T) {
+	workloads := &cartov1alpha1.WorkloadList{
+		Items: []cartov1alpha1.Workload{
+			{ObjectMeta: metav1.ObjectMeta{Name: "api", Namespace: "default"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "ui", Namespace: "default"}},
+		},
+	}
+	expectedOutput := `
+   name:        my-app
+   namespace:   default
+   workloads:   1/2 ready
+`
+
+	output := &bytes.Buffer{}
+	if err := printer.AppOverviewPrinter(output, "my-app", "default", workloads, 1); err != nil {
+		t.Errorf("AppOverviewPrinter() expected no error, got %v", err)
+	}
+	if diff := cmp.Diff(strings.TrimPrefix(expectedOutput, "\n"), output.String()); diff != "" {
+		t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+	}
+}
+
+func TestAppWorkloadsPrinter(t *testing.T) {
+	workloads := &cartov1alpha1.WorkloadList{
+		Items: []cartov1alpha1.Workload{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "api",
+					Namespace: "default",
+					Labels:    map[string]string{apis.WorkloadTypeLabelName: "web"},
+				},
+				Status: cartov1alpha1.WorkloadStatus{
+					SupplyChainRef: cartov1alpha1.ObjectReference{Name: "source-to-url"},
+					Conditions:     []metav1.Condition{{Type: cartov1alpha1.WorkloadConditionReady, Status: metav1.ConditionTrue, Reason: "Ready"}},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "ui", Namespace: "default"},
+				Status: cartov1alpha1.WorkloadStatus{
+					Conditions: []metav1.Condition{{Type: cartov1alpha1.WorkloadConditionReady, Status: metav1.ConditionTrue, Reason: "Ready"}},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "worker", Namespace: "default"},
+			},
+		},
+	}
+	ready := map[types.NamespacedName]bool{
+		{Namespace: "default", Name: "api"}: true,
+	}
+	urls := map[types.NamespacedName][]string{
+		{Namespace: "default", Name: "api"}: {"https://api.example.com", "https://api.internal.example.com"},
+	}
+	expectedOutput := `
+   NAME     TYPE      READY       SUPPLY CHAIN    URL
+   api      web       Ready       source-to-url   https://api.example.com,https://api.internal.example.com
+   ui       <empty>   not-Ready   <empty>         <empty>
+   worker   <empty>   <unknown>   <empty>         <empty>
+`
+
+	output := &bytes.Buffer{}
+	if err := printer.AppWorkloadsPrinter(output, workloads, ready, urls); err != nil {
+		t.Errorf("AppWorkloadsPrinter() expected no error, got %v", err)
+	}
+	if diff := cmp.Diff(strings.TrimPrefix(expectedOutput, "\n"), output.String()); diff != "" {
+		t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+	}
+}
+
+func TestAppMessagesPrinter(t *testing.T) {
+	issues := map[string][]printer.ConditionIssue{
+		"ui": {
+			{Kind: "Workload", Reason: "MissingValueAtPath", Message: "waiting to read value [.status.latestImage]"},
+		},
+		"api": {
+			{Kind: "Workload", Reason: "Ready", Message: "deliverable is not ready"},
+			{Kind: "Deliverable", Reason: "TemplateRejectedByAPIServer", Message: "unable to apply object"},
+		},
+	}
+	expectedOutput := `
+   api   Workload [Ready]:                            deliverable is not ready
+   api   Deliverable [TemplateRejectedByAPIServer]:   unable to apply object
+   ui    Workload [MissingValueAtPath]:               waiting to read value [.status.latestImage]
+`
+
+	output := &bytes.Buffer{}
+	if err := printer.AppMessagesPrinter(output, issues); err != nil {
+		t.Errorf("AppMessagesPrinter() expected no error, got %v", err)
+	}
+	if diff := cmp.Diff(strings.TrimPrefix(expectedOutput, "\n"), output.String()); diff != "" {
+		t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+	}
+}
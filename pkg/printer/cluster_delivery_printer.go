@@ -0,0 +1,79 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer
+
+import (
+	"fmt"
+	"io"
+
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
+)
+
+// ClusterDeliveryPrinter prints the selectors of the delivery, in the same table as the selectors
+// of a supply chain
+func ClusterDeliveryPrinter(w io.Writer, delivery *cartov1alpha1.ClusterDelivery) error {
+	return ClusterSupplyChainPrinter(w, &cartov1alpha1.ClusterSupplyChain{
+		ObjectMeta: delivery.ObjectMeta,
+		Spec: cartov1alpha1.SupplyChainSpec{
+			Selector:                 delivery.Spec.Selector,
+			SelectorMatchExpressions: delivery.Spec.SelectorMatchExpressions,
+			SelectorMatchFields:      delivery.Spec.SelectorMatchFields,
+		},
+	})
+}
+
+// ClusterDeliveryResourcesPrinter prints the resources of the delivery, the template stamping
+// each of them and the resources whose sources, deployment and configs they consume
+func ClusterDeliveryResourcesPrinter(w io.Writer, delivery *cartov1alpha1.ClusterDelivery) error {
+	printResourceRows := func(delivery *cartov1alpha1.ClusterDelivery, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		rows := make([]metav1beta1.TableRow, 0, len(delivery.Spec.Resources))
+		for _, resource := range delivery.Spec.Resources {
+			deployment := printer.Sfaintf("<none>")
+			if resource.Deployment != nil {
+				deployment = resource.Deployment.Resource
+			}
+			rows = append(rows, metav1beta1.TableRow{
+				Object: runtime.RawExtension{Object: delivery},
+				Cells: []interface{}{
+					resource.Name,
+					fmt.Sprintf("%s/%s", resource.TemplateRef.Kind, resource.TemplateRef.Name),
+					resourceReferenceNames(resource.Sources),
+					deployment,
+					resourceReferenceNames(resource.Configs),
+				},
+			})
+		}
+		return rows, nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		columns := []metav1beta1.TableColumnDefinition{
+			{Name: "Name", Type: "string"},
+			{Name: "Template", Type: "string"},
+			{Name: "Sources", Type: "string"},
+			{Name: "Deployment", Type: "string"},
+			{Name: "Configs", Type: "string"},
+		}
+		h.TableHandler(columns, printResourceRows)
+	})
+	return tablePrinter.PrintObj(delivery, w)
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+func TestClusterDeliveryPrinter(t *testing.T) {
+	delivery := &cartov1alpha1.ClusterDelivery{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "delivery-basic",
+		},
+		Spec: cartov1alpha1.DeliverySpec{
+			Selector: map[string]string{"app.tanzu.vmware.com/deliverable-type": "web"},
+			SelectorMatchFields: []cartov1alpha1.FieldSelectorRequirement{{
+				Key:      "spec.source.image",
+				Operator: "Exists",
+			}},
+		},
+	}
+	expectedOutput := `
+   TYPE     KEY                                     OPERATOR   VALUE
+   labels   app.tanzu.vmware.com/deliverable-type              web
+   fields   spec.source.image                       Exists
+`
+
+	output := &bytes.Buffer{}
+	if err := printer.ClusterDeliveryPrinter(output, delivery); err != nil {
+		t.Errorf("ClusterDeliveryPrinter() expected no error, got %v", err)
+	}
+	if diff := cmp.Diff(strings.TrimPrefix(expectedOutput, "\n"), output.String()); diff != "" {
+		t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+	}
+}
+
+func TestClusterDeliveryResourcesPrinter(t *testing.T) {
+	delivery := &cartov1alpha1.ClusterDelivery{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "delivery-basic",
+		},
+		Spec: cartov1alpha1.DeliverySpec{
+			Resources: []cartov1alpha1.DeliveryResource{{
+				Name:        "source-provider",
+				TemplateRef: cartov1alpha1.DeliveryTemplateReference{Kind: "ClusterSourceTemplate", Name: "delivery-source-template"},
+			}, {
+				Name:        "config-provider",
+				TemplateRef: cartov1alpha1.DeliveryTemplateReference{Kind: "ClusterConfigTemplate", Name: "delivery-config"},
+				Sources:     []cartov1alpha1.ResourceReference{{Name: "source", Resource: "source-provider"}},
+			}, {
+				Name:        "deployer",
+				TemplateRef: cartov1alpha1.DeliveryTemplateReference{Kind: "ClusterDeploymentTemplate", Name: "app-deploy"},
+				Deployment:  &cartov1alpha1.DeploymentReference{Resource: "source-provider"},
+				Configs:     []cartov1alpha1.ResourceReference{{Name: "config", Resource: "config-provider"}},
+			}},
+		},
+	}
+	expectedOutput := `
+   NAME              TEMPLATE                                         SOURCES           DEPLOYMENT        CONFIGS
+   source-provider   ClusterSourceTemplate/delivery-source-template   <none>            <none>            <none>
+   config-provider   ClusterConfigTemplate/delivery-config            source-provider   <none>            <none>
+   deployer          ClusterDeploymentTemplate/app-deploy             <none>            source-provider   config-provider
+`
+
+	output := &bytes.Buffer{}
+	if err := printer.ClusterDeliveryResourcesPrinter(output, delivery); err != nil {
+		t.Errorf("ClusterDeliveryResourcesPrinter() expected no error, got %v", err)
+	}
+	if diff := cmp.Diff(strings.TrimPrefix(expectedOutput, "\n"), output.String()); diff != "" {
+		t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+	}
+}
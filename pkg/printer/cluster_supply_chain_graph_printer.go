@@ -0,0 +1,223 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
+)
+
+const (
+	OutputFormatDot     = "dot"
+	OutputFormatMermaid = "mermaid"
+)
+
+// GraphOutputFormats are the formats rendering the resources of a supply chain as a graph
+var GraphOutputFormats = []string{OutputFormatDot, OutputFormatMermaid}
+
+// supplyChainEdge is an output of a supply chain resource consumed by another resource
+type supplyChainEdge struct {
+	from  string
+	to    string
+	label string
+}
+
+func supplyChainEdges(supplyChain *cartov1alpha1.ClusterSupplyChain) []supplyChainEdge {
+	edges := []supplyChainEdge{}
+	for _, resource := range supplyChain.Spec.Resources {
+		for _, ref := range resource.Sources {
+			edges = append(edges, supplyChainEdge{from: ref.Resource, to: resource.Name, label: "source"})
+		}
+		for _, ref := range resource.Images {
+			edges = append(edges, supplyChainEdge{from: ref.Resource, to: resource.Name, label: "image"})
+		}
+		for _, ref := range resource.Configs {
+			edges = append(edges, supplyChainEdge{from: ref.Resource, to: resource.Name, label: "config"})
+		}
+	}
+	return edges
+}
+
+func supplyChainResourceReadyCondition(name string, realized []cartov1alpha1.RealizedResource) *metav1.Condition {
+	for i := range realized {
+		if realized[i].Name == name {
+			return printer.FindCondition(realized[i].Conditions, cartov1alpha1.ConditionReady)
+		}
+	}
+	return nil
+}
+
+// supplyChainResourceReadiness returns the status of the Ready condition of the resource as
+// realized for a workload, or "Unknown" when the resource has not been realized yet
+func supplyChainResourceReadiness(name string, realized []cartov1alpha1.RealizedResource) metav1.ConditionStatus {
+	if cond := supplyChainResourceReadyCondition(name, realized); cond != nil && cond.Status != "" {
+		return cond.Status
+	}
+	return metav1.ConditionUnknown
+}
+
+func resourceReferenceNames(refs []cartov1alpha1.ResourceReference) string {
+	if len(refs) == 0 {
+		return printer.Sfaintf("<none>")
+	}
+	names := make([]string, len(refs))
+	for i := range refs {
+		names[i] = refs[i].Resource
+	}
+	return strings.Join(names, ", ")
+}
+
+// ClusterSupplyChainResourcesPrinter prints the resources of the supply chain, the template
+// stamping each of them and the resources whose sources, images and configs they consume. When
+// the realized resources of a workload are given, the readiness of each resource is printed too
+func ClusterSupplyChainResourcesPrinter(w io.Writer, supplyChain *cartov1alpha1.ClusterSupplyChain, realized []cartov1alpha1.RealizedResource) error {
+	printResourceRows := func(supplyChain *cartov1alpha1.ClusterSupplyChain, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		rows := make([]metav1beta1.TableRow, 0, len(supplyChain.Spec.Resources))
+		for _, resource := range supplyChain.Spec.Resources {
+			row := metav1beta1.TableRow{
+				Object: runtime.RawExtension{Object: supplyChain},
+				Cells: []interface{}{
+					resource.Name,
+					fmt.Sprintf("%s/%s", resource.TemplateRef.Kind, resource.TemplateRef.Name),
+					resourceReferenceNames(resource.Sources),
+					resourceReferenceNames(resource.Images),
+					resourceReferenceNames(resource.Configs),
+				},
+			}
+			if realized != nil {
+				row.Cells = append(row.Cells, printer.ConditionStatus(supplyChainResourceReadyCondition(resource.Name, realized)))
+			}
+			rows = append(rows, row)
+		}
+		return rows, nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		columns := []metav1beta1.TableColumnDefinition{
+			{Name: "Name", Type: "string"},
+			{Name: "Template", Type: "string"},
+			{Name: "Sources", Type: "string"},
+			{Name: "Images", Type: "string"},
+			{Name: "Configs", Type: "string"},
+		}
+		if realized != nil {
+			columns = append(columns, metav1beta1.TableColumnDefinition{Name: "Ready", Type: "string"})
+		}
+		h.TableHandler(columns, printResourceRows)
+	})
+	return tablePrinter.PrintObj(supplyChain, w)
+}
+
+// SupplyChainGraph renders the resources of the supply chain as a graph in the dot or mermaid
+// format. Each node is a resource labeled with its template, each edge is an output consumed by
+// another resource labeled with its type. When the realized resources of a workload are given,
+// the nodes are colored after the readiness of each resource
+func SupplyChainGraph(format string, supplyChain *cartov1alpha1.ClusterSupplyChain, realized []cartov1alpha1.RealizedResource) (string, error) {
+	switch format {
+	case OutputFormatDot:
+		return supplyChainDot(supplyChain, realized), nil
+	case OutputFormatMermaid:
+		return supplyChainMermaid(supplyChain, realized), nil
+	}
+	return "", fmt.Errorf("unsupported graph format %q", format)
+}
+
+var dotReadinessColors = map[metav1.ConditionStatus]string{
+	metav1.ConditionTrue:    "green",
+	metav1.ConditionFalse:   "red",
+	metav1.ConditionUnknown: "gray",
+}
+
+func supplyChainDot(supplyChain *cartov1alpha1.ClusterSupplyChain, realized []cartov1alpha1.RealizedResource) string {
+	b := &strings.Builder{}
+	fmt.Fprintf(b, "digraph %s {\n", strconv.Quote(supplyChain.Name))
+	b.WriteString("  rankdir=\"LR\";\n")
+	b.WriteString("  node [shape=box];\n")
+	for _, resource := range supplyChain.Spec.Resources {
+		label := fmt.Sprintf("%s\n%s/%s", resource.Name, resource.TemplateRef.Kind, resource.TemplateRef.Name)
+		if realized == nil {
+			fmt.Fprintf(b, "  %s [label=%s];\n", strconv.Quote(resource.Name), strconv.Quote(label))
+			continue
+		}
+		readiness := supplyChainResourceReadiness(resource.Name, realized)
+		label = fmt.Sprintf("%s\nready: %s", label, readiness)
+		fmt.Fprintf(b, "  %s [label=%s, color=%s];\n", strconv.Quote(resource.Name), strconv.Quote(label), dotReadinessColors[readiness])
+	}
+	for _, edge := range supplyChainEdges(supplyChain) {
+		fmt.Fprintf(b, "  %s -> %s [label=%s];\n", strconv.Quote(edge.from), strconv.Quote(edge.to), strconv.Quote(edge.label))
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+var mermaidReadinessClasses = map[metav1.ConditionStatus]string{
+	metav1.ConditionTrue:    "ready",
+	metav1.ConditionFalse:   "notready",
+	metav1.ConditionUnknown: "unknown",
+}
+
+func supplyChainMermaid(supplyChain *cartov1alpha1.ClusterSupplyChain, realized []cartov1alpha1.RealizedResource) string {
+	// resource names are not valid mermaid ids in every case, nodes are identified by their position
+	ids := map[string]string{}
+	nodeID := func(name string) string {
+		if _, ok := ids[name]; !ok {
+			ids[name] = fmt.Sprintf("r%d", len(ids))
+		}
+		return ids[name]
+	}
+	escape := strings.NewReplacer(`"`, "#quot;").Replace
+
+	b := &strings.Builder{}
+	b.WriteString("flowchart LR\n")
+	classes := []string{}
+	for _, resource := range supplyChain.Spec.Resources {
+		id := nodeID(resource.Name)
+		label := fmt.Sprintf("%s<br/>%s/%s", resource.Name, resource.TemplateRef.Kind, resource.TemplateRef.Name)
+		if realized != nil {
+			readiness := supplyChainResourceReadiness(resource.Name, realized)
+			label = fmt.Sprintf("%s<br/>ready: %s", label, readiness)
+			classes = append(classes, fmt.Sprintf("  class %s %s\n", id, mermaidReadinessClasses[readiness]))
+		}
+		fmt.Fprintf(b, "  %s[\"%s\"]\n", id, escape(label))
+	}
+	for _, edge := range supplyChainEdges(supplyChain) {
+		if _, ok := ids[edge.from]; !ok {
+			// the resource consumed is not declared by the supply chain
+			fmt.Fprintf(b, "  %s[\"%s\"]\n", nodeID(edge.from), escape(edge.from))
+		}
+		fmt.Fprintf(b, "  %s -->|%s| %s\n", nodeID(edge.from), edge.label, nodeID(edge.to))
+	}
+	if realized != nil {
+		b.WriteString("  classDef ready stroke:#2e7d32,fill:#e8f5e9\n")
+		b.WriteString("  classDef notready stroke:#c62828,fill:#ffebee\n")
+		b.WriteString("  classDef unknown stroke:#757575,fill:#f5f5f5\n")
+		for _, class := range classes {
+			b.WriteString(class)
+		}
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+func TestClusterSupplyChainResourcesPrinter(t *testing.T) {
+	supplyChain := &cartov1alpha1.ClusterSupplyChain{
+		ObjectMeta: metav1.ObjectMeta{Name: "source-to-url"},
+		Spec: cartov1alpha1.SupplyChainSpec{
+			Resources: []cartov1alpha1.SupplyChainResource{{
+				Name:        "source-provider",
+				TemplateRef: cartov1alpha1.SupplyChainTemplateReference{Kind: "ClusterSourceTemplate", Name: "source-template"},
+			}, {
+				Name:        "image-builder",
+				TemplateRef: cartov1alpha1.SupplyChainTemplateReference{Kind: "ClusterImageTemplate", Name: "kpack-template"},
+				Sources:     []cartov1alpha1.ResourceReference{{Name: "source", Resource: "source-provider"}},
+			}},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		realized       []cartov1alpha1.RealizedResource
+		expectedOutput string
+	}{{
+		name: "resources",
+		expectedOutput: `
+   NAME              TEMPLATE                                SOURCES           IMAGES   CONFIGS
+   source-provider   ClusterSourceTemplate/source-template   <none>            <none>   <none>
+   image-builder     ClusterImageTemplate/kpack-template     source-provider   <none>   <none>
+`,
+	}, {
+		name: "resources realized for a workload",
+		realized: []cartov1alpha1.RealizedResource{{
+			Name:       "source-provider",
+			Conditions: []metav1.Condition{{Type: cartov1alpha1.ConditionReady, Status: metav1.ConditionTrue}},
+		}},
+		expectedOutput: `
+   NAME              TEMPLATE                                SOURCES           IMAGES   CONFIGS   READY
+   source-provider   ClusterSourceTemplate/source-template   <none>            <none>   <none>    Ready
+   image-builder     ClusterImageTemplate/kpack-template     source-provider   <none>   <none>    <unknown>
+`,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output := &bytes.Buffer{}
+			if err := printer.ClusterSupplyChainResourcesPrinter(output, supplyChain, test.realized); err != nil {
+				t.Errorf("ClusterSupplyChainResourcesPrinter() expected no error, got %v", err)
+			}
+			if diff := cmp.Diff(strings.TrimPrefix(test.expectedOutput, "\n"), output.String()); diff != "" {
+				t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSupplyChainGraph(t *testing.T) {
+	supplyChain := &cartov1alpha1.ClusterSupplyChain{
+		ObjectMeta: metav1.ObjectMeta{Name: "source-to-url"},
+		Spec: cartov1alpha1.SupplyChainSpec{
+			Resources: []cartov1alpha1.SupplyChainResource{{
+				Name:        "image-builder",
+				TemplateRef: cartov1alpha1.SupplyChainTemplateReference{Kind: "ClusterImageTemplate", Name: "kpack-template"},
+				Sources:     []cartov1alpha1.ResourceReference{{Name: "source", Resource: "source-provider"}},
+			}},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		format         string
+		expectedOutput string
+		shouldError    bool
+	}{{
+		name:   "dot with a resource not declared",
+		format: printer.OutputFormatDot,
+		expectedOutput: `
+digraph "source-to-url" {
+  rankdir="LR";
+  node [shape=box];
+  "image-builder" [label="image-builder\nClusterImageTemplate/kpack-template"];
+  "source-provider" -> "image-builder" [label="source"];
+}`,
+	}, {
+		name:   "mermaid with a resource not declared",
+		format: printer.OutputFormatMermaid,
+		expectedOutput: `
+flowchart LR
+  r0["image-builder<br/>ClusterImageTemplate/kpack-template"]
+  r1["source-provider"]
+  r1 -->|source| r0`,
+	}, {
+		name:        "unsupported format",
+		format:      "svg",
+		shouldError: true,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output, err := printer.SupplyChainGraph(test.format, supplyChain, nil)
+			if (err != nil) != test.shouldError {
+				t.Errorf("SupplyChainGraph() expected error %t, got %v", test.shouldError, err)
+			}
+			if diff := cmp.Diff(strings.TrimPrefix(test.expectedOutput, "\n"), output); diff != "" {
+				t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2022 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer
+
+import (
+	"fmt"
+	"io"
+
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
+)
+
+// ClusterSupplyChainOverviewPrinter prints the templates readiness, service account and number of
+// steps of the supply chain, along with the number of workloads selecting it. A nil workloads
+// count is shown as unknown
+func ClusterSupplyChainOverviewPrinter(w io.Writer, supplyChain *cartov1alpha1.ClusterSupplyChain, workloads *int) error {
+	printOverview := func(supplyChain *cartov1alpha1.ClusterSupplyChain, printOpts table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		workloadsCount := printer.Sfaintf("<unknown>")
+		if workloads != nil {
+			workloadsCount = fmt.Sprintf("%d", *workloads)
+		}
+		rows := []metav1beta1.TableRow{
+			{Cells: []interface{}{"templates ready:", printer.EmptyString(ClusterSupplyChainTemplatesReadyReason(supplyChain))}},
+			{Cells: []interface{}{"service account:", printer.EmptyString(ClusterSupplyChainServiceAccount(supplyChain))}},
+			{Cells: []interface{}{"steps:", len(supplyChain.Spec.Resources)}},
+			{Cells: []interface{}{"workloads:", workloadsCount}},
+		}
+		return rows, nil
+	}
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{NoHeaders: true, PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		h.TableHandler(nil, printOverview)
+	})
+
+	return tablePrinter.PrintObj(supplyChain, w)
+}
+
+// ClusterSupplyChainTemplatesReadyReason is the reason of the TemplatesReady condition, empty when
+// the supply chain does not report it
+func ClusterSupplyChainTemplatesReadyReason(supplyChain *cartov1alpha1.ClusterSupplyChain) string {
+	if cond := printer.FindCondition(supplyChain.Status.Conditions, cartov1alpha1.SupplyChainTemplatesReady); cond != nil {
+		return cond.Reason
+	}
+	return ""
+}
+
+// ClusterSupplyChainServiceAccount is the service account the supply chain stamps resources
+// with, prefixed by its namespace when set
+func ClusterSupplyChainServiceAccount(supplyChain *cartov1alpha1.ClusterSupplyChain) string {
+	ref := supplyChain.Spec.ServiceAccountRef
+	if ref.Name == "" || ref.Namespace == "" {
+		return ref.Name
+	}
+	return fmt.Sprintf("%s/%s", ref.Namespace, ref.Name)
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2022 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+func TestClusterSupplyChainOverviewPrinter(t *testing.T) {
+	workloads := 3
+	tests := []struct {
+		name            string
+		testSupplyChain *cartov1alpha1.ClusterSupplyChain
+		workloads       *int
+		expectedOutput  string
+	}{{
+		name: "empty supply chain",
+		testSupplyChain: &cartov1alpha1.ClusterSupplyChain{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "source-to-url",
+			},
+		},
+		expectedOutput: `
+   templates ready:   <empty>
+   service account:   <empty>
+   steps:             0
+   workloads:         <unknown>
+`,
+	}, {
+		name: "supply chain with details",
+		testSupplyChain: &cartov1alpha1.ClusterSupplyChain{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "source-to-url",
+			},
+			Spec: cartov1alpha1.SupplyChainSpec{
+				ServiceAccountRef: cartov1alpha1.ServiceAccountRef{
+					Name:      "supply-chain-sa",
+					Namespace: "build",
+				},
+				Resources: []cartov1alpha1.SupplyChainResource{
+					{Name: "source-provider"},
+					{Name: "image-builder"},
+				},
+			},
+			Status: cartov1alpha1.SupplyChainStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:   cartov1alpha1.SupplyChainTemplatesReady,
+						Status: metav1.ConditionTrue,
+						Reason: cartov1alpha1.ReadyTemplatesReadyReason,
+					},
+				},
+			},
+		},
+		workloads: &workloads,
+		expectedOutput: `
+   templates ready:   Ready
+   service account:   build/supply-chain-sa
+   steps:             2
+   workloads:         3
+`,
+	}, {
+		name: "service account without namespace",
+		testSupplyChain: &cartov1alpha1.ClusterSupplyChain{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "source-to-url",
+			},
+			Spec: cartov1alpha1.SupplyChainSpec{
+				ServiceAccountRef: cartov1alpha1.ServiceAccountRef{
+					Name: "supply-chain-sa",
+				},
+			},
+			Status: cartov1alpha1.SupplyChainStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:   cartov1alpha1.SupplyChainTemplatesReady,
+						Status: metav1.ConditionFalse,
+						Reason: cartov1alpha1.NotFoundTemplatesReadyReason,
+					},
+				},
+			},
+		},
+		workloads: &workloads,
+		expectedOutput: `
+   templates ready:   TemplatesNotFound
+   service account:   supply-chain-sa
+   steps:             0
+   workloads:         3
+`,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output := &bytes.Buffer{}
+			if err := printer.ClusterSupplyChainOverviewPrinter(output, test.testSupplyChain, test.workloads); err != nil {
+				t.Errorf("ClusterSupplyChainOverviewPrinter() expected no error, got %v", err)
+			}
+			outputString := output.String()
+			if diff := cmp.Diff(strings.TrimPrefix(test.expectedOutput, "\n"), outputString); diff != "" {
+				t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
@@ -24,6 +24,8 @@ import (
 
 const (
 	paddingStart = 3
+	// PaddingStart is the indentation of the tables printed under a section title
+	PaddingStart = paddingStart
 )
 
 var (
@@ -0,0 +1,168 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+
+	knativeservingv1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/knative/serving/v1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
+)
+
+// KnativeRevisionsInfoPrinter prints the latest created and latest ready revisions of a Knative
+// service, the latest created revision is highlighted when it is not ready yet
+func KnativeRevisionsInfoPrinter(w io.Writer, ksvc *knativeservingv1.Service) error {
+	printRevisionsInfo := func(ksvc *knativeservingv1.Service, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		latestCreated := printer.EmptyString(ksvc.Status.LatestCreatedRevisionName)
+		if ksvc.Status.LatestCreatedRevisionName != "" && ksvc.Status.LatestCreatedRevisionName != ksvc.Status.LatestReadyRevisionName {
+			latestCreated = printer.Swarnf("%s (not ready)", ksvc.Status.LatestCreatedRevisionName)
+		}
+		rows := []metav1beta1.TableRow{
+			{Cells: []interface{}{"service:", ksvc.Name}},
+			{Cells: []interface{}{"latest created:", latestCreated}},
+			{Cells: []interface{}{"latest ready:", printer.EmptyString(ksvc.Status.LatestReadyRevisionName)}},
+		}
+		return rows, nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{NoHeaders: true, PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		h.TableHandler(nil, printRevisionsInfo)
+	})
+
+	return tablePrinter.PrintObj(ksvc, w)
+}
+
+// KnativeRevisionsPrinter prints the revisions of a Knative service in the given order with the
+// percentage of traffic routed to each of them and the tags they are reachable by
+func KnativeRevisionsPrinter(w io.Writer, ksvc *knativeservingv1.Service, revisions []knativeservingv1.Revision) error {
+	now := time.Now()
+	printRevisions := func(ksvc *knativeservingv1.Service, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		rows := make([]metav1beta1.TableRow, 0, len(revisions))
+		for i := range revisions {
+			r := &revisions[i]
+			percent, tags := knativeRevisionTraffic(ksvc, r.Name)
+			row := metav1beta1.TableRow{
+				Cells: []interface{}{
+					r.Name,
+					printer.ColorConditionStatus(printer.ConditionStatus(printer.FindCondition(r.Status.Conditions, knativeservingv1.RevisionConditionReady))),
+					fmt.Sprintf("%d%%", percent),
+					printer.EmptyString(strings.Join(tags, ", ")),
+					printer.TimestampSince(r.CreationTimestamp, now),
+				},
+			}
+			rows = append(rows, row)
+		}
+		return rows, nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		columns := []metav1beta1.TableColumnDefinition{
+			{Name: "Name", Type: "string"},
+			{Name: "Ready", Type: "string"},
+			{Name: "Traffic", Type: "string"},
+			{Name: "Tags", Type: "string"},
+			{Name: "Age", Type: "string"},
+		}
+		h.TableHandler(columns, printRevisions)
+	})
+
+	return tablePrinter.PrintObj(ksvc, w)
+}
+
+// ServiceURL is an address a Knative service of a workload is reachable at, either the url of
+// the service or the url of one of its tagged traffic targets
+type ServiceURL struct {
+	// Service is the name of the Knative service
+	Service string `json:"service"`
+	// Tag is the tag of the traffic target, empty for the url of the service
+	Tag string `json:"tag,omitempty"`
+	// RevisionName is the revision the tagged traffic target routes to
+	RevisionName string `json:"revisionName,omitempty"`
+	// Percent is the percentage of the traffic of the service routed to the traffic target
+	Percent *int64 `json:"percent,omitempty"`
+	// URL is the address of the service or traffic target
+	URL string `json:"url"`
+}
+
+// KnativeServiceURLsPrinter prints the urls of the Knative services of a workload and of their
+// tagged traffic targets
+func KnativeServiceURLsPrinter(w io.Writer, ksvcs *knativeservingv1.ServiceList, urls []ServiceURL) error {
+	printURLs := func(ksvcs *knativeservingv1.ServiceList, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		rows := make([]metav1beta1.TableRow, 0, len(urls))
+		for i := range urls {
+			u := &urls[i]
+			traffic := printer.EmptyString("")
+			if u.Percent != nil {
+				traffic = fmt.Sprintf("%d%%", *u.Percent)
+			}
+			row := metav1beta1.TableRow{
+				Cells: []interface{}{
+					u.Service,
+					printer.EmptyString(u.Tag),
+					printer.EmptyString(u.RevisionName),
+					traffic,
+					printer.EmptyString(u.URL),
+				},
+			}
+			rows = append(rows, row)
+		}
+		return rows, nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		columns := []metav1beta1.TableColumnDefinition{
+			{Name: "Service", Type: "string"},
+			{Name: "Tag", Type: "string"},
+			{Name: "Revision", Type: "string"},
+			{Name: "Traffic", Type: "string"},
+			{Name: "URL", Type: "string"},
+		}
+		h.TableHandler(columns, printURLs)
+	})
+
+	return tablePrinter.PrintObj(ksvcs, w)
+}
+
+// knativeRevisionTraffic returns the percentage of the traffic of the service routed to the
+// revision and the tags of the traffic targets naming it. Targets following the latest revision
+// are resolved to the latest ready revision when they do not name a revision
+func knativeRevisionTraffic(ksvc *knativeservingv1.Service, revisionName string) (int64, []string) {
+	var percent int64
+	tags := []string{}
+	for _, target := range ksvc.Status.Traffic {
+		name := target.RevisionName
+		if name == "" && target.LatestRevision != nil && *target.LatestRevision {
+			name = ksvc.Status.LatestReadyRevisionName
+		}
+		if name != revisionName {
+			continue
+		}
+		if target.Percent != nil {
+			percent += *target.Percent
+		}
+		if target.Tag != "" {
+			tags = append(tags, target.Tag)
+		}
+	}
+	return percent, tags
+}
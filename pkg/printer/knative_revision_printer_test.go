@@ -0,0 +1,205 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	knativeservingv1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/knative/serving/v1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+func knativeServiceWithTraffic() *knativeservingv1.Service {
+	latest := true
+	ninety, ten, zero := int64(90), int64(10), int64(0)
+	return &knativeservingv1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-ksvc",
+			Namespace: "default",
+		},
+		Status: knativeservingv1.ServiceStatus{
+			URL:                       "https://my-ksvc.default.example.com",
+			LatestCreatedRevisionName: "my-ksvc-00003",
+			LatestReadyRevisionName:   "my-ksvc-00002",
+			Traffic: []knativeservingv1.TrafficTarget{{
+				RevisionName:   "my-ksvc-00002",
+				LatestRevision: &latest,
+				Percent:        &ninety,
+			}, {
+				Tag:          "previous",
+				RevisionName: "my-ksvc-00001",
+				Percent:      &ten,
+				URL:          "https://previous-my-ksvc.default.example.com",
+			}, {
+				Tag:            "latest",
+				LatestRevision: &latest,
+				Percent:        &zero,
+				URL:            "https://latest-my-ksvc.default.example.com",
+			}},
+		},
+	}
+}
+
+func TestKnativeRevisionsInfoPrinter(t *testing.T) {
+	ksvc := knativeServiceWithTraffic()
+
+	tests := []struct {
+		name           string
+		ksvc           *knativeservingv1.Service
+		expectedOutput string
+	}{{
+		name: "latest created revision not ready",
+		ksvc: ksvc,
+		expectedOutput: `
+   service:          my-ksvc
+   latest created:   my-ksvc-00003 (not ready)
+   latest ready:     my-ksvc-00002
+`,
+	}, {
+		name: "latest created revision ready",
+		ksvc: func() *knativeservingv1.Service {
+			ksvc := ksvc.DeepCopy()
+			ksvc.Status.LatestReadyRevisionName = "my-ksvc-00003"
+			return ksvc
+		}(),
+		expectedOutput: `
+   service:          my-ksvc
+   latest created:   my-ksvc-00003
+   latest ready:     my-ksvc-00003
+`,
+	}, {
+		name: "no revisions",
+		ksvc: &knativeservingv1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-ksvc",
+				Namespace: "default",
+			},
+		},
+		expectedOutput: `
+   service:          my-ksvc
+   latest created:   <empty>
+   latest ready:     <empty>
+`,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output := &bytes.Buffer{}
+			if err := printer.KnativeRevisionsInfoPrinter(output, test.ksvc); err != nil {
+				t.Errorf("KnativeRevisionsInfoPrinter() expected no error, got %v", err)
+			}
+			if diff := cmp.Diff(strings.TrimPrefix(test.expectedOutput, "\n"), output.String()); diff != "" {
+				t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestKnativeRevisionsPrinter(t *testing.T) {
+	ksvc := knativeServiceWithTraffic()
+	created := metav1.NewTime(time.Now().AddDate(-2, 0, 0))
+	revisions := []knativeservingv1.Revision{{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "my-ksvc-00003",
+			Namespace:         "default",
+			CreationTimestamp: created,
+		},
+		Status: knativeservingv1.RevisionStatus{
+			Conditions: []metav1.Condition{{
+				Type:   knativeservingv1.RevisionConditionReady,
+				Status: metav1.ConditionFalse,
+				Reason: "ContainerMissing",
+			}},
+		},
+	}, {
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "my-ksvc-00002",
+			Namespace:         "default",
+			CreationTimestamp: created,
+		},
+		Status: knativeservingv1.RevisionStatus{
+			Conditions: []metav1.Condition{{
+				Type:   knativeservingv1.RevisionConditionReady,
+				Status: metav1.ConditionTrue,
+			}},
+		},
+	}, {
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "my-ksvc-00001",
+			Namespace:         "default",
+			CreationTimestamp: created,
+		},
+	}}
+
+	output := &bytes.Buffer{}
+	if err := printer.KnativeRevisionsPrinter(output, ksvc, revisions); err != nil {
+		t.Errorf("KnativeRevisionsPrinter() expected no error, got %v", err)
+	}
+
+	expectedOutput := `
+   NAME            READY              TRAFFIC   TAGS       AGE
+   my-ksvc-00003   ContainerMissing   0%        <empty>    2y
+   my-ksvc-00002   Ready              90%       latest     2y
+   my-ksvc-00001   <unknown>          10%       previous   2y
+`
+	if diff := cmp.Diff(strings.TrimPrefix(expectedOutput, "\n"), output.String()); diff != "" {
+		t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+	}
+}
+
+func TestKnativeServiceURLsPrinter(t *testing.T) {
+	ksvc := knativeServiceWithTraffic()
+	ten, zero := int64(10), int64(0)
+	ksvcs := &knativeservingv1.ServiceList{Items: []knativeservingv1.Service{*ksvc}}
+	urls := []printer.ServiceURL{{
+		Service: "my-ksvc",
+		URL:     "https://my-ksvc.default.example.com",
+	}, {
+		Service:      "my-ksvc",
+		Tag:          "previous",
+		RevisionName: "my-ksvc-00001",
+		Percent:      &ten,
+		URL:          "https://previous-my-ksvc.default.example.com",
+	}, {
+		Service:      "my-ksvc",
+		Tag:          "latest",
+		RevisionName: "my-ksvc-00002",
+		Percent:      &zero,
+		URL:          "https://latest-my-ksvc.default.example.com",
+	}}
+
+	output := &bytes.Buffer{}
+	if err := printer.KnativeServiceURLsPrinter(output, ksvcs, urls); err != nil {
+		t.Errorf("KnativeServiceURLsPrinter() expected no error, got %v", err)
+	}
+
+	expectedOutput := `
+   SERVICE   TAG        REVISION        TRAFFIC   URL
+   my-ksvc   <empty>    <empty>         <empty>   https://my-ksvc.default.example.com
+   my-ksvc   previous   my-ksvc-00001   10%       https://previous-my-ksvc.default.example.com
+   my-ksvc   latest     my-ksvc-00002   0%        https://latest-my-ksvc.default.example.com
+`
+	if diff := cmp.Diff(strings.TrimPrefix(expectedOutput, "\n"), output.String()); diff != "" {
+		t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+	}
+}
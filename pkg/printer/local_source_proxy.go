@@ -41,7 +41,12 @@ func PrintLocalSourceProxyStatus(w io.Writer, printType string, s lsp.HealthStat
 			w.Write(m)
 		}
 	default:
-		return fmt.Errorf("output not supported, supported formats: 'json', 'yaml', 'yml'")
+		// custom columns and templates
+		export, err := OutputObject(s, OutputFormat(printType))
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(w, export)
 	}
 	return nil
 }
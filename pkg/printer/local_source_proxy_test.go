@@ -102,6 +102,14 @@ overall_health: true
 message: my cool status
 `,
 		},
+		{
+			name: "jsonpath",
+			args: args{
+				printType: "jsonpath={.overall_health}: {.message}",
+				s:         status,
+			},
+			wantW: "true: my cool status\n",
+		},
 		{
 			name: "wrong type",
 			args: args{
@@ -23,6 +23,7 @@ import (
 
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
 	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
@@ -136,3 +137,27 @@ func DeliverableIssuesPrinter(w io.Writer, deliverable *cartov1alpha1.Deliverabl
 
 	return tablePrinter.PrintObj(deliverable, w)
 }
+
+// DeliverableOverviewPrinter prints the name, type and namespace of the deliverable along with
+// the workload it was stamped for, when it is labeled with one
+func DeliverableOverviewPrinter(w io.Writer, deliverable *cartov1alpha1.Deliverable) error {
+	printDeliverableOverview := func(deliverable *cartov1alpha1.Deliverable, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		labels := deliverable.Labels
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		rows := []metav1beta1.TableRow{
+			{Cells: []interface{}{"name:", deliverable.GetName()}},
+			{Cells: []interface{}{"type:", printer.EmptyString(labels[apis.WorkloadTypeLabelName])}},
+			{Cells: []interface{}{"namespace:", deliverable.GetNamespace()}},
+			{Cells: []interface{}{"workload:", printer.EmptyString(labels[cartov1alpha1.WorkloadLabelName])}},
+		}
+		return rows, nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{NoHeaders: true, PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		h.TableHandler(nil, printDeliverableOverview)
+	})
+
+	return tablePrinter.PrintObj(deliverable, w)
+}
@@ -25,6 +25,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/apis"
 	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
 	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
 )
@@ -637,3 +638,56 @@ func TestDeliverablesIssuesPrinter(t *testing.T) {
 		})
 	}
 }
+
+func TestDeliverableOverviewPrinter(t *testing.T) {
+	tests := []struct {
+		name            string
+		testDeliverable *cartov1alpha1.Deliverable
+		expectedOutput  string
+	}{{
+		name: "no labels",
+		testDeliverable: &cartov1alpha1.Deliverable{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-deliverable",
+				Namespace: "default",
+			},
+		},
+		expectedOutput: `
+   name:        my-deliverable
+   type:        <empty>
+   namespace:   default
+   workload:    <empty>
+`,
+	}, {
+		name: "stamped for a workload",
+		testDeliverable: &cartov1alpha1.Deliverable{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-workload",
+				Namespace: "default",
+				Labels: map[string]string{
+					apis.WorkloadTypeLabelName:      "web",
+					cartov1alpha1.WorkloadLabelName: "my-workload",
+				},
+			},
+		},
+		expectedOutput: `
+   name:        my-workload
+   type:        web
+   namespace:   default
+   workload:    my-workload
+`,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output := &bytes.Buffer{}
+			if err := printer.DeliverableOverviewPrinter(output, test.testDeliverable); err != nil {
+				t.Errorf("DeliverableOverviewPrinter() expected no error, got %v", err)
+			}
+			outputString := output.String()
+			if diff := cmp.Diff(strings.TrimPrefix(test.expectedOutput, "\n"), outputString); diff != "" {
+				t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
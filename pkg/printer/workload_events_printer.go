@@ -0,0 +1,94 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
+)
+
+// EventTimestamp returns the time of the last occurrence of the event, falling back to
+// the time it was first observed or created when it is not set
+func EventTimestamp(event *corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	case !event.FirstTimestamp.IsZero():
+		return event.FirstTimestamp.Time
+	}
+	return event.CreationTimestamp.Time
+}
+
+// WorkloadEventsPrinter prints the events related to a workload in the given order, the
+// header is omitted when printing events as they are received while watching
+func WorkloadEventsPrinter(w io.Writer, events *corev1.EventList, noHeaders bool) error {
+	now := time.Now()
+	printEventRow := func(event *corev1.Event, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		eventType := event.Type
+		if eventType == corev1.EventTypeWarning {
+			eventType = printer.Swarnf(eventType)
+		}
+		row := metav1beta1.TableRow{
+			Object: runtime.RawExtension{Object: event},
+			Cells: []interface{}{
+				printer.TimestampSince(metav1.NewTime(EventTimestamp(event)), now),
+				printer.EmptyString(eventType),
+				printer.EmptyString(event.Reason),
+				fmt.Sprintf("%s/%s", strings.ToLower(event.InvolvedObject.Kind), event.InvolvedObject.Name),
+				printer.EmptyString(strings.TrimSpace(event.Message)),
+			},
+		}
+		return []metav1beta1.TableRow{row}, nil
+	}
+	printEventList := func(events *corev1.EventList, printOpts table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		rows := make([]metav1beta1.TableRow, 0, len(events.Items))
+		for i := range events.Items {
+			r, err := printEventRow(&events.Items[i], printOpts)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, r...)
+		}
+		return rows, nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{PaddingStart: paddingStart, NoHeaders: noHeaders}).With(func(h table.PrintHandler) {
+		columns := []metav1beta1.TableColumnDefinition{
+			{Name: "Age", Type: "string"},
+			{Name: "Type", Type: "string"},
+			{Name: "Reason", Type: "string"},
+			{Name: "Object", Type: "string"},
+			{Name: "Message", Type: "string"},
+		}
+		h.TableHandler(columns, printEventList)
+		h.TableHandler(columns, printEventRow)
+	})
+
+	return tablePrinter.PrintObj(events, w)
+}
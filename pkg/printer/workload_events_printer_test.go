@@ -0,0 +1,126 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+func TestEventTimestamp(t *testing.T) {
+	first := time.Date(2023, time.May, 4, 10, 30, 0, 0, time.UTC)
+	last := time.Date(2023, time.May, 4, 11, 30, 0, 0, time.UTC)
+	created := time.Date(2023, time.May, 4, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		event    *corev1.Event
+		expected time.Time
+	}{{
+		name: "last timestamp",
+		event: &corev1.Event{
+			FirstTimestamp: metav1.NewTime(first),
+			LastTimestamp:  metav1.NewTime(last),
+		},
+		expected: last,
+	}, {
+		name: "event time",
+		event: &corev1.Event{
+			EventTime: metav1.NewMicroTime(last),
+		},
+		expected: last,
+	}, {
+		name: "first timestamp",
+		event: &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+			FirstTimestamp: metav1.NewTime(first),
+		},
+		expected: first,
+	}, {
+		name: "creation timestamp",
+		event: &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(created)},
+		},
+		expected: created,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := printer.EventTimestamp(test.event); !actual.Equal(test.expected) {
+				t.Errorf("EventTimestamp() expected %v, got %v", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestWorkloadEventsPrinter(t *testing.T) {
+	now := time.Now()
+	events := &corev1.EventList{
+		Items: []corev1.Event{{
+			InvolvedObject: corev1.ObjectReference{Kind: "Workload", Name: "my-workload"},
+			Type:           corev1.EventTypeNormal,
+			Reason:         "StampedObjectApplied",
+			Message:        "Created object [images.kpack.io/my-workload]",
+			LastTimestamp:  metav1.NewTime(now.Add(-10 * time.Minute)),
+		}, {
+			InvolvedObject: corev1.ObjectReference{Kind: "Image", Name: "my-workload"},
+			Type:           corev1.EventTypeWarning,
+			Reason:         "BuildFailed",
+			LastTimestamp:  metav1.NewTime(now.Add(-5 * time.Minute)),
+		}},
+	}
+
+	tests := []struct {
+		name           string
+		noHeaders      bool
+		expectedOutput string
+	}{{
+		name: "with headers",
+		expectedOutput: `
+   AGE   TYPE      REASON                 OBJECT                 MESSAGE
+   10m   Normal    StampedObjectApplied   workload/my-workload   Created object [images.kpack.io/my-workload]
+   5m    Warning   BuildFailed            image/my-workload      <empty>
+`,
+	}, {
+		name:      "without headers",
+		noHeaders: true,
+		expectedOutput: `
+   10m   Normal    StampedObjectApplied   workload/my-workload   Created object [images.kpack.io/my-workload]
+   5m    Warning   BuildFailed            image/my-workload      <empty>
+`,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output := &bytes.Buffer{}
+			if err := printer.WorkloadEventsPrinter(output, events, test.noHeaders); err != nil {
+				t.Errorf("WorkloadEventsPrinter() expected no error, got %v", err)
+			}
+			if diff := cmp.Diff(strings.TrimPrefix(test.expectedOutput, "\n"), output.String()); diff != "" {
+				t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer
+
+import (
+	"io"
+
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
+)
+
+const (
+	WorkloadResultCreated   = "created"
+	WorkloadResultUpdated   = "updated"
+	WorkloadResultUnchanged = "unchanged"
+	WorkloadResultDeleted   = "deleted"
+	WorkloadResultPruned    = "pruned"
+	WorkloadResultRebuilt   = "rebuilt"
+	WorkloadResultNotFound  = "not found"
+	WorkloadResultSkipped   = "skipped"
+	WorkloadResultFailed    = "failed"
+)
+
+// WorkloadResultsPrinter prints the result of an operation that was run over
+// several workloads, results are keyed by the workload namespace and name
+func WorkloadResultsPrinter(w io.Writer, workloads *cartov1alpha1.WorkloadList, results map[types.NamespacedName]string) error {
+	printWorkloadResultRow := func(workload *cartov1alpha1.Workload, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		result := results[types.NamespacedName{Namespace: workload.Namespace, Name: workload.Name}]
+		if result == WorkloadResultFailed {
+			result = printer.Serrorf(result)
+		}
+		row := metav1beta1.TableRow{
+			Object: runtime.RawExtension{Object: workload},
+			Cells: []interface{}{
+				workload.Name,
+				workload.Namespace,
+				printer.EmptyString(result),
+			},
+		}
+		return []metav1beta1.TableRow{row}, nil
+	}
+	printWorkloadResultList := func(workloads *cartov1alpha1.WorkloadList, printOpts table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		rows := make([]metav1beta1.TableRow, 0, len(workloads.Items))
+		for i := range workloads.Items {
+			r, err := printWorkloadResultRow(&workloads.Items[i], printOpts)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, r...)
+		}
+		return rows, nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		columns := []metav1beta1.TableColumnDefinition{
+			{Name: "Name", Type: "string"},
+			{Name: "Namespace", Type: "string"},
+			{Name: "Result", Type: "string"},
+		}
+		h.TableHandler(columns, printWorkloadResultList)
+		h.TableHandler(columns, printWorkloadResultRow)
+	})
+
+	return tablePrinter.PrintObj(workloads, w)
+}
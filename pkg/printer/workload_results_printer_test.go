@@ -0,0 +1,77 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+func TestWorkloadResultsPrinter(t *testing.T) {
+	output := &bytes.Buffer{}
+	workloads := &cartov1alpha1.WorkloadList{
+		Items: []cartov1alpha1.Workload{{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-workload",
+				Namespace: "default",
+			},
+		}, {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-other-workload",
+				Namespace: "my-namespace",
+			},
+		}, {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-failed-workload",
+				Namespace: "default",
+			},
+		}, {
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-unknown-workload",
+				Namespace: "default",
+			},
+		}},
+	}
+	results := map[types.NamespacedName]string{
+		{Namespace: "default", Name: "my-workload"}:            "created",
+		{Namespace: "my-namespace", Name: "my-other-workload"}: "unchanged",
+		{Namespace: "default", Name: "my-failed-workload"}:     printer.WorkloadResultFailed,
+	}
+
+	if err := printer.WorkloadResultsPrinter(output, workloads, results); err != nil {
+		t.Errorf("WorkloadResultsPrinter() expected no error, got %v", err)
+	}
+
+	expectedOutput := `
+   NAME                  NAMESPACE      RESULT
+   my-workload           default        created
+   my-other-workload     my-namespace   unchanged
+   my-failed-workload    default        failed
+   my-unknown-workload   default        <empty>
+`
+	if diff := cmp.Diff(strings.TrimPrefix(expectedOutput, "\n"), output.String()); diff != "" {
+		t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+	}
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/util/duration"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
+)
+
+const (
+	WorkloadRunResultSucceeded = "Succeeded"
+	WorkloadRunResultFailed    = "Failed"
+	WorkloadRunResultRunning   = "Running"
+)
+
+// WorkloadRun is an object stamped by a runnable of a workload, such as a Tekton TaskRun or
+// PipelineRun running the workload tests
+type WorkloadRun struct {
+	// Runnable is the name of the runnable that stamped the run
+	Runnable string `json:"runnable"`
+	// APIVersion is the api version of the run
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind of the run
+	Kind string `json:"kind"`
+	// Name is the name of the run
+	Name string `json:"name"`
+	// Result is one of Succeeded, Failed or Running, as reported by the Succeeded condition of the run
+	Result string `json:"result"`
+	// Reason is the reason of the Succeeded condition of the run
+	Reason string `json:"reason,omitempty"`
+	// Message is the message of the Succeeded condition of the run
+	Message string `json:"message,omitempty"`
+	// StartTime is the time the run started, or was created when it does not report a start time
+	StartTime metav1.Time `json:"startTime"`
+	// CompletionTime is the time the run completed
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// RunnableInfoPrinter prints the run template and readiness of a runnable together with the
+// result of its latest run, when there is one
+func RunnableInfoPrinter(w io.Writer, runnable *cartov1alpha1.Runnable, latest *WorkloadRun) error {
+	printRunnableInfo := func(runnable *cartov1alpha1.Runnable, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		rows := []metav1beta1.TableRow{
+			{Cells: []interface{}{"name:", runnable.Name}},
+			{Cells: []interface{}{"run template:", printer.EmptyString(runnable.Spec.RunTemplateRef.Name)}},
+			{Cells: []interface{}{"status:", printer.ConditionStatus(printer.FindCondition(runnable.Status.Conditions, cartov1alpha1.RunnableConditionReady))}},
+		}
+		if latest == nil {
+			return rows, nil
+		}
+		rows = append(rows,
+			metav1beta1.TableRow{Cells: []interface{}{"latest run:", fmt.Sprintf("%s (%s)", latest.Name, latest.Kind)}},
+			metav1beta1.TableRow{Cells: []interface{}{"result:", workloadRunResult(latest)}},
+		)
+		if latest.Result == WorkloadRunResultFailed && strings.TrimSpace(latest.Message) != "" {
+			rows = append(rows, metav1beta1.TableRow{Cells: []interface{}{"message:", strings.TrimSpace(latest.Message)}})
+		}
+		return rows, nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{NoHeaders: true, PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		h.TableHandler(nil, printRunnableInfo)
+	})
+
+	return tablePrinter.PrintObj(runnable, w)
+}
+
+// WorkloadRunsPrinter prints the runs stamped by the runnables of a workload in the given order
+func WorkloadRunsPrinter(w io.Writer, workload *cartov1alpha1.Workload, runs []WorkloadRun) error {
+	now := time.Now()
+	printWorkloadRuns := func(workload *cartov1alpha1.Workload, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		rows := make([]metav1beta1.TableRow, 0, len(runs))
+		for i := range runs {
+			r := &runs[i]
+			started := ""
+			if !r.StartTime.IsZero() {
+				started = r.StartTime.UTC().Format(time.RFC3339)
+			}
+			row := metav1beta1.TableRow{
+				Cells: []interface{}{
+					r.Name,
+					r.Kind,
+					workloadRunResult(r),
+					printer.EmptyString(started),
+					workloadRunDuration(r, now),
+				},
+			}
+			rows = append(rows, row)
+		}
+		return rows, nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		columns := []metav1beta1.TableColumnDefinition{
+			{Name: "Name", Type: "string"},
+			{Name: "Kind", Type: "string"},
+			{Name: "Result", Type: "string"},
+			{Name: "Started", Type: "string"},
+			{Name: "Duration", Type: "string"},
+		}
+		h.TableHandler(columns, printWorkloadRuns)
+	})
+
+	return tablePrinter.PrintObj(workload, w)
+}
+
+func workloadRunResult(run *WorkloadRun) string {
+	switch run.Result {
+	case WorkloadRunResultSucceeded:
+		return printer.Ssuccessf(run.Result)
+	case WorkloadRunResultFailed:
+		return printer.Serrorf(run.Result)
+	}
+	return printer.EmptyString(run.Result)
+}
+
+// workloadRunDuration returns the time a run took to complete, or has been running for when it
+// did not complete yet
+func workloadRunDuration(run *WorkloadRun, now time.Time) string {
+	if run.StartTime.IsZero() {
+		return printer.EmptyString("")
+	}
+	end := now
+	if run.CompletionTime != nil {
+		end = run.CompletionTime.Time
+	}
+	return duration.HumanDuration(end.Sub(run.StartTime.Time))
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+func TestRunnableInfoPrinter(t *testing.T) {
+	runnable := &cartov1alpha1.Runnable{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-workload",
+			Namespace: "default",
+		},
+		Spec: cartov1alpha1.RunnableSpec{
+			RunTemplateRef: cartov1alpha1.RunTemplateReference{Name: "tekton-taskrun"},
+		},
+		Status: cartov1alpha1.RunnableStatus{
+			OwnerStatus: cartov1alpha1.OwnerStatus{
+				Conditions: []metav1.Condition{{
+					Type:   cartov1alpha1.RunnableConditionReady,
+					Status: metav1.ConditionFalse,
+					Reason: "SucceededCondition",
+				}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		latest         *printer.WorkloadRun
+		expectedOutput string
+	}{{
+		name: "no runs",
+		expectedOutput: `
+   name:           my-workload
+   run template:   tekton-taskrun
+   status:         SucceededCondition
+`,
+	}, {
+		name: "failed run",
+		latest: &printer.WorkloadRun{
+			Kind:    "TaskRun",
+			Name:    "my-workload-x8f2k",
+			Result:  printer.WorkloadRunResultFailed,
+			Message: "\"step-test\" exited with code 1 ",
+		},
+		expectedOutput: `
+   name:           my-workload
+   run template:   tekton-taskrun
+   status:         SucceededCondition
+   latest run:     my-workload-x8f2k (TaskRun)
+   result:         Failed
+   message:        "step-test" exited with code 1
+`,
+	}, {
+		name: "succeeded run",
+		latest: &printer.WorkloadRun{
+			Kind:    "PipelineRun",
+			Name:    "my-workload-p4hzn",
+			Result:  printer.WorkloadRunResultSucceeded,
+			Message: "Tasks Completed: 1 (Failed: 0, Cancelled 0), Skipped: 0",
+		},
+		expectedOutput: `
+   name:           my-workload
+   run template:   tekton-taskrun
+   status:         SucceededCondition
+   latest run:     my-workload-p4hzn (PipelineRun)
+   result:         Succeeded
+`,
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output := &bytes.Buffer{}
+			if err := printer.RunnableInfoPrinter(output, runnable, test.latest); err != nil {
+				t.Errorf("RunnableInfoPrinter() expected no error, got %v", err)
+			}
+			if diff := cmp.Diff(strings.TrimPrefix(test.expectedOutput, "\n"), output.String()); diff != "" {
+				t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
+func TestWorkloadRunsPrinter(t *testing.T) {
+	output := &bytes.Buffer{}
+	workload := &cartov1alpha1.Workload{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-workload",
+			Namespace: "default",
+		},
+	}
+	started := time.Date(2023, time.May, 4, 10, 30, 0, 0, time.UTC)
+	runs := []printer.WorkloadRun{{
+		Kind:           "TaskRun",
+		Name:           "my-workload-x8f2k",
+		Result:         printer.WorkloadRunResultFailed,
+		StartTime:      metav1.NewTime(started),
+		CompletionTime: &metav1.Time{Time: started.Add(95 * time.Second)},
+	}, {
+		Kind:           "TaskRun",
+		Name:           "my-workload-p4hzn",
+		Result:         printer.WorkloadRunResultSucceeded,
+		StartTime:      metav1.NewTime(started.Add(-time.Hour)),
+		CompletionTime: &metav1.Time{Time: started.Add(-time.Hour + 3*time.Minute)},
+	}, {
+		Kind:   "TaskRun",
+		Name:   "my-workload-7djsq",
+		Result: printer.WorkloadRunResultRunning,
+	}}
+
+	if err := printer.WorkloadRunsPrinter(output, workload, runs); err != nil {
+		t.Errorf("WorkloadRunsPrinter() expected no error, got %v", err)
+	}
+
+	expectedOutput := `
+   NAME                KIND      RESULT      STARTED                DURATION
+   my-workload-x8f2k   TaskRun   Failed      2023-05-04T10:30:00Z   95s
+   my-workload-p4hzn   TaskRun   Succeeded   2023-05-04T09:30:00Z   3m
+   my-workload-7djsq   TaskRun   Running     <empty>                <empty>
+`
+	if diff := cmp.Diff(strings.TrimPrefix(expectedOutput, "\n"), output.String()); diff != "" {
+		t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+	}
+}
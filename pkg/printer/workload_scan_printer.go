@@ -0,0 +1,134 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	scanningv1beta1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/scanning/v1beta1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
+)
+
+// ScanResult summarizes a SourceScan or ImageScan stamped by the supply chain of a workload
+type ScanResult struct {
+	// Resource is the name of the supply chain resource that stamped the scan
+	Resource string `json:"resource"`
+	// Kind is the kind of the scan, SourceScan or ImageScan
+	Kind string `json:"kind"`
+	// Name is the name of the scan
+	Name string `json:"name"`
+	// Phase is the stage of the scan
+	Phase string `json:"phase,omitempty"`
+	// ScanPolicy is the name of the policy the scan results are evaluated against
+	ScanPolicy string `json:"scanPolicy,omitempty"`
+	// ScanTemplate is the name of the template running the scan
+	ScanTemplate string `json:"scanTemplate,omitempty"`
+	// Artifact is the source code or image that was scanned
+	Artifact scanningv1beta1.Artifact `json:"artifact"`
+	// ScannedBy is the scanner that ran the scan
+	ScannedBy scanningv1beta1.ScannedBy `json:"scannedBy"`
+	// ScannedAt is the time the scan completed
+	ScannedAt *metav1.Time `json:"scannedAt,omitempty"`
+	// PolicyCompliant is the status of the PolicySucceeded condition of the scan, True when the
+	// scanned artifact complies with the scan policy
+	PolicyCompliant metav1.ConditionStatus `json:"policyCompliant,omitempty"`
+	// PolicyReason is the reason of the PolicySucceeded condition of the scan
+	PolicyReason string `json:"policyReason,omitempty"`
+	// PolicyMessage is the message of the PolicySucceeded condition of the scan, listing the
+	// vulnerabilities that violate the policy
+	PolicyMessage string `json:"policyMessage,omitempty"`
+	// CVECount is the number of vulnerabilities found by severity
+	CVECount scanningv1beta1.CVECount `json:"cveCount"`
+	// MetadataURL is the location of the scan report in the metadata store
+	MetadataURL string `json:"metadataUrl,omitempty"`
+}
+
+// WorkloadScanResultsPrinter prints the number of critical, high and medium vulnerabilities found
+// by each scan of a workload and whether the scanned artifact complies with the scan policy
+func WorkloadScanResultsPrinter(w io.Writer, workload *cartov1alpha1.Workload, results []ScanResult) error {
+	printScanResults := func(workload *cartov1alpha1.Workload, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		rows := make([]metav1beta1.TableRow, 0, len(results))
+		for _, r := range results {
+			compliant := printer.Sfaintf("Unknown")
+			if r.PolicyCompliant != "" {
+				compliant = printer.ColorConditionStatus(string(r.PolicyCompliant))
+			}
+			row := metav1beta1.TableRow{
+				Cells: []interface{}{
+					r.Resource,
+					r.Kind,
+					printer.EmptyString(r.ScanPolicy),
+					compliant,
+					strconv.FormatInt(r.CVECount.Critical, 10),
+					strconv.FormatInt(r.CVECount.High, 10),
+					strconv.FormatInt(r.CVECount.Medium, 10),
+				},
+			}
+			rows = append(rows, row)
+		}
+		return rows, nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		columns := []metav1beta1.TableColumnDefinition{
+			{Name: "Name", Type: "string"},
+			{Name: "Kind", Type: "string"},
+			{Name: "Policy", Type: "string"},
+			{Name: "Compliant", Type: "string"},
+			{Name: "Critical", Type: "string"},
+			{Name: "High", Type: "string"},
+			{Name: "Medium", Type: "string"},
+		}
+		h.TableHandler(columns, printScanResults)
+	})
+
+	return tablePrinter.PrintObj(workload, w)
+}
+
+// ScanPolicyIssuesPrinter prints why the artifacts of the scans that do not comply with their
+// scan policy were rejected
+func ScanPolicyIssuesPrinter(w io.Writer, workload *cartov1alpha1.Workload, results []ScanResult) error {
+	printIssues := func(workload *cartov1alpha1.Workload, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		rows := []metav1beta1.TableRow{}
+		for _, r := range results {
+			if r.PolicyCompliant != metav1.ConditionFalse || strings.TrimSpace(r.PolicyMessage) == "" {
+				continue
+			}
+			rows = append(rows, metav1beta1.TableRow{
+				Cells: []interface{}{
+					fmt.Sprintf("%s %s:", r.Kind, printer.Sfaintf("[%s]", r.PolicyReason)),
+					strings.TrimSpace(r.PolicyMessage),
+				},
+			})
+		}
+		return rows, nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{NoHeaders: true, PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		h.TableHandler(nil, printIssues)
+	})
+
+	return tablePrinter.PrintObj(workload, w)
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	scanningv1beta1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/scanning/v1beta1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+func TestWorkloadScanResultsPrinter(t *testing.T) {
+	output := &bytes.Buffer{}
+	workload := &cartov1alpha1.Workload{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-workload",
+			Namespace: "default",
+		},
+	}
+	results := []printer.ScanResult{{
+		Resource:        "source-scanner",
+		Kind:            scanningv1beta1.SourceScanKind,
+		ScanPolicy:      "scan-policy",
+		PolicyCompliant: metav1.ConditionTrue,
+		CVECount:        scanningv1beta1.CVECount{Medium: 3, Low: 8},
+	}, {
+		Resource:        "image-scanner",
+		Kind:            scanningv1beta1.ImageScanKind,
+		ScanPolicy:      "scan-policy",
+		PolicyCompliant: metav1.ConditionFalse,
+		CVECount:        scanningv1beta1.CVECount{Critical: 1, High: 2, Medium: 4},
+	}, {
+		Resource: "image-scanner-2",
+		Kind:     scanningv1beta1.ImageScanKind,
+	}}
+
+	if err := printer.WorkloadScanResultsPrinter(output, workload, results); err != nil {
+		t.Errorf("WorkloadScanResultsPrinter() expected no error, got %v", err)
+	}
+
+	expectedOutput := `
+   NAME              KIND         POLICY        COMPLIANT   CRITICAL   HIGH   MEDIUM
+   source-scanner    SourceScan   scan-policy   True        0          0      3
+   image-scanner     ImageScan    scan-policy   False       1          2      4
+   image-scanner-2   ImageScan    <empty>       Unknown     0          0      0
+`
+	if diff := cmp.Diff(strings.TrimPrefix(expectedOutput, "\n"), output.String()); diff != "" {
+		t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+	}
+}
+
+func TestScanPolicyIssuesPrinter(t *testing.T) {
+	output := &bytes.Buffer{}
+	workload := &cartov1alpha1.Workload{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-workload",
+			Namespace: "default",
+		},
+	}
+	results := []printer.ScanResult{{
+		Kind:            scanningv1beta1.SourceScanKind,
+		PolicyCompliant: metav1.ConditionTrue,
+		PolicyReason:    "EvaluationPassed",
+		PolicyMessage:   "No CVEs were found that violated the policy",
+	}, {
+		Kind:            scanningv1beta1.ImageScanKind,
+		PolicyCompliant: metav1.ConditionFalse,
+		PolicyReason:    "EvaluationFailed",
+		PolicyMessage:   "Policy violated because of 1 CVEs: CVE-2023-1234 (Critical) ",
+	}}
+
+	if err := printer.ScanPolicyIssuesPrinter(output, workload, results); err != nil {
+		t.Errorf("ScanPolicyIssuesPrinter() expected no error, got %v", err)
+	}
+
+	expectedOutput := `
+   ImageScan [EvaluationFailed]:   Policy violated because of 1 CVEs: CVE-2023-1234 (Critical)
+`
+	if diff := cmp.Diff(strings.TrimPrefix(expectedOutput, "\n"), output.String()); diff != "" {
+		t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+	}
+}
@@ -85,6 +85,82 @@ func WorkloadResourcesPrinter(w io.Writer, workload *cartov1alpha1.Workload) err
 	return tablePrinter.PrintObj(workload, w)
 }
 
+// WorkloadOutputsPrinter lists the outputs of each supply chain resource next to the
+// resources it consumed as inputs, so values can be traced from one step to the next
+func WorkloadOutputsPrinter(w io.Writer, workload *cartov1alpha1.Workload) error {
+	printResourceOutputsRows := func(resource *cartov1alpha1.RealizedResource, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		inputs := make([]string, len(resource.Inputs))
+		for i, input := range resource.Inputs {
+			inputs[i] = input.Name
+		}
+		name, from := resource.Name, printer.Sfaintf("<none>")
+		if len(inputs) != 0 {
+			from = strings.Join(inputs, ", ")
+		}
+		if len(resource.Outputs) == 0 {
+			return []metav1beta1.TableRow{{
+				Cells: []interface{}{name, from, printer.Sfaintf("<none>"), "", "", ""},
+			}}, nil
+		}
+		rows := make([]metav1beta1.TableRow, 0, len(resource.Outputs))
+		for _, output := range resource.Outputs {
+			rows = append(rows, metav1beta1.TableRow{
+				Cells: []interface{}{
+					name,
+					from,
+					output.Name,
+					outputPreview(output.Preview),
+					output.Digest,
+					printer.TimestampSince(output.LastTransitionTime, time.Now()),
+				},
+			})
+			// the resource and its inputs are only shown on the first output row
+			name, from = "", ""
+		}
+		return rows, nil
+	}
+
+	printResourceOutputsList := func(workload *cartov1alpha1.Workload, printOpts table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		rows := make([]metav1beta1.TableRow, 0, len(workload.Status.Resources))
+		for i := range workload.Status.Resources {
+			r, err := printResourceOutputsRows(&workload.Status.Resources[i], printOpts)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, r...)
+		}
+		return rows, nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		columns := []metav1beta1.TableColumnDefinition{
+			{Name: "Resource", Type: "string"},
+			{Name: "Inputs", Type: "string"},
+			{Name: "Output", Type: "string"},
+			{Name: "Preview", Type: "string"},
+			{Name: "Digest", Type: "string"},
+			{Name: "Updated", Type: "string"},
+		}
+		h.TableHandler(columns, printResourceOutputsList)
+		h.TableHandler(columns, printResourceOutputsRows)
+	})
+
+	return tablePrinter.PrintObj(workload, w)
+}
+
+// outputPreview returns the first line of the preview of an output, shortened to fit a table cell
+func outputPreview(preview string) string {
+	const maxLength = 50
+	lines := strings.Split(strings.TrimSpace(preview), "\n")
+	value := strings.TrimSpace(lines[0])
+	if len(value) > maxLength {
+		value = value[:maxLength-3] + "..."
+	} else if len(lines) > 1 {
+		value += "..."
+	}
+	return printer.EmptyString(value)
+}
+
 func WorkloadSupplyChainInfoPrinter(w io.Writer, workload *cartov1alpha1.Workload) error {
 	printSupplyChainInfo := func(workload *cartov1alpha1.Workload, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
 		workloadStatus := &workload.Status
@@ -111,6 +187,31 @@ func WorkloadSupplyChainInfoPrinter(w io.Writer, workload *cartov1alpha1.Workloa
 	return tablePrinter.PrintObj(workload, w)
 }
 
+// ConditionIssue is a message reported by the Ready or ResourcesHealthy condition of a resource
+type ConditionIssue struct {
+	Kind    string `json:"kind"`
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+// ConditionIssues returns the messages of the Ready and ResourcesHealthy conditions, the same
+// messages listed by the issues printers
+func ConditionIssues(kind string, conditions []metav1.Condition) []ConditionIssue {
+	issues := []ConditionIssue{}
+	readyCondition := printer.FindCondition(conditions, cartov1alpha1.ConditionReady)
+	if readyCondition == nil {
+		return issues
+	}
+	if strings.TrimSpace(readyCondition.Message) != "" {
+		issues = append(issues, ConditionIssue{Kind: kind, Reason: readyCondition.Reason, Message: readyCondition.Message})
+	}
+	healthyCondition := printer.FindCondition(conditions, cartov1alpha1.ResourcesHealthy)
+	if healthyCondition != nil && strings.TrimSpace(healthyCondition.Message) != "" && healthyCondition.Message != readyCondition.Message {
+		issues = append(issues, ConditionIssue{Kind: kind, Reason: healthyCondition.Reason, Message: healthyCondition.Message})
+	}
+	return issues
+}
+
 func WorkloadIssuesPrinter(w io.Writer, workload *cartov1alpha1.Workload) error {
 	readyCondition := printer.FindCondition(workload.Status.Conditions, cartov1alpha1.ConditionReady)
 	healthyCondition := printer.FindCondition(workload.Status.Conditions, cartov1alpha1.ResourcesHealthy)
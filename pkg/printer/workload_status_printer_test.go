@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
@@ -514,6 +515,83 @@ func TestWorkloadSupplyChainInfoPrinter(t *testing.T) {
 	}
 }
 
+func TestWorkloadOutputsPrinter(t *testing.T) {
+	defaultNamespace := "default"
+	workloadName := "my-workload"
+	updated := metav1.NewTime(time.Now().Add(-5 * time.Hour))
+
+	tests := []struct {
+		name           string
+		testWorkload   *cartov1alpha1.Workload
+		expectedOutput string
+	}{{
+		name: "outputs flowing into later resources",
+		testWorkload: &cartov1alpha1.Workload{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      workloadName,
+				Namespace: defaultNamespace,
+			},
+			Status: cartov1alpha1.WorkloadStatus{
+				Resources: []cartov1alpha1.RealizedResource{{
+					Name: "source-provider",
+					Outputs: []cartov1alpha1.Output{{
+						Name:               "url",
+						Preview:            "http://source-controller.flux-system.svc.cluster.local./gitrepository/default/my-workload/b4df00d.tar.gz\n",
+						Digest:             "sha256:2f8c1a",
+						LastTransitionTime: updated,
+					}, {
+						Name:               "revision",
+						Preview:            "main/b4df00d\n",
+						Digest:             "sha256:9e1d0b",
+						LastTransitionTime: updated,
+					}},
+				}, {
+					Name:   "image-provider",
+					Inputs: []cartov1alpha1.Input{{Name: "source-provider"}},
+					Outputs: []cartov1alpha1.Output{{
+						Name:               "image",
+						Preview:            "registry.example.com/my-workload@sha256:c0ffee\n",
+						Digest:             "sha256:a1b2c3",
+						LastTransitionTime: updated,
+					}},
+				}, {
+					Name:   "config-provider",
+					Inputs: []cartov1alpha1.Input{{Name: "image-provider"}},
+					Outputs: []cartov1alpha1.Output{{
+						Name:               "config",
+						Preview:            "containers:\n- image: registry.example.com/my-workload@sha256:c0ffee\n",
+						Digest:             "sha256:d4e5f6",
+						LastTransitionTime: updated,
+					}},
+				}, {
+					Name:   "deliverable",
+					Inputs: []cartov1alpha1.Input{{Name: "config-provider"}, {Name: "source-provider"}},
+				}},
+			},
+		},
+		expectedOutput: `   RESOURCE          INPUTS                             OUTPUT     PREVIEW                                              DIGEST          UPDATED
+   source-provider   <none>                             url        http://source-controller.flux-system.svc.cluste...   sha256:2f8c1a   5h
+                                                        revision   main/b4df00d                                         sha256:9e1d0b   5h
+   image-provider    source-provider                    image      registry.example.com/my-workload@sha256:c0ffee       sha256:a1b2c3   5h
+   config-provider   image-provider                     config     containers:...                                       sha256:d4e5f6   5h
+   deliverable       config-provider, source-provider   <none>                                                                          
+`,
+	}}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			output := &bytes.Buffer{}
+			if err := printer.WorkloadOutputsPrinter(output, test.testWorkload); err != nil {
+				t.Errorf("WorkloadOutputsPrinter() expected no error, got %v", err)
+			}
+			outputString := output.String()
+			if diff := cmp.Diff(strings.TrimPrefix(test.expectedOutput, "\n"), outputString); diff != "" {
+				t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+			}
+		})
+	}
+}
+
 func TestWorkloadIssuesPrinter(t *testing.T) {
 	defaultNamespace := "default"
 	workloadName := "my-workload"
@@ -0,0 +1,82 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer
+
+import (
+	"fmt"
+	"io"
+
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/cli-runtime/printer/table"
+)
+
+// WorkloadTreeNode is an object related to a workload, along with the objects it stamped
+// or owns
+type WorkloadTreeNode struct {
+	APIVersion string              `json:"apiVersion"`
+	Kind       string              `json:"kind"`
+	Namespace  string              `json:"namespace,omitempty"`
+	Name       string              `json:"name"`
+	Ready      string              `json:"ready,omitempty"`
+	Reason     string              `json:"reason,omitempty"`
+	Children   []*WorkloadTreeNode `json:"children,omitempty"`
+}
+
+// WorkloadTreePrinter prints the tree of objects related to a workload, each object is
+// indented below its parent
+func WorkloadTreePrinter(w io.Writer, workload *cartov1alpha1.Workload, root *WorkloadTreeNode) error {
+	printWorkloadTree := func(workload *cartov1alpha1.Workload, _ table.PrintOptions) ([]metav1beta1.TableRow, error) {
+		rows := []metav1beta1.TableRow{}
+		var walk func(node *WorkloadTreeNode, prefix, childPrefix string)
+		walk = func(node *WorkloadTreeNode, prefix, childPrefix string) {
+			ready := printer.Sfaintf("-")
+			if node.Ready != "" {
+				ready = printer.ColorConditionStatus(node.Ready)
+			}
+			rows = append(rows, metav1beta1.TableRow{
+				Cells: []interface{}{
+					fmt.Sprintf("%s%s/%s", prefix, node.Kind, node.Name),
+					ready,
+					node.Reason,
+				},
+			})
+			for i, child := range node.Children {
+				if i == len(node.Children)-1 {
+					walk(child, childPrefix+"└─", childPrefix+"  ")
+				} else {
+					walk(child, childPrefix+"├─", childPrefix+"│ ")
+				}
+			}
+		}
+		walk(root, "", "")
+		return rows, nil
+	}
+
+	tablePrinter := table.NewTablePrinter(table.PrintOptions{PaddingStart: paddingStart}).With(func(h table.PrintHandler) {
+		columns := []metav1beta1.TableColumnDefinition{
+			{Name: "Name", Type: "string"},
+			{Name: "Ready", Type: "string"},
+			{Name: "Reason", Type: "string"},
+		}
+		h.TableHandler(columns, printWorkloadTree)
+	})
+
+	return tablePrinter.PrintObj(workload, w)
+}
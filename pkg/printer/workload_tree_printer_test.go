@@ -0,0 +1,73 @@
+/*
+Copyright 2023 VMware, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package printer_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cartov1alpha1 "github.com/vmware-tanzu/apps-cli-plugin/pkg/apis/cartographer/v1alpha1"
+	"github.com/vmware-tanzu/apps-cli-plugin/pkg/printer"
+)
+
+func TestWorkloadTreePrinter(t *testing.T) {
+	output := &bytes.Buffer{}
+	workload := &cartov1alpha1.Workload{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-workload",
+			Namespace: "default",
+		},
+	}
+	root := &printer.WorkloadTreeNode{
+		Kind:   "Workload",
+		Name:   "my-workload",
+		Ready:  "False",
+		Reason: "MissingValueAtPath",
+		Children: []*printer.WorkloadTreeNode{{
+			Kind:  "Image",
+			Name:  "my-workload",
+			Ready: "True",
+			Children: []*printer.WorkloadTreeNode{{
+				Kind:  "Build",
+				Name:  "my-workload-build-1",
+				Ready: "True",
+			}},
+		}, {
+			Kind: "ConfigMap",
+			Name: "my-workload",
+		}},
+	}
+
+	if err := printer.WorkloadTreePrinter(output, workload, root); err != nil {
+		t.Errorf("WorkloadTreePrinter() expected no error, got %v", err)
+	}
+
+	expectedOutput := `
+   NAME                            READY   REASON
+   Workload/my-workload            False   MissingValueAtPath
+   ├─Image/my-workload             True    
+   │ └─Build/my-workload-build-1   True    
+   └─ConfigMap/my-workload         -       
+`
+	if diff := cmp.Diff(strings.TrimPrefix(expectedOutput, "\n"), output.String()); diff != "" {
+		t.Errorf("Unexpected output (-expected, +actual): %s", diff)
+	}
+}